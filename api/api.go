@@ -25,6 +25,56 @@ type CemInterface interface {
 
 	// Add a use case implementation
 	AddUseCase(usecase UseCaseInterface)
+
+	// Remove a use case implementation
+	//
+	// the use case is removed from the use case announcement, so remote devices re-discover it,
+	// and it no longer receives events. The subscriptions and bindings of the client features
+	// no other use case uses are removed, the features themselves stay part of the local entity.
+	//
+	// possible errors:
+	//   - ErrUnknownUseCase if the use case was not added
+	RemoveUseCase(usecase UseCaseInterface) error
+
+	// Enable a disabled use case
+	//
+	// the use case is announced as available again and receives all events
+	//
+	// possible errors:
+	//   - ErrUnknownUseCase if the use case was not added
+	EnableUseCase(usecase UseCaseInterface) error
+
+	// Disable a use case
+	//
+	// the use case is announced as not available and no longer receives data update events,
+	// the device and entity events are still passed to keep its known entities up to date
+	//
+	// possible errors:
+	//   - ErrUnknownUseCase if the use case was not added
+	DisableUseCase(usecase UseCaseInterface) error
+
+	// returns the added use cases which are not disabled
+	UseCases() []UseCaseInterface
+
+	// returns all connected remote devices with their entities and
+	// which of the added use cases each entity supports
+	RemoteDevices() []RemoteDeviceInfo
+
+	// returns the added use cases a remote entity currently supports,
+	// as reported by the UseCaseSupported and UseCaseUnsupported events
+	SupportedUseCases(entity spineapi.EntityRemoteInterface) []SupportedUseCase
+
+	// returns the EEBUS services currently visible via mDNS,
+	// as reported by the RemoteServicesUpdated event
+	VisibleRemoteServices() []RemoteServiceInfo
+
+	// start the pairing process with a remote SKI, or approve an incoming pairing request
+	//
+	// the state changes are reported by the pairing events
+	StartPairing(ski string)
+
+	// cancel the pairing process with a remote SKI, or deny an incoming pairing request
+	CancelPairing(ski string)
 }
 
 // Implemented by each UseCase
@@ -38,10 +88,46 @@ type UseCaseInterface interface {
 	// add the usecase
 	AddUseCase()
 
+	// remove the usecase from the use case announcement of the local entity
+	RemoveUseCase()
+
+	// set if the usecase is announced as available by the local entity
+	UpdateUseCaseAvailability(available bool)
+
+	// returns the local client features the usecase uses
+	ClientFeatures() []model.FeatureTypeType
+
+	// returns the currently known remote entities compatible with the usecase
+	//
+	// an entity is known after it was connected or sent data, the EntityAdded and EntityRemoved
+	// events of the usecase report the changes of the list
+	CompatibleEntities() []spineapi.EntityRemoteInterface
+
+	// returns the SPINE events the usecase handles
+	EventInterest() EventInterest
+
 	// returns if the entity supports the usecase
 	//
 	// possible errors:
 	//   - ErrDataNotAvailable if that information is not (yet) available
 	//   - and others
 	IsUseCaseSupported(remoteEntity spineapi.EntityRemoteInterface) (bool, error)
+
+	// returns what a remote entity has to provide to support the usecase
+	RemoteRequirements() UseCaseRequirements
+
+	// returns the scenarios the remote entity announces and for which data is available
+	//
+	// possible errors:
+	//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+	SupportedScenarios(remoteEntity spineapi.EntityRemoteInterface) ([]model.UseCaseScenarioSupportType, error)
+
+	// request the descriptions, constraints and values of all features the usecase uses from a remote entity
+	//
+	// returns a result for each feature, the replies are received asynchronously
+	// and reported by the usecase events like any other data update
+	//
+	// possible errors:
+	//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+	Refresh(remoteEntity spineapi.EntityRemoteInterface) ([]RefreshResult, error)
 }
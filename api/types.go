@@ -4,6 +4,8 @@ import (
 	"errors"
 	"time"
 
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
 )
 
@@ -23,6 +25,55 @@ type LoadLimitsPhase struct {
 	Phase    model.ElectricalConnectionPhaseNameType
 	IsActive bool
 	Value    float64
+
+	// the duration the limit is valid for, 0 if the limit does not expire
+	//
+	// when writing, the limit automatically expires after this duration,
+	// when reading, this contains the remaining validity of the limit
+	Duration time.Duration
+}
+
+// Contains the details of a phase specific limit as reported by the remote device
+type LoadLimitsPhaseDetails struct {
+	LoadLimitsPhase
+
+	// if the limit can be changed by a client, e.g. via WriteLoadControlLimits
+	IsChangeable bool
+
+	// the permitted value range for this phase, 0 if not provided
+	Min float64
+	Max float64
+
+	// the default value for this phase which is used to pause charging, 0 if not provided
+	Default float64
+
+	// the time period the limit is valid for, zero values if not provided
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// the default duration after which a measurement value is considered stale
+const DefaultStaleThreshold = time.Minute
+
+// Contains a measurement value and its metadata as reported by the remote device
+type MeasurementResult struct {
+	Value float64
+
+	// the time the value was measured, zero if not provided
+	Timestamp time.Time
+
+	// the state of the value, normal if not provided
+	ValueState model.MeasurementValueStateType
+
+	// the source of the value, e.g. measured or calculated, empty if not provided
+	ValueSource model.MeasurementValueSourceType
+
+	// the phase of a value of a per phase measurement, empty otherwise
+	Phase model.ElectricalConnectionPhaseNameType
+
+	// true if the timestamp is older than the staleness threshold of the use case,
+	// values without a timestamp are never considered stale
+	IsStale bool
 }
 
 // identification
@@ -142,7 +193,168 @@ type DurationSlotValue struct {
 	Value    float64       // Energy Cost or Power Limit
 }
 
+// Describes what a remote entity has to provide to support a use case
+type UseCaseRequirements struct {
+	// the entity types compatible with the use case
+	EntityTypes []model.EntityTypeType
+
+	// the actors the remote device may announce the use case with
+	Actors []model.UseCaseActorType
+
+	// the mandatory scenarios
+	Scenarios []model.UseCaseScenarioSupportType
+
+	// the server features that need to be available
+	ServerFeatures []model.FeatureTypeType
+}
+
+// Describes why a remote entity does not support a use case
+type UseCaseUnsupportedReason string
+
+const (
+	// the entity type is not compatible with the use case
+	UseCaseUnsupportedReasonEntityType UseCaseUnsupportedReason = "entityType"
+
+	// the remote device does not announce the use case
+	UseCaseUnsupportedReasonUseCaseMissing UseCaseUnsupportedReason = "useCaseMissing"
+
+	// the remote device does not announce all mandatory scenarios
+	UseCaseUnsupportedReasonScenarioMissing UseCaseUnsupportedReason = "scenarioMissing"
+
+	// the remote device does not provide all required server features
+	UseCaseUnsupportedReasonFeatureMissing UseCaseUnsupportedReason = "featureMissing"
+
+	// the data required to check the support is not (yet) available
+	UseCaseUnsupportedReasonDataNotAvailable UseCaseUnsupportedReason = "dataNotAvailable"
+)
+
+// Contains a use case announced by a remote device
+type RemoteUseCase struct {
+	Actor       model.UseCaseActorType
+	UseCaseName model.UseCaseNameType
+	Version     model.SpecificationVersionType
+	Available   bool
+	Scenarios   []model.UseCaseScenarioSupportType
+}
+
+// Contains if one of the registered use cases is supported by a remote entity
+type UseCaseSupport struct {
+	UseCaseName model.UseCaseNameType
+
+	Supported bool
+
+	// why the use case is not supported, empty if it is supported
+	Reason UseCaseUnsupportedReason
+
+	// the mandatory scenarios that are not announced, if Reason is UseCaseUnsupportedReasonScenarioMissing
+	MissingScenarios []model.UseCaseScenarioSupportType
+
+	// the server features that are not available, if Reason is UseCaseUnsupportedReasonFeatureMissing
+	MissingFeatures []model.FeatureTypeType
+
+	// the error reported by the use case, if any
+	Error error
+}
+
+// Contains a use case supported by a remote entity
+type SupportedUseCase struct {
+	UseCaseName model.UseCaseNameType
+
+	// the scenarios the remote device announces and for which data is available
+	Scenarios []model.UseCaseScenarioSupportType
+}
+
+// Contains the details of an entity of a remote device
+type RemoteEntityInfo struct {
+	Entity spineapi.EntityRemoteInterface
+
+	// the dot separated entity address, e.g. "1.1"
+	Address    string
+	EntityType model.EntityTypeType
+
+	// the use cases announced for this entity, including those announced without an entity address
+	UseCases []RemoteUseCase
+
+	// the support of each registered use case, in the order they were added
+	Support []UseCaseSupport
+}
+
+// Contains the details of a connected remote device
+type RemoteDeviceInfo struct {
+	Device spineapi.DeviceRemoteInterface
+
+	Ski        string
+	Address    model.AddressDeviceType
+	DeviceType model.DeviceTypeType
+
+	// the entities, without the node management entity
+	Entities []RemoteEntityInfo
+}
+
+// Contains the details of an EEBUS service discovered via mDNS
+type RemoteServiceInfo struct {
+	Ski string
+
+	// the mDNS reported name, brand, model and EEBUS device type
+	Name       string
+	Brand      string
+	Model      string
+	DeviceType string
+
+	// if the SKI is registered as paired with the EEBUS service,
+	// paired services are connected automatically
+	Registered bool
+
+	// the current state of the pairing process
+	PairingState shipapi.ConnectionState
+}
+
+// Options for the context aware getters of the use cases
+type ReadOptions struct {
+	// request the data from the remote entity and wait for the reply
+	// within the context deadline, instead of returning the cached data
+	Fresh bool
+}
+
+// Options for the context aware writes of the use cases
+type WriteOptions struct {
+	// wait for the result of the remote entity within the context deadline
+	WaitForResult bool
+}
+
+// The result of refreshing the data of a remote server feature
+type RefreshResult struct {
+	Feature model.FeatureTypeType
+
+	// the functions a read request was sent for
+	Functions []model.FunctionType
+
+	// nil if the read requests were sent
+	Error error
+}
+
+// Describes the SPINE events a use case handles, so they are only passed to interested use cases
+type EventInterest struct {
+	// the entity types of the remote entities whose events are handled
+	EntityTypes []model.EntityTypeType
+
+	// the remote feature types whose data updates are handled
+	FeatureTypes []model.FeatureTypeType
+
+	// the functions whose data updates are handled, each function identifies the type of the data
+	Functions []model.FunctionType
+
+	// if device events without an entity are handled, e.g. a device disconnect
+	DeviceEvents bool
+}
+
 // type for cem and usecase specfic event names
 type EventType string
 
 var ErrNoCompatibleEntity = errors.New("entity is not an compatible entity")
+
+var ErrScenarioNotSupported = errors.New("scenario is not supported by the remote entity")
+
+var ErrWriteRejected = errors.New("the remote entity rejected the write")
+
+var ErrUnknownUseCase = errors.New("the use case was not added")
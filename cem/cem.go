@@ -1,12 +1,15 @@
 package cem
 
 import (
+	"sync"
+
 	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
 	eebusapi "github.com/enbility/eebus-go/api"
 	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
 	"github.com/enbility/ship-go/logging"
 	"github.com/enbility/spine-go/model"
-	"github.com/enbility/spine-go/spine"
 )
 
 // Generic CEM implementation
@@ -17,7 +20,30 @@ type Cem struct {
 
 	eventCB api.EventHandlerCB
 
+	// the active and the disabled use cases
 	usecases []api.UseCaseInterface
+	disabled []api.UseCaseInterface
+
+	// passes the SPINE events to the interested active use cases
+	router *eventRouter
+
+	// the supported use cases per remote device SKI and entity address
+	readiness map[string]map[string]*entityReadiness
+
+	// serializes the readiness evaluations, and guards the queued readiness
+	// events and if they are being sent
+	readinessMux     sync.Mutex
+	readinessEvents  []dispatchItem
+	sendingReadiness bool
+
+	// the application reader all service events are forwarded to
+	serviceReader eebusapi.ServiceReaderInterface
+
+	// the EEBUS services visible via mDNS and the running pairing processes per SKI
+	visible []shipapi.RemoteService
+	pairing map[string]*pairingProcess
+
+	mux sync.Mutex
 }
 
 func NewCEM(
@@ -26,14 +52,23 @@ func NewCEM(
 	eventCB api.EventHandlerCB,
 	log logging.LoggingInterface) *Cem {
 	cem := &Cem{
-		Service:  service.NewService(serviceDescription, serviceHandler),
 		Currency: model.CurrencyTypeEur,
 		eventCB:  eventCB,
+
+		router:    newEventRouter(),
+		readiness: make(map[string]map[string]*entityReadiness),
+
+		serviceReader: serviceHandler,
+		pairing:       make(map[string]*pairingProcess),
 	}
 
+	// the CEM tracks the visible services and pairing states,
+	// and forwards all service events to the service handler
+	cem.Service = service.NewService(serviceDescription, cem)
+
 	cem.Service.SetLogging(log)
 
-	_ = spine.Events.Subscribe(cem)
+	_ = util.SubscribeEvents(cem.Service, cem)
 
 	return cem
 }
@@ -51,14 +86,10 @@ func (h *Cem) Start() {
 }
 
 // Shutdown the EEBUS servic
+//
+// the CEM and its use cases no longer receive events afterwards
 func (h *Cem) Shutdown() {
 	h.Service.Shutdown()
-}
-
-// Add a use case implementation
-func (h *Cem) AddUseCase(usecase api.UseCaseInterface) {
-	h.usecases = append(h.usecases, usecase)
 
-	usecase.AddFeatures()
-	usecase.AddUseCase()
+	util.RemoveServiceEvents(h.Service)
 }
@@ -13,6 +13,7 @@ import (
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/mocks"
 	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
@@ -26,9 +27,12 @@ type CemSuite struct {
 
 	sut              *Cem
 	mockRemoteDevice *mocks.DeviceRemoteInterface
+
+	events []api.EventType
 }
 
 func (s *CemSuite) BeforeTest(suiteName, testName string) {
+	s.events = nil
 	s.mockRemoteDevice = mocks.NewDeviceRemoteInterface(s.T())
 
 	certificate, err := cert.CreateCertificate("Demo", "Demo", "DE", "Demo-Unit-10")
@@ -64,6 +68,7 @@ func (s *CemSuite) Test_CEM() {
 
 // ReaderInterface
 func (d *CemSuite) eventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	d.events = append(d.events, event)
 }
 
 // eebusapi.ServiceReaderInterface
@@ -87,3 +92,59 @@ func (h *CemSuite) ServiceShipIDUpdate(ski string, shipdID string) {}
 func (h *CemSuite) ServicePairingDetailUpdate(ski string, detail *shipapi.ConnectionStateDetail) {}
 
 func (h *CemSuite) AllowWaitingForTrust(ski string) bool { return true }
+
+func (s *CemSuite) Test_MultipleInstances() {
+	err := s.sut.Setup()
+	assert.Nil(s.T(), err)
+
+	certificate, err := cert.CreateCertificate("Demo", "Demo", "DE", "Demo-Unit-11")
+	assert.Nil(s.T(), err)
+
+	configuration, err := eebusapi.NewConfiguration(
+		"Demo", "Demo", "HEMS", "987654321",
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		7655, certificate, 230, time.Second*4)
+	assert.Nil(s.T(), err)
+
+	var otherEvents []api.EventType
+	otherCB := func(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+		otherEvents = append(otherEvents, event)
+	}
+
+	other := NewCEM(configuration, s, otherCB, &logging.NoLogging{})
+	err = other.Setup()
+	assert.Nil(s.T(), err)
+
+	// the remote device is only connected to the first instance
+	remoteDevice := s.setupRemoteDevice()
+
+	payload := spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     remoteDevice,
+		EventType:  spineapi.EventTypeDeviceChange,
+		ChangeType: spineapi.ElementChangeAdd,
+	}
+	spine.Events.Publish(payload)
+
+	assert.Equal(s.T(), []api.EventType{DeviceConnected}, s.events)
+	assert.Equal(s.T(), 0, len(otherEvents))
+
+	// the disconnect event is published after the device is removed from the local device
+	s.events = nil
+	s.sut.Service.LocalDevice().RemoveRemoteDeviceConnection(remoteSki)
+
+	assert.Equal(s.T(), []api.EventType{DeviceDisconnected}, s.events)
+	assert.Equal(s.T(), 0, len(otherEvents))
+
+	// no events are received after the shutdown
+	s.events = nil
+	s.sut.Shutdown()
+	other.Shutdown()
+
+	s.sut.Service.LocalDevice().AddRemoteDeviceForSki(remoteSki, remoteDevice)
+	spine.Events.Publish(payload)
+
+	assert.Equal(s.T(), 0, len(s.events))
+	assert.Equal(s.T(), 0, len(otherEvents))
+}
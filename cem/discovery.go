@@ -0,0 +1,210 @@
+package cem
+
+import (
+	"errors"
+	"slices"
+	"sort"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// returns all connected remote devices with their entities and
+// which of the added use cases each entity supports
+func (h *Cem) RemoteDevices() []api.RemoteDeviceInfo {
+	if h.Service == nil || h.Service.LocalDevice() == nil {
+		return nil
+	}
+
+	var result []api.RemoteDeviceInfo
+	for _, device := range h.Service.LocalDevice().RemoteDevices() {
+		result = append(result, h.remoteDeviceInfo(device))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Ski < result[j].Ski
+	})
+
+	return result
+}
+
+// returns the details of a remote device
+func (h *Cem) remoteDeviceInfo(device spineapi.DeviceRemoteInterface) api.RemoteDeviceInfo {
+	result := api.RemoteDeviceInfo{
+		Device: device,
+		Ski:    device.Ski(),
+	}
+
+	if address := device.Address(); address != nil {
+		result.Address = *address
+	}
+	if deviceType := device.DeviceType(); deviceType != nil {
+		result.DeviceType = *deviceType
+	}
+
+	usecases := device.UseCases()
+
+	for _, entity := range device.Entities() {
+		// ignore the node management entity
+		if entity.Address() == nil || slices.Equal(entity.Address().Entity, []model.AddressEntityType{0}) {
+			continue
+		}
+
+		info := api.RemoteEntityInfo{
+			Entity:     entity,
+			Address:    util.EntityAddressString(entity),
+			EntityType: entity.EntityType(),
+			UseCases:   announcedUseCases(entity, usecases),
+		}
+
+		for _, usecase := range h.UseCases() {
+			info.Support = append(info.Support, useCaseSupport(usecase, entity, usecases))
+		}
+
+		result.Entities = append(result.Entities, info)
+	}
+
+	return result
+}
+
+// returns the use cases announced for an entity, including those announced without an entity address
+func announcedUseCases(entity spineapi.EntityRemoteInterface, usecases []model.UseCaseInformationDataType) []api.RemoteUseCase {
+	var result []api.RemoteUseCase
+
+	for _, info := range usecases {
+		if !util.IsUseCaseInformationOfEntity(info, entity) {
+			continue
+		}
+
+		for _, support := range info.UseCaseSupport {
+			item := api.RemoteUseCase{
+				Scenarios: support.ScenarioSupport,
+			}
+			if info.Actor != nil {
+				item.Actor = *info.Actor
+			}
+			if support.UseCaseName != nil {
+				item.UseCaseName = *support.UseCaseName
+			}
+			if support.UseCaseVersion != nil {
+				item.Version = *support.UseCaseVersion
+			}
+			if support.UseCaseAvailable != nil {
+				item.Available = *support.UseCaseAvailable
+			}
+
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// returns if a use case is supported by an entity, and why not if it isn't
+func useCaseSupport(
+	usecase api.UseCaseInterface,
+	entity spineapi.EntityRemoteInterface,
+	usecases []model.UseCaseInformationDataType) api.UseCaseSupport {
+	supported, err := usecase.IsUseCaseSupported(entity)
+
+	result := api.UseCaseSupport{
+		UseCaseName: usecase.UseCaseName(),
+		Supported:   supported,
+		Error:       err,
+	}
+
+	if supported {
+		return result
+	}
+
+	requirements := usecase.RemoteRequirements()
+
+	switch {
+	case errors.Is(err, api.ErrNoCompatibleEntity):
+		result.Reason = api.UseCaseUnsupportedReasonEntityType
+
+	case errors.Is(err, eebusapi.ErrFunctionNotSupported):
+		result.Reason = api.UseCaseUnsupportedReasonFeatureMissing
+		result.MissingFeatures = missingFeatures(entity, requirements.ServerFeatures)
+
+	case err != nil:
+		result.Reason = api.UseCaseUnsupportedReasonDataNotAvailable
+
+	default:
+		// the use case announcement or the server features are not sufficient
+		scenarios, found := missingScenarios(usecase.UseCaseName(), requirements, usecases)
+		if !found {
+			result.Reason = api.UseCaseUnsupportedReasonUseCaseMissing
+			break
+		}
+
+		if len(scenarios) > 0 {
+			result.Reason = api.UseCaseUnsupportedReasonScenarioMissing
+			result.MissingScenarios = scenarios
+			break
+		}
+
+		result.Reason = api.UseCaseUnsupportedReasonFeatureMissing
+		result.MissingFeatures = missingFeatures(entity, requirements.ServerFeatures)
+	}
+
+	return result
+}
+
+// returns the mandatory scenarios missing in the closest matching use case announcement,
+// and if the use case is announced with a required actor at all
+func missingScenarios(
+	name model.UseCaseNameType,
+	requirements api.UseCaseRequirements,
+	usecases []model.UseCaseInformationDataType) ([]model.UseCaseScenarioSupportType, bool) {
+	var result []model.UseCaseScenarioSupportType
+	found := false
+
+	for _, info := range usecases {
+		if info.Actor == nil || !slices.Contains(requirements.Actors, *info.Actor) {
+			continue
+		}
+
+		for _, support := range info.UseCaseSupport {
+			if support.UseCaseName == nil || *support.UseCaseName != name {
+				continue
+			}
+
+			var missing []model.UseCaseScenarioSupportType
+			for _, scenario := range requirements.Scenarios {
+				if !slices.Contains(support.ScenarioSupport, scenario) {
+					missing = append(missing, scenario)
+				}
+			}
+
+			if !found || len(missing) < len(result) {
+				result = missing
+			}
+			found = true
+		}
+	}
+
+	return result, found
+}
+
+// returns the required server features the entity does not provide
+func missingFeatures(entity spineapi.EntityRemoteInterface, features []model.FeatureTypeType) []model.FeatureTypeType {
+	var available []model.FeatureTypeType
+	for _, feature := range entity.Features() {
+		if feature.Role() == model.RoleTypeServer {
+			available = append(available, feature.Type())
+		}
+	}
+
+	var result []model.FeatureTypeType
+	for _, feature := range features {
+		if !slices.Contains(available, feature) {
+			result = append(result, feature)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,177 @@
+package cem
+
+import (
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/ucevsecc"
+	"github.com/enbility/cemd/ucmgcp"
+	eebusutil "github.com/enbility/eebus-go/util"
+	shipmocks "github.com/enbility/ship-go/mocks"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const remoteSki string = "testremoteski"
+
+// add a remote device with a grid connection point entity providing
+// measurement and electrical connection server features
+func (s *CemSuite) setupRemoteDevice() spineapi.DeviceRemoteInterface {
+	localDevice := s.sut.Service.LocalDevice()
+
+	writeHandler := shipmocks.NewShipConnectionDataWriterInterface(s.T())
+	writeHandler.EXPECT().WriteShipMessageWithPayload(mock.Anything).Return().Maybe()
+	sender := spine.NewSender(writeHandler)
+	remoteDevice := spine.NewDeviceRemote(localDevice, remoteSki, sender)
+
+	remoteDeviceName := "remote"
+
+	var featureInformations []model.NodeManagementDetailedDiscoveryFeatureInformationType
+	for index, feature := range []model.FeatureTypeType{
+		model.FeatureTypeTypeMeasurement,
+		model.FeatureTypeTypeElectricalConnection,
+	} {
+		featureInformations = append(featureInformations, model.NodeManagementDetailedDiscoveryFeatureInformationType{
+			Description: &model.NetworkManagementFeatureDescriptionDataType{
+				FeatureAddress: &model.FeatureAddressType{
+					Device:  eebusutil.Ptr(model.AddressDeviceType(remoteDeviceName)),
+					Entity:  []model.AddressEntityType{1},
+					Feature: eebusutil.Ptr(model.AddressFeatureType(index)),
+				},
+				FeatureType: eebusutil.Ptr(feature),
+				Role:        eebusutil.Ptr(model.RoleTypeServer),
+			},
+		})
+	}
+
+	detailedData := &model.NodeManagementDetailedDiscoveryDataType{
+		DeviceInformation: &model.NodeManagementDetailedDiscoveryDeviceInformationType{
+			Description: &model.NetworkManagementDeviceDescriptionDataType{
+				DeviceAddress: &model.DeviceAddressType{
+					Device: eebusutil.Ptr(model.AddressDeviceType(remoteDeviceName)),
+				},
+				DeviceType: eebusutil.Ptr(model.DeviceTypeTypeElectricitySupplySystem),
+			},
+		},
+		EntityInformation: []model.NodeManagementDetailedDiscoveryEntityInformationType{
+			{
+				Description: &model.NetworkManagementEntityDescriptionDataType{
+					EntityAddress: &model.EntityAddressType{
+						Device: eebusutil.Ptr(model.AddressDeviceType(remoteDeviceName)),
+						Entity: []model.AddressEntityType{1},
+					},
+					EntityType: eebusutil.Ptr(model.EntityTypeTypeGridConnectionPointOfPremises),
+				},
+			},
+		},
+		FeatureInformation: featureInformations,
+	}
+
+	_, err := remoteDevice.AddEntityAndFeatures(true, detailedData)
+	assert.Nil(s.T(), err)
+	remoteDevice.UpdateDevice(detailedData.DeviceInformation.Description)
+
+	localDevice.AddRemoteDeviceForSki(remoteSki, remoteDevice)
+
+	return remoteDevice
+}
+
+// set the use case data of the remote device announcing MGCP with the given scenarios
+func setUseCaseData(remoteDevice spineapi.DeviceRemoteInterface, scenarios []model.UseCaseScenarioSupportType) {
+	nodemgmtEntity := remoteDevice.Entity([]model.AddressEntityType{0})
+	nodeFeature := remoteDevice.FeatureByEntityTypeAndRole(nodemgmtEntity, model.FeatureTypeTypeNodeManagement, model.RoleTypeSpecial)
+
+	data := &model.NodeManagementUseCaseDataType{
+		UseCaseInformation: []model.UseCaseInformationDataType{
+			{
+				Address: &model.FeatureAddressType{
+					Device: eebusutil.Ptr(model.AddressDeviceType("remote")),
+					Entity: []model.AddressEntityType{1},
+				},
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeGridConnectionPoint),
+				UseCaseSupport: []model.UseCaseSupportType{
+					{
+						UseCaseName:      eebusutil.Ptr(model.UseCaseNameTypeMonitoringOfGridConnectionPoint),
+						UseCaseVersion:   eebusutil.Ptr(model.SpecificationVersionType("1.0.0")),
+						UseCaseAvailable: eebusutil.Ptr(true),
+						ScenarioSupport:  scenarios,
+					},
+				},
+			},
+		},
+	}
+
+	nodeFeature.UpdateData(model.FunctionTypeNodeManagementUseCaseData, data, nil, nil)
+}
+
+func (s *CemSuite) Test_RemoteDevices() {
+	assert.Nil(s.T(), s.sut.RemoteDevices())
+
+	err := s.sut.Setup()
+	assert.Nil(s.T(), err)
+
+	s.sut.AddUseCase(ucmgcp.NewUCMGCP(s.sut.Service, s.eventCB))
+	s.sut.AddUseCase(ucevsecc.NewUCEVSECC(s.sut.Service, s.eventCB))
+
+	assert.Equal(s.T(), 0, len(s.sut.RemoteDevices()))
+
+	remoteDevice := s.setupRemoteDevice()
+
+	devices := s.sut.RemoteDevices()
+	assert.Equal(s.T(), 1, len(devices))
+	assert.Equal(s.T(), remoteSki, devices[0].Ski)
+	assert.Equal(s.T(), model.AddressDeviceType("remote"), devices[0].Address)
+	assert.Equal(s.T(), model.DeviceTypeTypeElectricitySupplySystem, devices[0].DeviceType)
+	assert.Equal(s.T(), 1, len(devices[0].Entities))
+
+	entity := devices[0].Entities[0]
+	assert.Equal(s.T(), "1", entity.Address)
+	assert.Equal(s.T(), model.EntityTypeTypeGridConnectionPointOfPremises, entity.EntityType)
+	assert.Equal(s.T(), 0, len(entity.UseCases))
+	assert.Equal(s.T(), 2, len(entity.Support))
+
+	support := entity.Support[0]
+	assert.Equal(s.T(), model.UseCaseNameTypeMonitoringOfGridConnectionPoint, support.UseCaseName)
+	assert.Equal(s.T(), false, support.Supported)
+	assert.Equal(s.T(), api.UseCaseUnsupportedReasonUseCaseMissing, support.Reason)
+
+	support = entity.Support[1]
+	assert.Equal(s.T(), model.UseCaseNameTypeEVSECommissioningAndConfiguration, support.UseCaseName)
+	assert.Equal(s.T(), false, support.Supported)
+	assert.Equal(s.T(), api.UseCaseUnsupportedReasonEntityType, support.Reason)
+
+	setUseCaseData(remoteDevice, []model.UseCaseScenarioSupportType{1, 2, 3})
+
+	entity = s.sut.RemoteDevices()[0].Entities[0]
+	assert.Equal(s.T(), 1, len(entity.UseCases))
+	assert.Equal(s.T(), model.UseCaseActorTypeGridConnectionPoint, entity.UseCases[0].Actor)
+	assert.Equal(s.T(), model.SpecificationVersionType("1.0.0"), entity.UseCases[0].Version)
+	assert.Equal(s.T(), true, entity.UseCases[0].Available)
+
+	support = entity.Support[0]
+	assert.Equal(s.T(), api.UseCaseUnsupportedReasonScenarioMissing, support.Reason)
+	assert.Equal(s.T(), []model.UseCaseScenarioSupportType{4}, support.MissingScenarios)
+
+	// the measurement descriptions are not yet received
+	setUseCaseData(remoteDevice, []model.UseCaseScenarioSupportType{1, 2, 3, 4})
+
+	support = s.sut.RemoteDevices()[0].Entities[0].Support[0]
+	assert.Equal(s.T(), false, support.Supported)
+	assert.Equal(s.T(), api.UseCaseUnsupportedReasonDataNotAvailable, support.Reason)
+	assert.NotNil(s.T(), support.Error)
+}
+
+func (s *CemSuite) Test_MissingFeatures() {
+	err := s.sut.Setup()
+	assert.Nil(s.T(), err)
+
+	remoteDevice := s.setupRemoteDevice()
+	entity := remoteDevice.Entity([]model.AddressEntityType{1})
+
+	result := missingFeatures(entity, []model.FeatureTypeType{
+		model.FeatureTypeTypeMeasurement,
+		model.FeatureTypeTypeDeviceConfiguration,
+	})
+	assert.Equal(s.T(), []model.FeatureTypeType{model.FeatureTypeTypeDeviceConfiguration}, result)
+}
@@ -0,0 +1,226 @@
+package cem
+
+import (
+	"sync"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// the default maximum number of queued callbacks per remote device
+const DefaultDispatcherQueueSize = 100
+
+// defines what happens with a callback if the queue of its remote device is full
+type OverflowPolicy int
+
+const (
+	// the oldest queued callback is dropped
+	OverflowDropOldest OverflowPolicy = iota
+
+	// the callback is dropped if the same event for the same entity is already queued,
+	// otherwise the oldest queued callback is dropped
+	OverflowCoalesce
+)
+
+type DispatcherOptions struct {
+	// the maximum number of queued callbacks per remote device, 0 uses DefaultDispatcherQueueSize
+	QueueSize int
+
+	Overflow OverflowPolicy
+}
+
+// the counters of a dispatcher
+type DispatcherMetrics struct {
+	// the number of queued callbacks per remote device SKI
+	QueueDepth map[string]int
+
+	// the number of invoked callbacks
+	Dispatched uint64
+
+	// the number of callbacks dropped because a queue was full
+	Dropped uint64
+
+	// the number of callbacks dropped because the same event was already queued
+	Coalesced uint64
+
+	// the average and maximum duration of the invoked callbacks
+	CallbackLatencyAverage time.Duration
+	CallbackLatencyMax     time.Duration
+}
+
+// a queued callback
+type dispatchItem struct {
+	ski    string
+	device spineapi.DeviceRemoteInterface
+	entity spineapi.EntityRemoteInterface
+	event  api.EventType
+}
+
+// the queue and worker state of a remote device
+type dispatchQueue struct {
+	items   []dispatchItem
+	running bool
+}
+
+// Dispatcher invokes the application callback asynchronously
+//
+// The use cases and the CEM call their callback in the SPINE event handling,
+// so a slow callback delays all EEBUS message processing. Pass Callback
+// instead of the application callback to NewCEM and the use case constructors:
+//
+//	dispatcher := cem.NewDispatcher(eventCB, cem.DispatcherOptions{})
+//	demo := cem.NewCEM(configuration, serviceHandler, dispatcher.Callback, log)
+//	demo.AddUseCase(ucmgcp.NewUCMGCP(demo.Service, dispatcher.Callback))
+//
+// The callbacks of each remote device are invoked in order on a worker goroutine
+// of the device, the callbacks of different devices run in parallel.
+type Dispatcher struct {
+	callback api.EventHandlerCB
+	options  DispatcherOptions
+
+	queues map[string]*dispatchQueue
+	closed bool
+
+	dispatched   uint64
+	dropped      uint64
+	coalesced    uint64
+	latencyTotal time.Duration
+	latencyMax   time.Duration
+
+	wg  sync.WaitGroup
+	mux sync.Mutex
+}
+
+func NewDispatcher(callback api.EventHandlerCB, options DispatcherOptions) *Dispatcher {
+	if options.QueueSize <= 0 {
+		options.QueueSize = DefaultDispatcherQueueSize
+	}
+
+	return &Dispatcher{
+		callback: callback,
+		options:  options,
+		queues:   make(map[string]*dispatchQueue),
+	}
+}
+
+var _ api.EventHandlerCB = (*Dispatcher)(nil).Callback
+
+// queue a callback, this can be used as an api.EventHandlerCB
+func (d *Dispatcher) Callback(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	item := dispatchItem{
+		ski:    ski,
+		device: device,
+		entity: entity,
+		event:  event,
+	}
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	if d.closed {
+		return
+	}
+
+	queue, ok := d.queues[ski]
+	if !ok {
+		queue = &dispatchQueue{}
+		d.queues[ski] = queue
+	}
+
+	if len(queue.items) >= d.options.QueueSize {
+		if d.options.Overflow == OverflowCoalesce && queue.contains(item) {
+			d.coalesced++
+			return
+		}
+
+		queue.items = queue.items[1:]
+		d.dropped++
+	}
+
+	queue.items = append(queue.items, item)
+
+	if !queue.running {
+		queue.running = true
+		d.wg.Add(1)
+		go d.run(ski, queue)
+	}
+}
+
+// returns if the same event for the same entity is queued
+func (q *dispatchQueue) contains(item dispatchItem) bool {
+	for _, queued := range q.items {
+		if queued.event == item.event && queued.entity == item.entity {
+			return true
+		}
+	}
+
+	return false
+}
+
+// invoke the queued callbacks of a remote device until its queue is empty
+func (d *Dispatcher) run(ski string, queue *dispatchQueue) {
+	defer d.wg.Done()
+
+	for {
+		d.mux.Lock()
+		if len(queue.items) == 0 {
+			queue.running = false
+			// the queue is recreated with the next callback
+			if d.queues[ski] == queue {
+				delete(d.queues, ski)
+			}
+			d.mux.Unlock()
+			return
+		}
+
+		item := queue.items[0]
+		queue.items = queue.items[1:]
+		d.mux.Unlock()
+
+		start := time.Now()
+		d.callback(item.ski, item.device, item.entity, item.event)
+		latency := time.Since(start)
+
+		d.mux.Lock()
+		d.dispatched++
+		d.latencyTotal += latency
+		if latency > d.latencyMax {
+			d.latencyMax = latency
+		}
+		d.mux.Unlock()
+	}
+}
+
+// returns the current counters
+func (d *Dispatcher) Metrics() DispatcherMetrics {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	result := DispatcherMetrics{
+		QueueDepth:         make(map[string]int),
+		Dispatched:         d.dispatched,
+		Dropped:            d.dropped,
+		Coalesced:          d.coalesced,
+		CallbackLatencyMax: d.latencyMax,
+	}
+
+	for ski, queue := range d.queues {
+		result.QueueDepth[ski] = len(queue.items)
+	}
+
+	if d.dispatched > 0 {
+		result.CallbackLatencyAverage = d.latencyTotal / time.Duration(d.dispatched)
+	}
+
+	return result
+}
+
+// stop accepting callbacks and wait until all queued callbacks are invoked
+func (d *Dispatcher) Close() {
+	d.mux.Lock()
+	d.closed = true
+	d.mux.Unlock()
+
+	d.wg.Wait()
+}
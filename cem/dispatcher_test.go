@@ -0,0 +1,151 @@
+package cem
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestDispatcherSuite(t *testing.T) {
+	suite.Run(t, new(DispatcherSuite))
+}
+
+type dispatchedEvent struct {
+	ski   string
+	event api.EventType
+}
+
+type DispatcherSuite struct {
+	suite.Suite
+
+	// closed to let the blocked callbacks continue
+	release chan struct{}
+	// receives the ski of each callback that is invoked
+	started chan string
+
+	events []dispatchedEvent
+	mux    sync.Mutex
+}
+
+func (s *DispatcherSuite) BeforeTest(suiteName, testName string) {
+	s.release = make(chan struct{})
+	s.started = make(chan string, 100)
+	s.events = nil
+}
+
+func (s *DispatcherSuite) eventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.started <- ski
+	<-s.release
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.events = append(s.events, dispatchedEvent{ski: ski, event: event})
+}
+
+func (s *DispatcherSuite) eventsOf(ski string) []api.EventType {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	var result []api.EventType
+	for _, item := range s.events {
+		if item.ski == ski {
+			result = append(result, item.event)
+		}
+	}
+
+	return result
+}
+
+func (s *DispatcherSuite) waitForStart(ski string) {
+	select {
+	case started := <-s.started:
+		assert.Equal(s.T(), ski, started)
+	case <-time.After(time.Second):
+		s.T().Fatal("callback not invoked")
+	}
+}
+
+func (s *DispatcherSuite) Test_Order() {
+	sut := NewDispatcher(s.eventCB, DispatcherOptions{})
+
+	sut.Callback("test", nil, nil, api.EventType("1"))
+	s.waitForStart("test")
+
+	// the callbacks of another device are not blocked
+	sut.Callback("other", nil, nil, api.EventType("1"))
+	s.waitForStart("other")
+
+	sut.Callback("test", nil, nil, api.EventType("2"))
+	sut.Callback("test", nil, nil, api.EventType("3"))
+
+	metrics := sut.Metrics()
+	assert.Equal(s.T(), 2, metrics.QueueDepth["test"])
+	assert.Equal(s.T(), 0, metrics.QueueDepth["other"])
+
+	close(s.release)
+	sut.Close()
+
+	assert.Equal(s.T(), []api.EventType{"1", "2", "3"}, s.eventsOf("test"))
+	assert.Equal(s.T(), []api.EventType{"1"}, s.eventsOf("other"))
+
+	metrics = sut.Metrics()
+	assert.Equal(s.T(), uint64(4), metrics.Dispatched)
+	assert.Equal(s.T(), uint64(0), metrics.Dropped)
+	assert.Equal(s.T(), 0, len(metrics.QueueDepth))
+	assert.True(s.T(), metrics.CallbackLatencyMax >= metrics.CallbackLatencyAverage)
+	assert.True(s.T(), metrics.CallbackLatencyAverage > 0)
+
+	// no callbacks are accepted after closing
+	sut.Callback("test", nil, nil, api.EventType("4"))
+	assert.Equal(s.T(), uint64(4), sut.Metrics().Dispatched)
+}
+
+func (s *DispatcherSuite) Test_OverflowDropOldest() {
+	sut := NewDispatcher(s.eventCB, DispatcherOptions{QueueSize: 2})
+
+	sut.Callback("test", nil, nil, api.EventType("1"))
+	s.waitForStart("test")
+
+	sut.Callback("test", nil, nil, api.EventType("2"))
+	sut.Callback("test", nil, nil, api.EventType("3"))
+	sut.Callback("test", nil, nil, api.EventType("2"))
+
+	metrics := sut.Metrics()
+	assert.Equal(s.T(), 2, metrics.QueueDepth["test"])
+	assert.Equal(s.T(), uint64(1), metrics.Dropped)
+
+	close(s.release)
+	sut.Close()
+
+	assert.Equal(s.T(), []api.EventType{"1", "3", "2"}, s.eventsOf("test"))
+}
+
+func (s *DispatcherSuite) Test_OverflowCoalesce() {
+	sut := NewDispatcher(s.eventCB, DispatcherOptions{QueueSize: 2, Overflow: OverflowCoalesce})
+
+	sut.Callback("test", nil, nil, api.EventType("1"))
+	s.waitForStart("test")
+
+	sut.Callback("test", nil, nil, api.EventType("2"))
+	sut.Callback("test", nil, nil, api.EventType("3"))
+	// already queued
+	sut.Callback("test", nil, nil, api.EventType("2"))
+	// not queued, so the oldest is dropped
+	sut.Callback("test", nil, nil, api.EventType("4"))
+
+	metrics := sut.Metrics()
+	assert.Equal(s.T(), 2, metrics.QueueDepth["test"])
+	assert.Equal(s.T(), uint64(1), metrics.Coalesced)
+	assert.Equal(s.T(), uint64(1), metrics.Dropped)
+
+	close(s.release)
+	sut.Close()
+
+	assert.Equal(s.T(), []api.EventType{"1", "3", "4"}, s.eventsOf("test"))
+}
@@ -3,10 +3,20 @@ package cem
 import (
 	"github.com/enbility/cemd/util"
 	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
 )
 
 // handle SPINE events
+//
+// the events are passed to the interested active use cases after the CEM processed them
 func (h *Cem) HandleEvent(payload spineapi.EventPayload) {
+	h.handleEvent(payload)
+
+	h.router.HandleEvent(payload)
+}
+
+// process the SPINE events of the remote devices
+func (h *Cem) handleEvent(payload spineapi.EventPayload) {
 
 	if util.IsDeviceConnected(payload) {
 		h.eventCB(payload.Ski, payload.Device, nil, DeviceConnected)
@@ -14,7 +24,32 @@ func (h *Cem) HandleEvent(payload spineapi.EventPayload) {
 	}
 
 	if util.IsDeviceDisconnected(payload) {
+		h.removeReadiness(payload.Ski, payload.Device)
 		h.eventCB(payload.Ski, payload.Device, nil, DeviceDisconnected)
 		return
 	}
+
+	if util.IsEntityConnected(payload) || util.IsEntityDisconnected(payload) {
+		h.eventCB(payload.Ski, payload.Device, payload.Entity, RemoteDeviceUpdated)
+		h.updateReadiness(payload.Device, false)
+		return
+	}
+
+	if payload.Device == nil ||
+		payload.EventType != spineapi.EventTypeDataChange ||
+		payload.ChangeType != spineapi.ElementChangeUpdate {
+		return
+	}
+
+	// the announced use cases may change the support of all use cases and entities
+	if _, ok := payload.Data.(*model.NodeManagementUseCaseDataType); ok {
+		h.eventCB(payload.Ski, payload.Device, nil, RemoteDeviceUpdated)
+		h.updateReadiness(payload.Device, false)
+		return
+	}
+
+	// other data may complete the data a use case requires
+	if payload.Entity != nil {
+		h.updateEntityReadiness(payload)
+	}
 }
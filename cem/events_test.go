@@ -1,7 +1,11 @@
 package cem
 
 import (
+	"github.com/enbility/cemd/api"
 	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
 )
 
 func (s *CemSuite) Test_Events() {
@@ -18,3 +22,31 @@ func (s *CemSuite) Test_Events() {
 	payload.ChangeType = spineapi.ElementChangeRemove
 	s.sut.HandleEvent(payload)
 }
+
+func (s *CemSuite) Test_RemoteDeviceUpdatedEvents() {
+	s.mockRemoteDevice.EXPECT().Ski().Return(remoteSki).Maybe()
+	s.mockRemoteDevice.EXPECT().UseCases().Return(nil).Maybe()
+	s.mockRemoteDevice.EXPECT().Entities().Return(nil).Maybe()
+
+	payload := spineapi.EventPayload{
+		Device:     s.mockRemoteDevice,
+		EventType:  spineapi.EventTypeDataChange,
+		ChangeType: spineapi.ElementChangeUpdate,
+		Data:       &model.MeasurementListDataType{},
+	}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), 0, len(s.events))
+
+	payload.Data = &model.NodeManagementUseCaseDataType{}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), []api.EventType{RemoteDeviceUpdated}, s.events)
+
+	payload = spineapi.EventPayload{
+		Device:     s.mockRemoteDevice,
+		Entity:     mocks.NewEntityRemoteInterface(s.T()),
+		EventType:  spineapi.EventTypeEntityChange,
+		ChangeType: spineapi.ElementChangeRemove,
+	}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), []api.EventType{RemoteDeviceUpdated, RemoteDeviceUpdated}, s.events)
+}
@@ -0,0 +1,437 @@
+package cem
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// the use case support of a remote entity
+type entityReadiness struct {
+	entity spineapi.EntityRemoteInterface
+
+	// the support of each evaluated use case, including why it is not supported
+	support map[model.UseCaseNameType]api.UseCaseSupport
+
+	// the supported scenarios per supported use case
+	usecases map[model.UseCaseNameType][]model.UseCaseScenarioSupportType
+
+	// the use cases whose support can't change with newly received values,
+	// as all announced scenarios are supported or the reason is not missing data
+	complete map[model.UseCaseNameType]bool
+}
+
+func newEntityReadiness(entity spineapi.EntityRemoteInterface) *entityReadiness {
+	return &entityReadiness{
+		entity:   entity,
+		support:  make(map[model.UseCaseNameType]api.UseCaseSupport),
+		usecases: make(map[model.UseCaseNameType][]model.UseCaseScenarioSupportType),
+		complete: make(map[model.UseCaseNameType]bool),
+	}
+}
+
+// returns a copy which can be modified without affecting the original
+func (e *entityReadiness) clone() *entityReadiness {
+	result := newEntityReadiness(e.entity)
+	for name, support := range e.support {
+		result.support[name] = support
+	}
+	for name, scenarios := range e.usecases {
+		result.usecases[name] = scenarios
+	}
+	for name, complete := range e.complete {
+		result.complete[name] = complete
+	}
+
+	return result
+}
+
+// a use case support change to be reported
+type readinessChange struct {
+	entity spineapi.EntityRemoteInterface
+	event  api.EventType
+}
+
+// returns the event sent when an added use case is now supported by a remote entity,
+// or its supported scenarios changed, e.g. "monitoringOfGridConnectionPoint.useCaseSupported"
+func UseCaseSupportedEvent(usecase model.UseCaseNameType) api.EventType {
+	return api.EventType(string(usecase) + "." + string(UseCaseSupported))
+}
+
+// returns the event sent when an added use case is no longer supported by a remote entity,
+// e.g. "monitoringOfGridConnectionPoint.useCaseUnsupported"
+func UseCaseUnsupportedEvent(usecase model.UseCaseNameType) api.EventType {
+	return api.EventType(string(usecase) + "." + string(UseCaseUnsupported))
+}
+
+// returns the use case of an event created with UseCaseSupportedEvent or UseCaseUnsupportedEvent,
+// the kind of the event, either UseCaseSupported or UseCaseUnsupported, and if it is such an event
+func ParseUseCaseEvent(event api.EventType) (model.UseCaseNameType, api.EventType, bool) {
+	for _, kind := range []api.EventType{UseCaseSupported, UseCaseUnsupported} {
+		if name, ok := strings.CutSuffix(string(event), "."+string(kind)); ok && name != "" {
+			return model.UseCaseNameType(name), kind, true
+		}
+	}
+
+	return "", "", false
+}
+
+// returns the added use cases a remote entity currently supports,
+// as reported by the UseCaseSupportedEvent and UseCaseUnsupportedEvent events
+func (h *Cem) SupportedUseCases(entity spineapi.EntityRemoteInterface) []api.SupportedUseCase {
+	if entity == nil || entity.Device() == nil {
+		return nil
+	}
+
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	state, ok := h.readiness[entity.Device().Ski()][util.EntityAddressString(entity)]
+	if !ok {
+		return nil
+	}
+
+	var result []api.SupportedUseCase
+	for name, scenarios := range state.usecases {
+		result = append(result, api.SupportedUseCase{
+			UseCaseName: name,
+			Scenarios:   slices.Clone(scenarios),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].UseCaseName < result[j].UseCaseName
+	})
+
+	return result
+}
+
+// re-check the use case support of all entities of a remote device with all added use cases
+// and send events for all changes
+//
+// with notifyUpdate, RemoteDeviceUpdated is sent if the support or the reasons changed
+func (h *Cem) updateReadiness(device spineapi.DeviceRemoteInterface, notifyUpdate bool) {
+	h.evaluateReadiness(device, nil, nil, notifyUpdate)
+}
+
+// re-check the use case support of a remote entity after it sent data
+//
+// only the use cases interested in the data are checked. Description and
+// constraint data is always checked, other data only as long as its use
+// cases are missing data, so frequent value updates don't cause any checks.
+func (h *Cem) updateEntityReadiness(payload spineapi.EventPayload) {
+	usecases := h.router.dataUseCasesOf(payload)
+	if len(usecases) == 0 {
+		return
+	}
+
+	function := functionOfData[reflect.TypeOf(payload.Data)]
+	if !descriptionFunctions[function] {
+		var incomplete []api.UseCaseInterface
+
+		h.mux.Lock()
+		state := h.readiness[payload.Device.Ski()][util.EntityAddressString(payload.Entity)]
+		for _, usecase := range usecases {
+			if state == nil || !state.complete[usecase.UseCaseName()] {
+				incomplete = append(incomplete, usecase)
+			}
+		}
+		h.mux.Unlock()
+
+		if len(incomplete) == 0 {
+			return
+		}
+		usecases = incomplete
+	}
+
+	h.evaluateReadiness(payload.Device, payload.Entity, usecases, true)
+}
+
+// the functions with descriptions, constraints or characteristics of the features
+// used by the use cases, which may change the use case support
+var descriptionFunctions = map[model.FunctionType]bool{
+	model.FunctionTypeDeviceConfigurationKeyValueConstraintsListData:   true,
+	model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData:   true,
+	model.FunctionTypeElectricalConnectionCharacteristicListData:       true,
+	model.FunctionTypeElectricalConnectionDescriptionListData:          true,
+	model.FunctionTypeElectricalConnectionParameterDescriptionListData: true,
+	model.FunctionTypeElectricalConnectionPermittedValueSetListData:    true,
+	model.FunctionTypeIncentiveTableConstraintsData:                    true,
+	model.FunctionTypeIncentiveTableDescriptionData:                    true,
+	model.FunctionTypeLoadControlLimitConstraintsListData:              true,
+	model.FunctionTypeLoadControlLimitDescriptionListData:              true,
+	model.FunctionTypeMeasurementConstraintsListData:                   true,
+	model.FunctionTypeMeasurementDescriptionListData:                   true,
+	model.FunctionTypeTimeSeriesConstraintsListData:                    true,
+	model.FunctionTypeTimeSeriesDescriptionListData:                    true,
+}
+
+// check the use case support of a remote device and send events for all changes
+//
+// if entity is nil, all entities are checked and removed entities are dropped,
+// otherwise only the entity is checked and the others are kept.
+// If usecases is nil, all active use cases are checked, otherwise only the
+// given ones which are still active.
+func (h *Cem) evaluateReadiness(
+	device spineapi.DeviceRemoteInterface,
+	entity spineapi.EntityRemoteInterface,
+	usecases []api.UseCaseInterface,
+	notifyUpdate bool) {
+	ski := device.Ski()
+	announced := device.UseCases()
+
+	// the changes are computed against the stored state, so concurrent
+	// evaluations of the same device don't report a change twice or lose it
+	h.readinessMux.Lock()
+
+	h.mux.Lock()
+	previous := h.readiness[ski]
+	active := slices.Clone(h.usecases)
+	h.mux.Unlock()
+
+	// a use case may have been disabled since the caller selected it
+	if usecases == nil {
+		usecases = active
+	} else {
+		usecases = slices.DeleteFunc(slices.Clone(usecases), func(item api.UseCaseInterface) bool {
+			return !slices.Contains(active, item)
+		})
+	}
+
+	current := make(map[string]*entityReadiness)
+	entities := device.Entities()
+	if entity != nil {
+		for address, state := range previous {
+			current[address] = state
+		}
+		entities = []spineapi.EntityRemoteInterface{entity}
+	}
+
+	for _, item := range entities {
+		// ignore the node management entity
+		if item.Address() == nil || slices.Equal(item.Address().Entity, []model.AddressEntityType{0}) {
+			continue
+		}
+
+		address := util.EntityAddressString(item)
+
+		state := newEntityReadiness(item)
+		if before, ok := previous[address]; ok && entity != nil {
+			state = before.clone()
+		}
+
+		for _, usecase := range usecases {
+			evaluateUseCase(state, usecase, announced)
+		}
+
+		current[address] = state
+	}
+
+	h.mux.Lock()
+	if len(current) > 0 {
+		h.readiness[ski] = current
+	} else {
+		delete(h.readiness, ski)
+	}
+	h.mux.Unlock()
+
+	if notifyUpdate && supportChanged(previous, current) {
+		h.queueReadinessEvent(ski, device, nil, RemoteDeviceUpdated)
+	}
+
+	for _, change := range readinessChanges(previous, current) {
+		h.queueReadinessEvent(ski, device, change.entity, change.event)
+	}
+
+	h.readinessMux.Unlock()
+
+	h.sendReadinessEvents()
+}
+
+// check the support of a use case by an entity
+func evaluateUseCase(state *entityReadiness, usecase api.UseCaseInterface, announced []model.UseCaseInformationDataType) {
+	name := usecase.UseCaseName()
+	support := useCaseSupport(usecase, state.entity, announced)
+
+	state.support[name] = support
+	delete(state.usecases, name)
+	state.complete[name] = support.Reason != api.UseCaseUnsupportedReasonDataNotAvailable
+
+	if !support.Supported {
+		return
+	}
+
+	scenarios, err := usecase.SupportedScenarios(state.entity)
+	if err != nil {
+		state.complete[name] = false
+		return
+	}
+
+	state.usecases[name] = scenarios
+
+	all, _ := util.AnnouncedScenarios(state.entity, name, usecase.RemoteRequirements())
+	state.complete[name] = len(scenarios) >= len(all)
+}
+
+// remove the use case support of a disconnected remote device
+// and send UseCaseUnsupportedEvent events for all previously supported use cases
+func (h *Cem) removeReadiness(ski string, device spineapi.DeviceRemoteInterface) {
+	h.readinessMux.Lock()
+
+	h.mux.Lock()
+	previous := h.readiness[ski]
+	delete(h.readiness, ski)
+	h.mux.Unlock()
+
+	for _, change := range readinessChanges(previous, nil) {
+		h.queueReadinessEvent(ski, device, change.entity, change.event)
+	}
+
+	h.readinessMux.Unlock()
+
+	h.sendReadinessEvents()
+}
+
+// add an event of a readiness change, the readiness mutex has to be locked
+func (h *Cem) queueReadinessEvent(
+	ski string,
+	device spineapi.DeviceRemoteInterface,
+	entity spineapi.EntityRemoteInterface,
+	event api.EventType) {
+	h.readinessEvents = append(h.readinessEvents, dispatchItem{ski: ski, device: device, entity: entity, event: event})
+}
+
+// send the queued readiness events in the order of their changes
+//
+// the events are sent without holding the readiness mutex, so the callback may
+// enable or disable use cases. If another goroutine is already sending, it also
+// sends the newly queued events.
+func (h *Cem) sendReadinessEvents() {
+	h.readinessMux.Lock()
+	if h.sendingReadiness {
+		h.readinessMux.Unlock()
+		return
+	}
+	h.sendingReadiness = true
+
+	for len(h.readinessEvents) > 0 {
+		item := h.readinessEvents[0]
+		h.readinessEvents = h.readinessEvents[1:]
+		h.readinessMux.Unlock()
+
+		h.eventCB(item.ski, item.device, item.entity, item.event)
+
+		h.readinessMux.Lock()
+	}
+
+	h.sendingReadiness = false
+	h.readinessMux.Unlock()
+}
+
+// returns if the support of any use case or the reason why it is not supported changed
+func supportChanged(previous, current map[string]*entityReadiness) bool {
+	for address, before := range previous {
+		if !equalSupport(before, current[address]) {
+			return true
+		}
+	}
+
+	for address, after := range current {
+		if _, ok := previous[address]; !ok && !equalSupport(nil, after) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// returns if two entities have the same use case support, nil is handled like no use cases
+func equalSupport(a, b *entityReadiness) bool {
+	var first, second map[model.UseCaseNameType]api.UseCaseSupport
+	if a != nil {
+		first = a.support
+	}
+	if b != nil {
+		second = b.support
+	}
+
+	if len(first) != len(second) {
+		return false
+	}
+
+	for name, support := range first {
+		other, ok := second[name]
+		if !ok ||
+			support.Supported != other.Supported ||
+			support.Reason != other.Reason ||
+			!slices.Equal(support.MissingScenarios, other.MissingScenarios) ||
+			!slices.Equal(support.MissingFeatures, other.MissingFeatures) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// returns the events for the differences between two readiness states, in entity address order
+func readinessChanges(previous, current map[string]*entityReadiness) []readinessChange {
+	var addresses []string
+	for address := range previous {
+		addresses = append(addresses, address)
+	}
+	for address := range current {
+		if _, ok := previous[address]; !ok {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+
+	var result []readinessChange
+	for _, address := range addresses {
+		before, after := previous[address], current[address]
+
+		if after != nil {
+			for _, name := range sortedUseCaseNames(after.usecases) {
+				var scenarios []model.UseCaseScenarioSupportType
+				var ok bool
+				if before != nil {
+					scenarios, ok = before.usecases[name]
+				}
+
+				if !ok || !slices.Equal(scenarios, after.usecases[name]) {
+					result = append(result, readinessChange{after.entity, UseCaseSupportedEvent(name)})
+				}
+			}
+		}
+
+		if before != nil {
+			for _, name := range sortedUseCaseNames(before.usecases) {
+				if after != nil {
+					if _, ok := after.usecases[name]; ok {
+						continue
+					}
+				}
+
+				result = append(result, readinessChange{before.entity, UseCaseUnsupportedEvent(name)})
+			}
+		}
+	}
+
+	return result
+}
+
+func sortedUseCaseNames(data map[model.UseCaseNameType][]model.UseCaseScenarioSupportType) []model.UseCaseNameType {
+	var result []model.UseCaseNameType
+	for name := range data {
+		result = append(result, name)
+	}
+	slices.Sort(result)
+
+	return result
+}
@@ -0,0 +1,259 @@
+package cem
+
+import (
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// a MGCP use case with a fixed support result
+type fakeUseCase struct {
+	api.UseCaseInterface
+
+	supported bool
+	err       error
+
+	// the number of support checks
+	checks int
+}
+
+func (f *fakeUseCase) UseCaseName() model.UseCaseNameType {
+	return model.UseCaseNameTypeMonitoringOfGridConnectionPoint
+}
+
+func (f *fakeUseCase) AddFeatures() {}
+
+func (f *fakeUseCase) AddUseCase() {}
+
+func (f *fakeUseCase) RemoveUseCase() {}
+
+func (f *fakeUseCase) UpdateUseCaseAvailability(available bool) {}
+
+func (f *fakeUseCase) ClientFeatures() []model.FeatureTypeType {
+	return []model.FeatureTypeType{model.FeatureTypeTypeMeasurement}
+}
+
+func (f *fakeUseCase) EventInterest() api.EventInterest {
+	return api.EventInterest{
+		EntityTypes:  []model.EntityTypeType{model.EntityTypeTypeGridConnectionPointOfPremises},
+		FeatureTypes: []model.FeatureTypeType{model.FeatureTypeTypeMeasurement},
+		Functions: []model.FunctionType{
+			model.FunctionTypeMeasurementDescriptionListData,
+			model.FunctionTypeMeasurementListData,
+		},
+	}
+}
+
+func (f *fakeUseCase) HandleEvent(payload spineapi.EventPayload) {}
+
+func (f *fakeUseCase) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool, error) {
+	f.checks++
+
+	return f.supported, f.err
+}
+
+func (f *fakeUseCase) RemoteRequirements() api.UseCaseRequirements {
+	return api.UseCaseRequirements{
+		Actors:    []model.UseCaseActorType{model.UseCaseActorTypeGridConnectionPoint},
+		Scenarios: []model.UseCaseScenarioSupportType{2, 3, 4},
+	}
+}
+
+func (f *fakeUseCase) SupportedScenarios(entity spineapi.EntityRemoteInterface) ([]model.UseCaseScenarioSupportType, error) {
+	scenarios, _ := util.AnnouncedScenarios(entity, f.UseCaseName(), f.RemoteRequirements())
+	return scenarios, nil
+}
+
+// returns a data update event of the measurement feature of the remote entity
+func measurementPayload(remoteDevice spineapi.DeviceRemoteInterface, data any) spineapi.EventPayload {
+	entity := remoteDevice.Entity([]model.AddressEntityType{1})
+
+	return spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     remoteDevice,
+		Entity:     entity,
+		Feature:    remoteDevice.FeatureByEntityTypeAndRole(entity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer),
+		EventType:  spineapi.EventTypeDataChange,
+		ChangeType: spineapi.ElementChangeUpdate,
+		Data:       data,
+	}
+}
+
+// returns the update event of the use case data of the remote device
+func useCaseDataPayload(remoteDevice spineapi.DeviceRemoteInterface) spineapi.EventPayload {
+	return spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     remoteDevice,
+		Entity:     remoteDevice.Entity([]model.AddressEntityType{0}),
+		EventType:  spineapi.EventTypeDataChange,
+		ChangeType: spineapi.ElementChangeUpdate,
+		Data:       &model.NodeManagementUseCaseDataType{},
+	}
+}
+
+func (s *CemSuite) Test_Readiness() {
+	err := s.sut.Setup()
+	assert.Nil(s.T(), err)
+
+	usecase := &fakeUseCase{err: eebusapi.ErrDataNotAvailable}
+	s.sut.AddUseCase(usecase)
+
+	remoteDevice := s.setupRemoteDevice()
+	entity := remoteDevice.Entity([]model.AddressEntityType{1})
+	name := model.UseCaseNameTypeMonitoringOfGridConnectionPoint
+
+	values := measurementPayload(remoteDevice, &model.MeasurementListDataType{})
+	descriptions := measurementPayload(remoteDevice, &model.MeasurementDescriptionListDataType{})
+
+	// the first check reports the reason why the use case is not supported
+	s.sut.HandleEvent(values)
+	assert.Equal(s.T(), []api.EventType{RemoteDeviceUpdated}, s.events)
+	assert.Nil(s.T(), s.sut.SupportedUseCases(entity))
+
+	// unchanged reasons are not reported again
+	s.sut.HandleEvent(values)
+	assert.Equal(s.T(), 1, len(s.events))
+
+	// while data is missing, values are checked
+	setUseCaseData(remoteDevice, []model.UseCaseScenarioSupportType{4, 3, 2, 1})
+	usecase.supported = true
+	usecase.err = nil
+
+	s.events = nil
+	s.sut.HandleEvent(values)
+	assert.Equal(s.T(), []api.EventType{RemoteDeviceUpdated, UseCaseSupportedEvent(name)}, s.events)
+	assert.Equal(s.T(), []api.SupportedUseCase{
+		{
+			UseCaseName: name,
+			Scenarios:   []model.UseCaseScenarioSupportType{1, 2, 3, 4},
+		},
+	}, s.sut.SupportedUseCases(entity))
+
+	// values of a complete use case are not checked
+	checks := usecase.checks
+	s.sut.HandleEvent(values)
+	assert.Equal(s.T(), checks, usecase.checks)
+	assert.Equal(s.T(), 2, len(s.events))
+
+	// data of other features is not checked
+	other := values
+	other.Feature = remoteDevice.FeatureByEntityTypeAndRole(entity, model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	s.sut.HandleEvent(other)
+	assert.Equal(s.T(), checks, usecase.checks)
+
+	// unchanged support is not reported again
+	s.sut.HandleEvent(descriptions)
+	assert.Equal(s.T(), checks+1, usecase.checks)
+	assert.Equal(s.T(), 2, len(s.events))
+
+	// changed scenarios are reported
+	setUseCaseData(remoteDevice, []model.UseCaseScenarioSupportType{2, 3, 4, 6})
+	s.events = nil
+	s.sut.HandleEvent(useCaseDataPayload(remoteDevice))
+	assert.Equal(s.T(), []api.EventType{RemoteDeviceUpdated, UseCaseSupportedEvent(name)}, s.events)
+	assert.Equal(s.T(), []model.UseCaseScenarioSupportType{2, 3, 4, 6}, s.sut.SupportedUseCases(entity)[0].Scenarios)
+
+	// descriptions are always checked
+	usecase.supported = false
+	s.events = nil
+	s.sut.HandleEvent(descriptions)
+	assert.Equal(s.T(), []api.EventType{RemoteDeviceUpdated, UseCaseUnsupportedEvent(name)}, s.events)
+	assert.Nil(s.T(), s.sut.SupportedUseCases(entity))
+
+	usecase.supported = true
+	s.events = nil
+	s.sut.HandleEvent(descriptions)
+	assert.Equal(s.T(), []api.EventType{RemoteDeviceUpdated, UseCaseSupportedEvent(name)}, s.events)
+
+	s.events = nil
+	payload := spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     remoteDevice,
+		EventType:  spineapi.EventTypeDeviceChange,
+		ChangeType: spineapi.ElementChangeRemove,
+	}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), []api.EventType{UseCaseUnsupportedEvent(name), DeviceDisconnected}, s.events)
+	assert.Nil(s.T(), s.sut.SupportedUseCases(entity))
+
+	assert.Nil(s.T(), s.sut.SupportedUseCases(nil))
+}
+
+func (s *CemSuite) Test_ParseUseCaseEvent() {
+	name := model.UseCaseNameTypeMonitoringOfGridConnectionPoint
+
+	usecase, kind, ok := ParseUseCaseEvent(UseCaseSupportedEvent(name))
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), name, usecase)
+	assert.Equal(s.T(), UseCaseSupported, kind)
+
+	usecase, kind, ok = ParseUseCaseEvent(UseCaseUnsupportedEvent(name))
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), name, usecase)
+	assert.Equal(s.T(), UseCaseUnsupported, kind)
+
+	for _, event := range []api.EventType{UseCaseSupported, RemoteDeviceUpdated, "ucmgcp.EntityAdded"} {
+		_, _, ok = ParseUseCaseEvent(event)
+		assert.False(s.T(), ok)
+	}
+}
+
+func (s *CemSuite) Test_ReadinessConcurrentEvaluation() {
+	err := s.sut.Setup()
+	assert.Nil(s.T(), err)
+
+	usecase := &fakeUseCase{supported: true}
+	s.sut.AddUseCase(usecase)
+
+	remoteDevice := s.setupRemoteDevice()
+	entity := remoteDevice.Entity([]model.AddressEntityType{1})
+	name := model.UseCaseNameTypeMonitoringOfGridConnectionPoint
+
+	setUseCaseData(remoteDevice, []model.UseCaseScenarioSupportType{2, 3, 4})
+	s.sut.HandleEvent(useCaseDataPayload(remoteDevice))
+	assert.Equal(s.T(), []api.EventType{RemoteDeviceUpdated, UseCaseSupportedEvent(name)}, s.events)
+
+	s.events = nil
+	descriptions := measurementPayload(remoteDevice, &model.MeasurementDescriptionListDataType{})
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			s.sut.updateEntityReadiness(descriptions)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			assert.Nil(s.T(), s.sut.DisableUseCase(usecase))
+			assert.Nil(s.T(), s.sut.EnableUseCase(usecase))
+		}
+	}()
+	wg.Wait()
+
+	// each change is reported once, so the support events alternate
+	last := UseCaseSupportedEvent(name)
+	for _, event := range s.events {
+		if event == RemoteDeviceUpdated {
+			continue
+		}
+
+		assert.NotEqual(s.T(), last, event)
+		last = event
+	}
+
+	assert.Equal(s.T(), UseCaseSupportedEvent(name), last)
+	assert.Equal(s.T(), []api.SupportedUseCase{
+		{
+			UseCaseName: name,
+			Scenarios:   []model.UseCaseScenarioSupportType{2, 3, 4},
+		},
+	}, s.sut.SupportedUseCases(entity))
+}
@@ -0,0 +1,153 @@
+package cem
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	shipapi "github.com/enbility/ship-go/api"
+)
+
+// the direction of a recorded SPINE datagram
+type RecordDirection string
+
+const (
+	RecordDirectionIncoming RecordDirection = "in"
+	RecordDirectionOutgoing RecordDirection = "out"
+)
+
+// a recorded SPINE datagram
+type Record struct {
+	// the time the datagram was sent or received
+	Time time.Time `json:"time"`
+
+	// the SKI of the remote service
+	Ski string `json:"ski"`
+
+	Direction RecordDirection `json:"direction"`
+
+	// the SPINE datagram as sent in the SHIP data message payload
+	Datagram json.RawMessage `json:"datagram"`
+}
+
+// Recorder writes all SPINE datagrams of the SHIP connections to a file
+//
+// The recorder is added to a CEM with Cem.SetRecorder before its Setup. It wraps
+// the SHIP data reader and writer of each connection, so it sees the SPINE
+// datagrams exactly as they are passed between SHIP and SPINE.
+//
+// Each record is written as one JSON line. Use ReadRecording to read a recording
+// and the replay package to feed it into a CEM.
+type Recorder struct {
+	writer io.Writer
+	err    error
+
+	mux sync.Mutex
+}
+
+// create a recorder writing to w
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{
+		writer: w,
+	}
+}
+
+// record a SPINE datagram
+func (r *Recorder) Record(ski string, direction RecordDirection, datagram []byte) {
+	record := Record{
+		Time:      time.Now(),
+		Ski:       ski,
+		Direction: direction,
+		Datagram:  json.RawMessage(bytes.TrimSpace(datagram)),
+	}
+
+	data, err := json.Marshal(record)
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if r.err != nil {
+		return
+	}
+	if err == nil {
+		_, err = r.writer.Write(append(data, '\n'))
+	}
+	r.err = err
+}
+
+// return the first error writing a record, the following records are dropped
+func (r *Recorder) Err() error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.err
+}
+
+// returns a reader recording the datagrams of a remote service before passing them to reader
+//
+// This is used for connections without SHIP, e.g. in tests. The SHIP connections
+// of a CEM are wrapped by Cem.SetRecorder.
+func (r *Recorder) WrapReader(ski string, reader shipapi.ShipConnectionDataReaderInterface) shipapi.ShipConnectionDataReaderInterface {
+	return &recordingReader{recorder: r, ski: ski, reader: reader}
+}
+
+// returns a writer recording the datagrams to a remote service before passing them to writer
+//
+// This is used for connections without SHIP, e.g. in tests. The SHIP connections
+// of a CEM are wrapped by Cem.SetRecorder.
+func (r *Recorder) WrapWriter(ski string, writer shipapi.ShipConnectionDataWriterInterface) shipapi.ShipConnectionDataWriterInterface {
+	return &recordingWriter{recorder: r, ski: ski, writer: writer}
+}
+
+// read all records of a recording
+func ReadRecording(reader io.Reader) ([]Record, error) {
+	var records []Record
+
+	scanner := bufio.NewScanner(reader)
+	// datagrams with many entities or long lists exceed the default buffer
+	scanner.Buffer(nil, 16*1024*1024)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		records = append(records, record)
+	}
+
+	return records, scanner.Err()
+}
+
+// records the incoming datagrams of a connection
+type recordingReader struct {
+	recorder *Recorder
+	ski      string
+	reader   shipapi.ShipConnectionDataReaderInterface
+}
+
+func (r *recordingReader) HandleShipPayloadMessage(message []byte) {
+	r.recorder.Record(r.ski, RecordDirectionIncoming, message)
+	r.reader.HandleShipPayloadMessage(message)
+}
+
+// records the outgoing datagrams of a connection
+type recordingWriter struct {
+	recorder *Recorder
+	ski      string
+	writer   shipapi.ShipConnectionDataWriterInterface
+}
+
+func (w *recordingWriter) WriteShipMessageWithPayload(message []byte) {
+	w.recorder.Record(w.ski, RecordDirectionOutgoing, message)
+	w.writer.WriteShipMessageWithPayload(message)
+}
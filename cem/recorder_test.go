@@ -0,0 +1,70 @@
+package cem
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRecorderSuite(t *testing.T) {
+	suite.Run(t, new(RecorderSuite))
+}
+
+type RecorderSuite struct {
+	suite.Suite
+
+	buffer bytes.Buffer
+	sut    *Recorder
+
+	// the payloads passed to the wrapped reader and writer
+	payloads [][]byte
+}
+
+func (s *RecorderSuite) BeforeTest(suiteName, testName string) {
+	s.buffer.Reset()
+	s.payloads = nil
+	s.sut = NewRecorder(&s.buffer)
+}
+
+func (s *RecorderSuite) HandleShipPayloadMessage(message []byte) {
+	s.payloads = append(s.payloads, message)
+}
+
+func (s *RecorderSuite) WriteShipMessageWithPayload(message []byte) {
+	s.payloads = append(s.payloads, message)
+}
+
+func (s *RecorderSuite) Test_Wrap() {
+	reader := s.sut.WrapReader("1234", s)
+	writer := s.sut.WrapWriter("1234", s)
+
+	reader.HandleShipPayloadMessage([]byte(`{"datagram":{}}`))
+	writer.WriteShipMessageWithPayload([]byte(`{"datagram":{"payload":{}}}`))
+	assert.Equal(s.T(), 2, len(s.payloads))
+
+	records, err := ReadRecording(&s.buffer)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(records))
+	assert.Equal(s.T(), RecordDirectionIncoming, records[0].Direction)
+	assert.Equal(s.T(), `{"datagram":{}}`, string(records[0].Datagram))
+	assert.Equal(s.T(), RecordDirectionOutgoing, records[1].Direction)
+}
+
+type failingWriter struct{}
+
+func (f failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func (s *RecorderSuite) Test_Errors() {
+	sut := NewRecorder(failingWriter{})
+	sut.Record("1234", RecordDirectionIncoming, []byte(`{}`))
+	assert.NotNil(s.T(), sut.Err())
+
+	_, err := ReadRecording(strings.NewReader("{}\nno json"))
+	assert.NotNil(s.T(), err)
+}
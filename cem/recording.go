@@ -0,0 +1,137 @@
+package cem
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/hub"
+	"github.com/enbility/ship-go/mdns"
+)
+
+// record the SPINE datagrams of all SHIP connections of the service
+//
+// needs to be called before Setup
+func (h *Cem) SetRecorder(recorder *Recorder) error {
+	inner, ok := h.Service.(*service.Service)
+	if !ok {
+		return errors.New("the service can't be recorded")
+	}
+
+	// the events are routed by service, so they are moved to the wrapping service
+	util.RemoveServiceEvents(inner)
+	h.Service = &recordingService{
+		Service:  inner,
+		handler:  h,
+		recorder: recorder,
+	}
+
+	return util.SubscribeEvents(h.Service, h)
+}
+
+// an EEBUS service recording the datagrams of its SHIP connections
+//
+// The hub of the eebus-go service passes new connections to the service itself,
+// so they can't be intercepted. Instead, a second hub with the same configuration
+// is created and used, which passes the connections to SetupRemoteDevice of this
+// service. The hub of the wrapped service is never started.
+type recordingService struct {
+	*service.Service
+
+	// the service reader of the wrapped service
+	handler eebusapi.ServiceReaderInterface
+
+	recorder *Recorder
+	hub      shipapi.HubInterface
+
+	startOnce sync.Once
+}
+
+var _ eebusapi.ServiceInterface = (*recordingService)(nil)
+var _ shipapi.HubReaderInterface = (*recordingService)(nil)
+
+func (s *recordingService) Setup() error {
+	if err := s.Service.Setup(); err != nil {
+		return err
+	}
+
+	configuration := s.Configuration()
+
+	// the same mDNS announcement as created by the wrapped service
+	mdnsManager := mdns.NewMDNS(
+		s.LocalService().SKI(),
+		configuration.DeviceBrand(),
+		configuration.DeviceModel(),
+		string(configuration.DeviceType()),
+		configuration.Identifier(),
+		configuration.MdnsServiceName(),
+		configuration.Port(),
+		configuration.Interfaces(),
+		configuration.MdnsProviderSelection(),
+	)
+
+	s.hub = hub.NewHub(s, mdnsManager, configuration.Port(), configuration.Certificate(), s.LocalService())
+
+	return nil
+}
+
+func (s *recordingService) Start() {
+	s.startOnce.Do(func() {
+		s.hub.Start()
+	})
+}
+
+func (s *recordingService) Shutdown() {
+	s.hub.Shutdown()
+}
+
+func (s *recordingService) PairingDetailForSki(ski string) *shipapi.ConnectionStateDetail {
+	return s.hub.PairingDetailForSki(ski)
+}
+
+func (s *recordingService) RemoteServiceForSKI(ski string) *shipapi.ServiceDetails {
+	return s.hub.ServiceForSKI(ski)
+}
+
+func (s *recordingService) RegisterRemoteSKI(ski string, enable bool) {
+	s.hub.RegisterRemoteSKI(ski, enable)
+}
+
+func (s *recordingService) DisconnectSKI(ski string, reason string) {
+	s.hub.DisconnectSKI(ski, reason)
+}
+
+func (s *recordingService) InitiateOrApprovePairingWithSKI(ski string) {
+	s.hub.InitiateOrApprovePairingWithSKI(ski)
+}
+
+func (s *recordingService) CancelPairingWithSKI(ski string) {
+	s.hub.CancelPairingWithSKI(ski)
+}
+
+// report a connection to a SKI, with this service instead of the wrapped one
+func (s *recordingService) RemoteSKIConnected(ski string) {
+	s.handler.RemoteSKIConnected(s, ski)
+}
+
+// report a disconnection to a SKI, with this service instead of the wrapped one
+func (s *recordingService) RemoteSKIDisconnected(ski string) {
+	s.LocalDevice().RemoveRemoteDeviceConnection(ski)
+
+	s.handler.RemoteSKIDisconnected(s, ski)
+}
+
+// report all currently visible EEBUS services, with this service instead of the wrapped one
+func (s *recordingService) VisibleRemoteServicesUpdated(entries []shipapi.RemoteService) {
+	s.handler.VisibleRemoteServicesUpdated(s, entries)
+}
+
+// set up the SPINE device of a connection with a recording reader and writer
+func (s *recordingService) SetupRemoteDevice(ski string, writeI shipapi.ShipConnectionDataWriterInterface) shipapi.ShipConnectionDataReaderInterface {
+	reader := s.Service.SetupRemoteDevice(ski, s.recorder.WrapWriter(ski, writeI))
+
+	return s.recorder.WrapReader(ski, reader)
+}
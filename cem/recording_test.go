@@ -0,0 +1,58 @@
+package cem
+
+import (
+	"bytes"
+	"fmt"
+
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CemSuite) Test_SetRecorder() {
+	var buffer bytes.Buffer
+	err := s.sut.SetRecorder(NewRecorder(&buffer))
+	assert.Nil(s.T(), err)
+
+	err = s.sut.Setup()
+	assert.Nil(s.T(), err)
+
+	// the hub passes the connections to the recording service
+	hubReader, ok := s.sut.Service.(shipapi.HubReaderInterface)
+	assert.True(s.T(), ok)
+
+	reader := hubReader.SetupRemoteDevice(remoteSki, discardWriter{})
+
+	// the detailed discovery request is sent when the remote device is set up
+	records, err := ReadRecording(bytes.NewReader(buffer.Bytes()))
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(records))
+	assert.Equal(s.T(), remoteSki, records[0].Ski)
+	assert.Equal(s.T(), RecordDirectionOutgoing, records[0].Direction)
+	assert.Contains(s.T(), string(records[0].Datagram), "nodeManagementDetailedDiscoveryData")
+
+	// a result of the remote node management to the local node management
+	localDevice := string(*s.sut.Service.LocalDevice().Address())
+	datagram := fmt.Sprintf(`{"datagram":{"header":{"specificationVersion":"1.3.0",`+
+		`"addressSource":{"device":"remote","entity":[0],"feature":0},`+
+		`"addressDestination":{"device":"%s","entity":[0],"feature":0},`+
+		`"msgCounter":1,"msgCounterReference":1,"cmdClassifier":"result"},`+
+		`"payload":{"cmd":[{"resultData":{"errorNumber":0}}]}}}`, localDevice)
+	reader.HandleShipPayloadMessage([]byte(datagram))
+
+	records, err = ReadRecording(bytes.NewReader(buffer.Bytes()))
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(records))
+	assert.Equal(s.T(), RecordDirectionIncoming, records[1].Direction)
+	assert.JSONEq(s.T(), datagram, string(records[1].Datagram))
+
+	// the pairing is handled by the hub of the recording service
+	assert.NotNil(s.T(), s.sut.Service.PairingDetailForSki(remoteSki))
+
+	s.sut.Shutdown()
+}
+
+func (s *CemSuite) Test_SetRecorderUnknownService() {
+	s.sut.Service = nil
+	err := s.sut.SetRecorder(NewRecorder(&bytes.Buffer{}))
+	assert.NotNil(s.T(), err)
+}
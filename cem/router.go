@@ -0,0 +1,230 @@
+package cem
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+// the function of each SPINE data type, as data update events only contain the data
+var functionOfData = func() map[reflect.Type]model.FunctionType {
+	result := make(map[reflect.Type]model.FunctionType)
+
+	// the generic feature contains the functions of all other features except the node management
+	for _, featureType := range []model.FeatureTypeType{model.FeatureTypeTypeGeneric, model.FeatureTypeTypeNodeManagement} {
+		for _, function := range spine.CreateFunctionData[spineapi.FunctionDataInterface](featureType) {
+			result[reflect.TypeOf(function.DataCopyAny())] = function.FunctionType()
+		}
+	}
+
+	return result
+}()
+
+// the index key of data update events
+type dataRoute struct {
+	entityType  model.EntityTypeType
+	featureType model.FeatureTypeType
+	function    model.FunctionType
+}
+
+// a registered use case handler
+type routerEntry struct {
+	usecase  api.UseCaseInterface
+	handler  spineapi.EventHandlerInterface
+	interest api.EventInterest
+
+	// a disabled use case receives only the device and entity events,
+	// so its known entities stay up to date until it is enabled again
+	disabled bool
+}
+
+// passes each SPINE event only to the use cases interested in it
+//
+// The handlers are indexed by the entity type, the feature type and the function of the data,
+// so an event is matched with a map lookup instead of every use case inspecting it.
+// The index is rebuilt when a use case is added or removed, which happens rarely.
+type eventRouter struct {
+	entries []routerEntry
+
+	// the handlers of device events without an entity
+	deviceHandlers []spineapi.EventHandlerInterface
+	// the handlers of other entity events per entity type, e.g. entity connected
+	entityHandlers map[model.EntityTypeType][]spineapi.EventHandlerInterface
+	// the handlers of data update events, events without a feature use an empty feature type
+	dataHandlers map[dataRoute][]spineapi.EventHandlerInterface
+	// the use cases of the data update handlers
+	dataUseCases map[dataRoute][]api.UseCaseInterface
+
+	mux sync.RWMutex
+}
+
+func newEventRouter() *eventRouter {
+	return &eventRouter{
+		entityHandlers: make(map[model.EntityTypeType][]spineapi.EventHandlerInterface),
+		dataHandlers:   make(map[dataRoute][]spineapi.EventHandlerInterface),
+		dataUseCases:   make(map[dataRoute][]api.UseCaseInterface),
+	}
+}
+
+// register a use case with the events it is interested in, or enable a disabled use case again
+//
+// use cases not implementing spineapi.EventHandlerInterface are ignored
+func (r *eventRouter) add(usecase api.UseCaseInterface) {
+	handler, ok := usecase.(spineapi.EventHandlerInterface)
+	if !ok {
+		return
+	}
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if index := r.indexOf(usecase); index >= 0 {
+		if r.entries[index].disabled {
+			r.entries[index].disabled = false
+			r.rebuild()
+		}
+		return
+	}
+
+	r.entries = append(r.entries, routerEntry{
+		usecase:  usecase,
+		handler:  handler,
+		interest: usecase.EventInterest(),
+	})
+	r.rebuild()
+}
+
+// stop passing the data update events to a use case
+func (r *eventRouter) disable(usecase api.UseCaseInterface) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if index := r.indexOf(usecase); index >= 0 && !r.entries[index].disabled {
+		r.entries[index].disabled = true
+		r.rebuild()
+	}
+}
+
+// unregister a use case
+func (r *eventRouter) remove(usecase api.UseCaseInterface) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.entries = slices.DeleteFunc(r.entries, func(item routerEntry) bool { return item.usecase == usecase })
+	r.rebuild()
+}
+
+// returns the index of the entry of a use case, -1 if it is not registered
+func (r *eventRouter) indexOf(usecase api.UseCaseInterface) int {
+	return slices.IndexFunc(r.entries, func(item routerEntry) bool { return item.usecase == usecase })
+}
+
+// rebuild the index from the registered use cases, has to be called with the lock held
+//
+// the index slices are replaced and never modified, so they can be used without the lock
+func (r *eventRouter) rebuild() {
+	deviceHandlers := []spineapi.EventHandlerInterface{}
+	entityHandlers := make(map[model.EntityTypeType][]spineapi.EventHandlerInterface)
+	dataHandlers := make(map[dataRoute][]spineapi.EventHandlerInterface)
+	dataUseCases := make(map[dataRoute][]api.UseCaseInterface)
+
+	for _, entry := range r.entries {
+		if entry.interest.DeviceEvents {
+			deviceHandlers = append(deviceHandlers, entry.handler)
+		}
+
+		for _, entityType := range entry.interest.EntityTypes {
+			entityHandlers[entityType] = append(entityHandlers[entityType], entry.handler)
+
+			if entry.disabled {
+				continue
+			}
+
+			for _, function := range entry.interest.Functions {
+				key := dataRoute{entityType: entityType, function: function}
+				dataHandlers[key] = append(dataHandlers[key], entry.handler)
+				dataUseCases[key] = append(dataUseCases[key], entry.usecase)
+
+				for _, featureType := range entry.interest.FeatureTypes {
+					key.featureType = featureType
+					dataHandlers[key] = append(dataHandlers[key], entry.handler)
+					dataUseCases[key] = append(dataUseCases[key], entry.usecase)
+				}
+			}
+		}
+	}
+
+	r.deviceHandlers = deviceHandlers
+	r.entityHandlers = entityHandlers
+	r.dataHandlers = dataHandlers
+	r.dataUseCases = dataUseCases
+}
+
+// pass a SPINE event to the interested use cases
+func (r *eventRouter) HandleEvent(payload spineapi.EventPayload) {
+	for _, handler := range r.handlers(payload) {
+		handler.HandleEvent(payload)
+	}
+}
+
+// returns the handlers interested in an event
+func (r *eventRouter) handlers(payload spineapi.EventPayload) []spineapi.EventHandlerInterface {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	if len(r.entries) == 0 {
+		return nil
+	}
+
+	if payload.Entity == nil {
+		return r.deviceHandlers
+	}
+
+	if payload.EventType != spineapi.EventTypeDataChange || payload.Data == nil {
+		return r.entityHandlers[payload.Entity.EntityType()]
+	}
+
+	key, ok := dataRouteOf(payload)
+	if !ok {
+		return nil
+	}
+
+	return r.dataHandlers[key]
+}
+
+// returns the use cases interested in a data update event of a remote entity
+func (r *eventRouter) dataUseCasesOf(payload spineapi.EventPayload) []api.UseCaseInterface {
+	key, ok := dataRouteOf(payload)
+	if !ok {
+		return nil
+	}
+
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	return r.dataUseCases[key]
+}
+
+// returns the index key of a data update event of a remote entity
+func dataRouteOf(payload spineapi.EventPayload) (dataRoute, bool) {
+	if payload.Entity == nil || payload.Data == nil {
+		return dataRoute{}, false
+	}
+
+	function, ok := functionOfData[reflect.TypeOf(payload.Data)]
+	if !ok {
+		return dataRoute{}, false
+	}
+
+	key := dataRoute{entityType: payload.Entity.EntityType(), function: function}
+	if payload.Feature != nil {
+		key.featureType = payload.Feature.Type()
+	}
+
+	return key, true
+}
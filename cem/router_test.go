@@ -0,0 +1,279 @@
+package cem
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/uccevc"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevcem"
+	"github.com/enbility/cemd/ucevsecc"
+	"github.com/enbility/cemd/ucevsoc"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmpc"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/ucoscev"
+	"github.com/enbility/cemd/ucvabd"
+	"github.com/enbility/cemd/ucvapd"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/ship-go/cert"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/stretchr/testify/assert"
+)
+
+// a use case recording the events it receives
+type routedUseCase struct {
+	fakeUseCase
+
+	interest api.EventInterest
+	events   []spineapi.EventPayload
+}
+
+func (r *routedUseCase) EventInterest() api.EventInterest {
+	return r.interest
+}
+
+func (r *routedUseCase) HandleEvent(payload spineapi.EventPayload) {
+	r.events = append(r.events, payload)
+}
+
+func (s *CemSuite) Test_Router() {
+	err := s.sut.Setup()
+	assert.Nil(s.T(), err)
+
+	grid := &routedUseCase{
+		interest: api.EventInterest{
+			EntityTypes:  []model.EntityTypeType{model.EntityTypeTypeGridConnectionPointOfPremises},
+			FeatureTypes: []model.FeatureTypeType{model.FeatureTypeTypeMeasurement},
+			Functions:    []model.FunctionType{model.FunctionTypeMeasurementListData},
+		},
+	}
+	ev := &routedUseCase{
+		interest: api.EventInterest{
+			EntityTypes:  []model.EntityTypeType{model.EntityTypeTypeEV},
+			FeatureTypes: []model.FeatureTypeType{model.FeatureTypeTypeMeasurement},
+			Functions:    []model.FunctionType{model.FunctionTypeMeasurementListData},
+			DeviceEvents: true,
+		},
+	}
+	s.sut.AddUseCase(grid)
+	s.sut.AddUseCase(ev)
+
+	remoteDevice := s.setupRemoteDevice()
+	entity := remoteDevice.Entity([]model.AddressEntityType{1})
+	measurement := remoteDevice.FeatureByEntityTypeAndRole(entity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	electricalConnection := remoteDevice.FeatureByEntityTypeAndRole(entity, model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+
+	payload := spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     remoteDevice,
+		Entity:     entity,
+		Feature:    measurement,
+		EventType:  spineapi.EventTypeDataChange,
+		ChangeType: spineapi.ElementChangeUpdate,
+		Data:       &model.MeasurementListDataType{},
+	}
+
+	// the event is passed only once, even though it is published on the global event bus
+	spine.Events.Publish(payload)
+	assert.Equal(s.T(), 1, len(grid.events))
+	assert.Equal(s.T(), 0, len(ev.events))
+
+	// a function the use case is not interested in
+	payload.Data = &model.MeasurementDescriptionListDataType{}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), 1, len(grid.events))
+
+	// a feature the use case is not interested in
+	payload.Feature = electricalConnection
+	payload.Data = &model.MeasurementListDataType{}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), 1, len(grid.events))
+
+	// entity events are passed by the entity type
+	entityPayload := spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     remoteDevice,
+		Entity:     entity,
+		EventType:  spineapi.EventTypeEntityChange,
+		ChangeType: spineapi.ElementChangeAdd,
+	}
+	s.sut.HandleEvent(entityPayload)
+	assert.Equal(s.T(), 2, len(grid.events))
+	assert.Equal(s.T(), 0, len(ev.events))
+
+	// device events are passed to the use cases interested in them
+	devicePayload := spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     remoteDevice,
+		EventType:  spineapi.EventTypeDeviceChange,
+		ChangeType: spineapi.ElementChangeRemove,
+	}
+	s.sut.HandleEvent(devicePayload)
+	assert.Equal(s.T(), 2, len(grid.events))
+	assert.Equal(s.T(), 1, len(ev.events))
+
+	// a disabled use case receives the entity events, but no data update events
+	err = s.sut.DisableUseCase(grid)
+	assert.Nil(s.T(), err)
+
+	payload.Feature = measurement
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), 2, len(grid.events))
+
+	s.sut.HandleEvent(entityPayload)
+	assert.Equal(s.T(), 3, len(grid.events))
+
+	err = s.sut.EnableUseCase(grid)
+	assert.Nil(s.T(), err)
+
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), 4, len(grid.events))
+
+	err = s.sut.RemoveUseCase(grid)
+	assert.Nil(s.T(), err)
+
+	s.sut.HandleEvent(entityPayload)
+	assert.Equal(s.T(), 4, len(grid.events))
+}
+
+// discards all SHIP messages
+type discardWriter struct{}
+
+func (d discardWriter) WriteShipMessageWithPayload([]byte) {}
+
+// returns a CEM with all use cases and data update events for each of their entity types
+func setupBenchmark(b *testing.B) (*Cem, []api.UseCaseInterface, []spineapi.EventPayload) {
+	certificate, err := cert.CreateCertificate("Demo", "Demo", "DE", "Demo-Unit-12")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	configuration, err := eebusapi.NewConfiguration(
+		"Demo", "Demo", "HEMS", "192837465",
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		7656, certificate, 230, time.Second*4)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	eventCB := func(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	}
+
+	sut := NewCEM(configuration, &CemSuite{}, eventCB, &logging.NoLogging{})
+	if err := sut.Setup(); err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(sut.Shutdown)
+
+	usecases := []api.UseCaseInterface{
+		uccevc.NewUCCEVC(sut.Service, eventCB),
+		ucevcc.NewUCEVCC(sut.Service, eventCB),
+		ucevcem.NewUCEVCEM(sut.Service, eventCB),
+		ucevsecc.NewUCEVSECC(sut.Service, eventCB),
+		ucevsoc.NewUCEVSOC(sut.Service, eventCB),
+		ucmgcp.NewUCMGCP(sut.Service, eventCB),
+		ucmpc.NewUCMPC(sut.Service, eventCB),
+		ucopev.NewUCOPEV(sut.Service, eventCB),
+		ucoscev.NewUCOSCEV(sut.Service, eventCB),
+		ucvabd.NewUCVABD(sut.Service, eventCB),
+		ucvapd.NewUCVAPD(sut.Service, eventCB),
+	}
+	for _, usecase := range usecases {
+		sut.AddUseCase(usecase)
+	}
+
+	remoteDevice := spine.NewDeviceRemote(sut.Service.LocalDevice(), remoteSki, spine.NewSender(discardWriter{}))
+	sut.Service.LocalDevice().AddRemoteDeviceForSki(remoteSki, remoteDevice)
+
+	var events []spineapi.EventPayload
+	for index, entityType := range []model.EntityTypeType{
+		model.EntityTypeTypeEVSE,
+		model.EntityTypeTypeEV,
+		model.EntityTypeTypeGridConnectionPointOfPremises,
+		model.EntityTypeTypePVSystem,
+		model.EntityTypeTypeElectricityStorageSystem,
+		model.EntityTypeTypeHeatPumpAppliance,
+	} {
+		entity := spine.NewEntityRemote(remoteDevice, entityType, []model.AddressEntityType{model.AddressEntityType(index + 1)})
+		remoteDevice.AddEntity(entity)
+
+		measurement := spine.NewFeatureRemote(0, entity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+		entity.AddFeature(measurement)
+		diagnosis := spine.NewFeatureRemote(1, entity, model.FeatureTypeTypeDeviceDiagnosis, model.RoleTypeServer)
+		entity.AddFeature(diagnosis)
+
+		// measurement notifications and the heartbeats no use case handles
+		events = append(events, spineapi.EventPayload{
+			Ski:        remoteSki,
+			Device:     remoteDevice,
+			Entity:     entity,
+			Feature:    measurement,
+			EventType:  spineapi.EventTypeDataChange,
+			ChangeType: spineapi.ElementChangeUpdate,
+			Data:       &model.MeasurementListDataType{},
+		}, spineapi.EventPayload{
+			Ski:        remoteSki,
+			Device:     remoteDevice,
+			Entity:     entity,
+			Feature:    diagnosis,
+			EventType:  spineapi.EventTypeDataChange,
+			ChangeType: spineapi.ElementChangeUpdate,
+			Data:       &model.DeviceDiagnosisHeartbeatDataType{},
+		})
+	}
+
+	return sut, usecases, events
+}
+
+// measures the events per second handled by the CEM with all eleven use cases
+func BenchmarkRouter(b *testing.B) {
+	sut, usecases, events := setupBenchmark(b)
+
+	// the measurement notifications, which are sent most frequently
+	var measurements []spineapi.EventPayload
+	for _, event := range events {
+		if _, ok := event.Data.(*model.MeasurementListDataType); ok {
+			measurements = append(measurements, event)
+		}
+	}
+
+	// the CEM processes the event, including the use case readiness, and routes it
+	b.Run("cem", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sut.HandleEvent(events[i%len(events)])
+		}
+		b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "events/s")
+	})
+
+	b.Run("cem measurements", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sut.HandleEvent(measurements[i%len(measurements)])
+		}
+		b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "events/s")
+	})
+
+	b.Run("router", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sut.router.HandleEvent(events[i%len(events)])
+		}
+		b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "events/s")
+	})
+
+	// the CEM processes the event and every use case inspects it, as without the router
+	b.Run("broadcast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			event := events[i%len(events)]
+			sut.handleEvent(event)
+			for _, usecase := range usecases {
+				usecase.(spineapi.EventHandlerInterface).HandleEvent(event)
+			}
+		}
+		b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "events/s")
+	})
+}
@@ -0,0 +1,243 @@
+package cem
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/enbility/cemd/api"
+	eebusapi "github.com/enbility/eebus-go/api"
+	shipapi "github.com/enbility/ship-go/api"
+	shiputil "github.com/enbility/ship-go/util"
+)
+
+var _ eebusapi.ServiceReaderInterface = (*Cem)(nil)
+
+// returns the EEBUS services currently visible via mDNS, sorted by SKI
+func (h *Cem) VisibleRemoteServices() []api.RemoteServiceInfo {
+	h.mux.Lock()
+	entries := slices.Clone(h.visible)
+	h.mux.Unlock()
+
+	result := make([]api.RemoteServiceInfo, 0, len(entries))
+	for _, entry := range entries {
+		info := api.RemoteServiceInfo{
+			Ski:        entry.Ski,
+			Name:       entry.Name,
+			Brand:      entry.Brand,
+			Model:      entry.Model,
+			DeviceType: entry.Type,
+		}
+
+		if details := h.Service.RemoteServiceForSKI(entry.Ski); details != nil {
+			info.Registered = details.Trusted()
+		}
+		if detail := h.Service.PairingDetailForSki(entry.Ski); detail != nil {
+			info.PairingState = detail.State()
+		}
+
+		result = append(result, info)
+	}
+
+	return result
+}
+
+// start the pairing process with a remote SKI, or approve an incoming pairing request
+func (h *Cem) StartPairing(ski string) {
+	normalizedSki := shiputil.NormalizeSKI(ski)
+
+	// the service reports the first state of the process synchronously
+	process := h.pairingProcess(normalizedSki)
+	h.mux.Lock()
+	process.started = true
+	h.mux.Unlock()
+
+	h.Service.InitiateOrApprovePairingWithSKI(normalizedSki)
+}
+
+// cancel the pairing process with a remote SKI, or deny an incoming pairing request
+func (h *Cem) CancelPairing(ski string) {
+	h.Service.CancelPairingWithSKI(shiputil.NormalizeSKI(ski))
+}
+
+// report a connection to a SKI
+func (h *Cem) RemoteSKIConnected(service eebusapi.ServiceInterface, ski string) {
+	if h.serviceReader != nil {
+		h.serviceReader.RemoteSKIConnected(service, ski)
+	}
+}
+
+// report a disconnection to a SKI
+func (h *Cem) RemoteSKIDisconnected(service eebusapi.ServiceInterface, ski string) {
+	if h.serviceReader != nil {
+		h.serviceReader.RemoteSKIDisconnected(service, ski)
+	}
+}
+
+// report all currently visible EEBUS services
+//
+// the RemoteServicesUpdated event is sent if the list changed
+func (h *Cem) VisibleRemoteServicesUpdated(service eebusapi.ServiceInterface, entries []shipapi.RemoteService) {
+	visible := make([]shipapi.RemoteService, 0, len(entries))
+	for _, entry := range entries {
+		entry.Ski = shiputil.NormalizeSKI(entry.Ski)
+		visible = append(visible, entry)
+	}
+
+	sort.Slice(visible, func(i, j int) bool {
+		return visible[i].Ski < visible[j].Ski
+	})
+
+	h.mux.Lock()
+	changed := !slices.Equal(h.visible, visible)
+	h.visible = visible
+	h.mux.Unlock()
+
+	if changed {
+		h.eventCB("", nil, nil, RemoteServicesUpdated)
+	}
+
+	if h.serviceReader != nil {
+		h.serviceReader.VisibleRemoteServicesUpdated(service, entries)
+	}
+}
+
+// Provides the SHIP ID the remote service reported during the handshake process
+func (h *Cem) ServiceShipIDUpdate(ski string, shipID string) {
+	if h.serviceReader != nil {
+		h.serviceReader.ServiceShipIDUpdate(ski, shipID)
+	}
+}
+
+// Provides the current pairing state for the remote service
+//
+// the pairing event is sent before the state is forwarded, as the service
+// handler may approve an incoming pairing request, which reports the next
+// state synchronously
+func (h *Cem) ServicePairingDetailUpdate(ski string, detail *shipapi.ConnectionStateDetail) {
+	if detail != nil {
+		normalizedSki := shiputil.NormalizeSKI(ski)
+		process := h.pairingProcess(normalizedSki)
+
+		h.mux.Lock()
+		event, ok := process.update(detail.State())
+		if process.finished() {
+			delete(h.pairing, normalizedSki)
+		}
+		h.mux.Unlock()
+
+		if ok {
+			h.eventCB(normalizedSki, nil, nil, event)
+		}
+	}
+
+	if h.serviceReader != nil {
+		h.serviceReader.ServicePairingDetailUpdate(ski, detail)
+	}
+}
+
+// a pairing process with a remote service, from its first state until a final one
+type pairingProcess struct {
+	state shipapi.ConnectionState
+
+	// the remote service was trusted when the process started, e.g. a paired service reconnects
+	trusted bool
+
+	// the process was started or approved with StartPairing
+	started bool
+
+	// the last event sent for the process
+	event api.EventType
+}
+
+// returns the running pairing process of a SKI, a new one is added if there is none
+func (h *Cem) pairingProcess(ski string) *pairingProcess {
+	h.mux.Lock()
+	process, ok := h.pairing[ski]
+	h.mux.Unlock()
+	if ok {
+		return process
+	}
+
+	trusted := false
+	if details := h.Service.RemoteServiceForSKI(ski); details != nil {
+		trusted = details.Trusted()
+	}
+
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	// the process may have been added in the meantime
+	if process, ok := h.pairing[ski]; ok {
+		return process
+	}
+
+	process = &pairingProcess{
+		state:   shipapi.ConnectionStateNone,
+		trusted: trusted,
+	}
+	h.pairing[ski] = process
+
+	return process
+}
+
+// set the new state of the process and return its event, if there is one
+func (p *pairingProcess) update(state shipapi.ConnectionState) (api.EventType, bool) {
+	previous := p.state
+	p.state = state
+
+	if previous == state {
+		return "", false
+	}
+
+	var event api.EventType
+	switch state {
+	case shipapi.ConnectionStateReceivedPairingRequest:
+		event = PairingWaitingForTrust
+
+	case shipapi.ConnectionStateInProgress:
+		// after starting the pairing, the handshake waits for the remote service to trust this service
+		if !p.started {
+			return "", false
+		}
+		event = PairingWaitingForTrust
+
+	case shipapi.ConnectionStateTrusted, shipapi.ConnectionStateCompleted:
+		if p.trusted {
+			return "", false
+		}
+		event = PairingTrusted
+
+	case shipapi.ConnectionStateNone:
+		event = PairingCanceled
+
+	case shipapi.ConnectionStateRemoteDeniedTrust:
+		event = PairingDenied
+
+	case shipapi.ConnectionStateError:
+		event = PairingError
+
+	default:
+		return "", false
+	}
+
+	// e.g. an approved incoming request is in progress, or the handshake is completed after the trust
+	if event == p.event {
+		return "", false
+	}
+	p.event = event
+
+	return event, true
+}
+
+// returns if the process reached a final state
+func (p *pairingProcess) finished() bool {
+	switch p.state {
+	case shipapi.ConnectionStateNone,
+		shipapi.ConnectionStateCompleted,
+		shipapi.ConnectionStateRemoteDeniedTrust,
+		shipapi.ConnectionStateError:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,166 @@
+package cem
+
+import (
+	"github.com/enbility/cemd/api"
+	eebusmocks "github.com/enbility/eebus-go/mocks"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CemSuite) Test_VisibleRemoteServices() {
+	mockService := eebusmocks.NewServiceInterface(s.T())
+	s.sut.Service = mockService
+
+	assert.Equal(s.T(), 0, len(s.sut.VisibleRemoteServices()))
+
+	entries := []shipapi.RemoteService{
+		{Ski: "other", Brand: "Brand", Model: "Heatpump", Type: "HeatPumpAppliance"},
+		{Ski: "test remote ski", Brand: "Brand", Model: "Wallbox", Type: "ChargingStation"},
+	}
+
+	s.sut.VisibleRemoteServicesUpdated(mockService, entries)
+	assert.Equal(s.T(), []api.EventType{RemoteServicesUpdated}, s.events)
+
+	// unchanged lists are not reported
+	s.sut.VisibleRemoteServicesUpdated(mockService, entries)
+	assert.Equal(s.T(), []api.EventType{RemoteServicesUpdated}, s.events)
+
+	registered := shipapi.NewServiceDetails(remoteSki)
+	registered.SetTrusted(true)
+	mockService.EXPECT().RemoteServiceForSKI(remoteSki).Return(registered).Once()
+	mockService.EXPECT().PairingDetailForSki(remoteSki).Return(
+		shipapi.NewConnectionStateDetail(shipapi.ConnectionStateCompleted, nil)).Once()
+	mockService.EXPECT().RemoteServiceForSKI("other").Return(shipapi.NewServiceDetails("other")).Once()
+	mockService.EXPECT().PairingDetailForSki("other").Return(
+		shipapi.NewConnectionStateDetail(shipapi.ConnectionStateNone, nil)).Once()
+
+	services := s.sut.VisibleRemoteServices()
+	assert.Equal(s.T(), []api.RemoteServiceInfo{
+		{Ski: "other", Brand: "Brand", Model: "Heatpump", DeviceType: "HeatPumpAppliance", PairingState: shipapi.ConnectionStateNone},
+		{Ski: remoteSki, Brand: "Brand", Model: "Wallbox", DeviceType: "ChargingStation", Registered: true, PairingState: shipapi.ConnectionStateCompleted},
+	}, services)
+
+	s.events = nil
+	s.sut.VisibleRemoteServicesUpdated(mockService, nil)
+	assert.Equal(s.T(), []api.EventType{RemoteServicesUpdated}, s.events)
+	assert.Equal(s.T(), 0, len(s.sut.VisibleRemoteServices()))
+}
+
+func (s *CemSuite) Test_Pairing() {
+	mockService := eebusmocks.NewServiceInterface(s.T())
+	s.sut.Service = mockService
+
+	mockService.EXPECT().RemoteServiceForSKI(remoteSki).Return(shipapi.NewServiceDetails(remoteSki)).Once()
+	s.sut.ServicePairingDetailUpdate(remoteSki, shipapi.NewConnectionStateDetail(shipapi.ConnectionStateReceivedPairingRequest, nil))
+	s.sut.ServicePairingDetailUpdate(remoteSki, shipapi.NewConnectionStateDetail(shipapi.ConnectionStateReceivedPairingRequest, nil))
+	assert.Equal(s.T(), []api.EventType{PairingWaitingForTrust}, s.events)
+
+	// approving the request continues the running process
+	mockService.EXPECT().InitiateOrApprovePairingWithSKI(remoteSki).Return().Once()
+	s.sut.StartPairing("test remote ski")
+
+	states := []shipapi.ConnectionState{
+		shipapi.ConnectionStateInProgress,
+		shipapi.ConnectionStateTrusted,
+		shipapi.ConnectionStateCompleted,
+	}
+	for _, state := range states {
+		s.sut.ServicePairingDetailUpdate(remoteSki, shipapi.NewConnectionStateDetail(state, nil))
+	}
+	s.sut.ServicePairingDetailUpdate(remoteSki, nil)
+
+	assert.Equal(s.T(), []api.EventType{
+		PairingWaitingForTrust,
+		PairingTrusted,
+	}, s.events)
+	assert.Equal(s.T(), 0, len(s.sut.pairing))
+}
+
+func (s *CemSuite) Test_PairingStarted() {
+	mockService := eebusmocks.NewServiceInterface(s.T())
+	s.sut.Service = mockService
+
+	mockService.EXPECT().RemoteServiceForSKI(remoteSki).Return(nil).Once()
+	mockService.EXPECT().InitiateOrApprovePairingWithSKI(remoteSki).Return().Once()
+	s.sut.StartPairing("test remote ski")
+
+	states := []shipapi.ConnectionState{
+		shipapi.ConnectionStateQueued,
+		shipapi.ConnectionStateInitiated,
+		shipapi.ConnectionStateInProgress,
+		shipapi.ConnectionStateRemoteDeniedTrust,
+	}
+	for _, state := range states {
+		s.sut.ServicePairingDetailUpdate(remoteSki, shipapi.NewConnectionStateDetail(state, nil))
+	}
+
+	assert.Equal(s.T(), []api.EventType{
+		PairingWaitingForTrust,
+		PairingDenied,
+	}, s.events)
+	assert.Equal(s.T(), 0, len(s.sut.pairing))
+
+	// a new process is started after the final state
+	mockService.EXPECT().RemoteServiceForSKI(remoteSki).Return(nil).Once()
+	s.sut.ServicePairingDetailUpdate(remoteSki, shipapi.NewConnectionStateDetail(shipapi.ConnectionStateQueued, nil))
+	s.sut.ServicePairingDetailUpdate(remoteSki, shipapi.NewConnectionStateDetail(shipapi.ConnectionStateError, nil))
+
+	assert.Equal(s.T(), []api.EventType{
+		PairingWaitingForTrust,
+		PairingDenied,
+		PairingError,
+	}, s.events)
+	assert.Equal(s.T(), 0, len(s.sut.pairing))
+}
+
+func (s *CemSuite) Test_PairingCanceled() {
+	mockService := eebusmocks.NewServiceInterface(s.T())
+	s.sut.Service = mockService
+
+	mockService.EXPECT().RemoteServiceForSKI(remoteSki).Return(shipapi.NewServiceDetails(remoteSki)).Once()
+	s.sut.ServicePairingDetailUpdate(remoteSki, shipapi.NewConnectionStateDetail(shipapi.ConnectionStateReceivedPairingRequest, nil))
+
+	// denying the request reports the state synchronously
+	mockService.EXPECT().CancelPairingWithSKI(remoteSki).Run(func(ski string) {
+		s.sut.ServicePairingDetailUpdate(ski, shipapi.NewConnectionStateDetail(shipapi.ConnectionStateNone, nil))
+	}).Return().Once()
+	s.sut.CancelPairing("test remote ski")
+
+	assert.Equal(s.T(), []api.EventType{
+		PairingWaitingForTrust,
+		PairingCanceled,
+	}, s.events)
+	assert.Equal(s.T(), 0, len(s.sut.pairing))
+
+	// there is nothing to cancel without a running process
+	mockService.EXPECT().RemoteServiceForSKI(remoteSki).Return(nil).Once()
+	s.sut.ServicePairingDetailUpdate(remoteSki, shipapi.NewConnectionStateDetail(shipapi.ConnectionStateNone, nil))
+
+	assert.Equal(s.T(), 2, len(s.events))
+	assert.Equal(s.T(), 0, len(s.sut.pairing))
+}
+
+func (s *CemSuite) Test_PairingReconnect() {
+	mockService := eebusmocks.NewServiceInterface(s.T())
+	s.sut.Service = mockService
+
+	trusted := shipapi.NewServiceDetails(remoteSki)
+	trusted.SetTrusted(true)
+	mockService.EXPECT().RemoteServiceForSKI(remoteSki).Return(trusted).Twice()
+
+	states := []shipapi.ConnectionState{
+		shipapi.ConnectionStateQueued,
+		shipapi.ConnectionStateInitiated,
+		shipapi.ConnectionStateInProgress,
+		shipapi.ConnectionStateTrusted,
+		shipapi.ConnectionStateCompleted,
+	}
+	for i := 0; i < 2; i++ {
+		for _, state := range states {
+			s.sut.ServicePairingDetailUpdate(remoteSki, shipapi.NewConnectionStateDetail(state, nil))
+		}
+	}
+
+	assert.Equal(s.T(), 0, len(s.events))
+	assert.Equal(s.T(), 0, len(s.sut.pairing))
+}
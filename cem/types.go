@@ -9,4 +9,49 @@ const (
 
 	// A paired remote device was disconnected
 	DeviceDisconnected api.EventType = "deviceDisconnected"
+
+	// The entities or the announced use cases of a remote device changed, or the
+	// support of an added use case or the reason why it is not supported changed
+	//
+	// Use RemoteDevices to get the current use case support
+	RemoteDeviceUpdated api.EventType = "remoteDeviceUpdated"
+
+	// An added use case is now supported by a remote entity, or its supported scenarios changed
+	//
+	// The event is sent per use case, see UseCaseSupportedEvent and ParseUseCaseEvent.
+	// Use SupportedUseCases to get the supported scenarios of the entity.
+	UseCaseSupported api.EventType = "useCaseSupported"
+
+	// An added use case is no longer supported by a remote entity,
+	// e.g. because the entity or the device was removed
+	//
+	// The event is sent per use case, see UseCaseUnsupportedEvent and ParseUseCaseEvent.
+	UseCaseUnsupported api.EventType = "useCaseUnsupported"
+
+	// The list of EEBUS services visible via mDNS changed
+	//
+	// Use VisibleRemoteServices to get the services, the event is sent without a SKI
+	RemoteServicesUpdated api.EventType = "remoteServicesUpdated"
+
+	// A pairing process waits for the trust, either a remote service requests
+	// to be paired and waits for the trust to be approved with StartPairing or
+	// denied with CancelPairing, or the pairing was started with StartPairing
+	// and waits for the remote service to trust this service
+	PairingWaitingForTrust api.EventType = "pairingWaitingForTrust"
+
+	// The trust with a remote service was established in the SHIP handshake
+	//
+	// This is only sent for remote services which were not trusted before,
+	// not when a paired remote service reconnects
+	PairingTrusted api.EventType = "pairingTrusted"
+
+	// The pairing process with a remote service was canceled, e.g. with
+	// CancelPairing or by an aborted SHIP handshake
+	PairingCanceled api.EventType = "pairingCanceled"
+
+	// The remote service denied the trust
+	PairingDenied api.EventType = "pairingDenied"
+
+	// The pairing process with a remote service failed
+	PairingError api.EventType = "pairingError"
 )
@@ -0,0 +1,159 @@
+package cem
+
+import (
+	"slices"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Add a use case implementation
+func (h *Cem) AddUseCase(usecase api.UseCaseInterface) {
+	h.mux.Lock()
+	h.usecases = append(h.usecases, usecase)
+	h.mux.Unlock()
+
+	usecase.AddFeatures()
+	usecase.AddUseCase()
+
+	// the CEM passes only the events the use case is interested in
+	h.router.add(usecase)
+}
+
+// Remove a use case implementation
+//
+// the subscriptions and bindings of the client features no other use case uses are removed,
+// the features themselves stay part of the local entity
+//
+// possible errors:
+//   - ErrUnknownUseCase if the use case was not added
+func (h *Cem) RemoveUseCase(usecase api.UseCaseInterface) error {
+	h.mux.Lock()
+	if !slices.Contains(h.usecases, usecase) && !slices.Contains(h.disabled, usecase) {
+		h.mux.Unlock()
+		return api.ErrUnknownUseCase
+	}
+
+	h.usecases = slices.DeleteFunc(h.usecases, func(item api.UseCaseInterface) bool { return item == usecase })
+	h.disabled = slices.DeleteFunc(h.disabled, func(item api.UseCaseInterface) bool { return item == usecase })
+
+	var remaining []api.UseCaseInterface
+	remaining = append(remaining, h.usecases...)
+	remaining = append(remaining, h.disabled...)
+	h.mux.Unlock()
+
+	usecase.RemoveUseCase()
+	h.unsubscribeUseCase(usecase)
+	h.releaseFeatures(usecase, remaining)
+	h.updateAllReadiness()
+
+	return nil
+}
+
+// Enable a disabled use case
+//
+// possible errors:
+//   - ErrUnknownUseCase if the use case was not added
+func (h *Cem) EnableUseCase(usecase api.UseCaseInterface) error {
+	h.mux.Lock()
+	if slices.Contains(h.usecases, usecase) {
+		h.mux.Unlock()
+		return nil
+	}
+
+	if !slices.Contains(h.disabled, usecase) {
+		h.mux.Unlock()
+		return api.ErrUnknownUseCase
+	}
+
+	h.disabled = slices.DeleteFunc(h.disabled, func(item api.UseCaseInterface) bool { return item == usecase })
+	h.usecases = append(h.usecases, usecase)
+	h.mux.Unlock()
+
+	usecase.UpdateUseCaseAvailability(true)
+	h.router.add(usecase)
+	h.updateAllReadiness()
+
+	return nil
+}
+
+// Disable a use case
+//
+// possible errors:
+//   - ErrUnknownUseCase if the use case was not added
+func (h *Cem) DisableUseCase(usecase api.UseCaseInterface) error {
+	h.mux.Lock()
+	if slices.Contains(h.disabled, usecase) {
+		h.mux.Unlock()
+		return nil
+	}
+
+	if !slices.Contains(h.usecases, usecase) {
+		h.mux.Unlock()
+		return api.ErrUnknownUseCase
+	}
+
+	h.usecases = slices.DeleteFunc(h.usecases, func(item api.UseCaseInterface) bool { return item == usecase })
+	h.disabled = append(h.disabled, usecase)
+	h.mux.Unlock()
+
+	usecase.UpdateUseCaseAvailability(false)
+	h.router.disable(usecase)
+	h.updateAllReadiness()
+
+	return nil
+}
+
+// returns the added use cases which are not disabled
+func (h *Cem) UseCases() []api.UseCaseInterface {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	return slices.Clone(h.usecases)
+}
+
+// stop passing events to a use case
+func (h *Cem) unsubscribeUseCase(usecase api.UseCaseInterface) {
+	h.router.remove(usecase)
+}
+
+// remove the subscriptions and bindings of the client features of a removed use case
+// which none of the remaining use cases uses
+func (h *Cem) releaseFeatures(usecase api.UseCaseInterface, remaining []api.UseCaseInterface) {
+	if h.Service.LocalDevice() == nil {
+		return
+	}
+
+	localEntity := h.Service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	if localEntity == nil {
+		return
+	}
+
+	for _, featureType := range usecase.ClientFeatures() {
+		used := slices.ContainsFunc(remaining, func(item api.UseCaseInterface) bool {
+			return slices.Contains(item.ClientFeatures(), featureType)
+		})
+		if used {
+			continue
+		}
+
+		feature := localEntity.FeatureOfTypeAndRole(featureType, model.RoleTypeClient)
+		if feature == nil {
+			continue
+		}
+
+		feature.RemoveAllRemoteSubscriptions()
+		feature.RemoveAllRemoteBindings()
+	}
+}
+
+// re-check the use case support of all remote devices
+func (h *Cem) updateAllReadiness() {
+	if h.Service.LocalDevice() == nil {
+		return
+	}
+
+	for _, device := range h.Service.LocalDevice().RemoteDevices() {
+		h.updateReadiness(device, true)
+	}
+}
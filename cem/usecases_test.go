@@ -0,0 +1,149 @@
+package cem
+
+import (
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/ucevsecc"
+	"github.com/enbility/cemd/ucmgcp"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// returns the availability of a use case in the use case announcement of the local entity, nil if not announced
+func (s *CemSuite) localUseCaseAvailable(name model.UseCaseNameType) *bool {
+	nodeMgmt := s.sut.Service.LocalDevice().NodeManagement()
+	data, ok := nodeMgmt.DataCopy(model.FunctionTypeNodeManagementUseCaseData).(*model.NodeManagementUseCaseDataType)
+	if !ok || data == nil {
+		return nil
+	}
+
+	for _, info := range data.UseCaseInformation {
+		for _, support := range info.UseCaseSupport {
+			if support.UseCaseName != nil && *support.UseCaseName == name {
+				return support.UseCaseAvailable
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *CemSuite) Test_UseCaseLifecycle() {
+	err := s.sut.Setup()
+	assert.Nil(s.T(), err)
+
+	mgcp := ucmgcp.NewUCMGCP(s.sut.Service, s.eventCB)
+	evsecc := ucevsecc.NewUCEVSECC(s.sut.Service, s.eventCB)
+
+	assert.ErrorIs(s.T(), s.sut.DisableUseCase(mgcp), api.ErrUnknownUseCase)
+	assert.ErrorIs(s.T(), s.sut.EnableUseCase(mgcp), api.ErrUnknownUseCase)
+	assert.ErrorIs(s.T(), s.sut.RemoveUseCase(mgcp), api.ErrUnknownUseCase)
+
+	s.sut.AddUseCase(mgcp)
+	s.sut.AddUseCase(evsecc)
+	assert.Equal(s.T(), []api.UseCaseInterface{mgcp, evsecc}, s.sut.UseCases())
+
+	name := model.UseCaseNameTypeMonitoringOfGridConnectionPoint
+	assert.Equal(s.T(), true, *s.localUseCaseAvailable(name))
+
+	err = s.sut.DisableUseCase(mgcp)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []api.UseCaseInterface{evsecc}, s.sut.UseCases())
+	assert.Equal(s.T(), false, *s.localUseCaseAvailable(name))
+
+	// disabling twice is fine
+	err = s.sut.DisableUseCase(mgcp)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.EnableUseCase(mgcp)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []api.UseCaseInterface{evsecc, mgcp}, s.sut.UseCases())
+	assert.Equal(s.T(), true, *s.localUseCaseAvailable(name))
+
+	err = s.sut.EnableUseCase(mgcp)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.RemoveUseCase(mgcp)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []api.UseCaseInterface{evsecc}, s.sut.UseCases())
+	assert.Nil(s.T(), s.localUseCaseAvailable(name))
+
+	assert.ErrorIs(s.T(), s.sut.RemoveUseCase(mgcp), api.ErrUnknownUseCase)
+
+	// a removed use case can be added again
+	s.sut.AddUseCase(mgcp)
+	assert.Equal(s.T(), []api.UseCaseInterface{evsecc, mgcp}, s.sut.UseCases())
+	assert.Equal(s.T(), true, *s.localUseCaseAvailable(name))
+}
+
+func (s *CemSuite) Test_UseCaseLifecycleReadiness() {
+	err := s.sut.Setup()
+	assert.Nil(s.T(), err)
+
+	usecase := &fakeUseCase{supported: true}
+	s.sut.AddUseCase(usecase)
+
+	remoteDevice := s.setupRemoteDevice()
+	setUseCaseData(remoteDevice, []model.UseCaseScenarioSupportType{1, 2, 3, 4})
+	name := usecase.UseCaseName()
+
+	payload := measurementPayload(remoteDevice, &model.MeasurementListDataType{})
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), []api.EventType{RemoteDeviceUpdated, UseCaseSupportedEvent(name)}, s.events)
+
+	s.events = nil
+	err = s.sut.DisableUseCase(usecase)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []api.EventType{RemoteDeviceUpdated, UseCaseUnsupportedEvent(name)}, s.events)
+
+	// a disabled use case is not evaluated
+	checks := usecase.checks
+	s.sut.HandleEvent(measurementPayload(remoteDevice, &model.MeasurementDescriptionListDataType{}))
+	assert.Equal(s.T(), 2, len(s.events))
+	assert.Equal(s.T(), checks, usecase.checks)
+
+	s.events = nil
+	err = s.sut.EnableUseCase(usecase)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []api.EventType{RemoteDeviceUpdated, UseCaseSupportedEvent(name)}, s.events)
+
+	s.events = nil
+	err = s.sut.RemoveUseCase(usecase)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []api.EventType{RemoteDeviceUpdated, UseCaseUnsupportedEvent(name)}, s.events)
+	assert.Equal(s.T(), 0, len(s.sut.UseCases()))
+}
+
+func (s *CemSuite) Test_UseCaseDisabledEntityRemoved() {
+	err := s.sut.Setup()
+	assert.Nil(s.T(), err)
+
+	mgcp := ucmgcp.NewUCMGCP(s.sut.Service, s.eventCB)
+	s.sut.AddUseCase(mgcp)
+
+	remoteDevice := s.setupRemoteDevice()
+	entity := remoteDevice.Entity([]model.AddressEntityType{1})
+
+	payload := spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     remoteDevice,
+		Entity:     entity,
+		EventType:  spineapi.EventTypeEntityChange,
+		ChangeType: spineapi.ElementChangeAdd,
+	}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), []spineapi.EntityRemoteInterface{entity}, mgcp.CompatibleEntities())
+
+	err = s.sut.DisableUseCase(mgcp)
+	assert.Nil(s.T(), err)
+
+	// the entity is removed while the use case is disabled
+	s.events = nil
+	payload.ChangeType = spineapi.ElementChangeRemove
+	s.sut.HandleEvent(payload)
+	assert.Contains(s.T(), s.events, ucmgcp.EntityRemoved)
+
+	err = s.sut.EnableUseCase(mgcp)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(mgcp.CompatibleEntities()))
+}
@@ -0,0 +1,196 @@
+package cemtest
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevcem"
+	"github.com/enbility/cemd/ucevsecc"
+	"github.com/enbility/cemd/ucevsoc"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusmocks "github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestCemTestSuite(t *testing.T) {
+	suite.Run(t, new(CemTestSuite))
+}
+
+type CemTestSuite struct {
+	suite.Suite
+
+	service eebusapi.ServiceInterface
+
+	evcc   *ucevcc.UCEVCC
+	evcem  *ucevcem.UCEVCEM
+	evsecc *ucevsecc.UCEVSECC
+	evsoc  *ucevsoc.UCEVSOC
+	opev   *ucopev.UCOPEV
+
+	events []api.EventType
+	mux    sync.Mutex
+}
+
+func (s *CemTestSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.events = append(s.events, event)
+}
+
+func (s *CemTestSuite) BeforeTest(suiteName, testName string) {
+	s.events = nil
+
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := eebusapi.NewConfiguration(
+		"test", "test", "test", "test",
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		9999, cert, 230.0, time.Second*4)
+
+	serviceHandler := eebusmocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+
+	s.evcc = ucevcc.NewUCEVCC(s.service, s.Event)
+	s.evcem = ucevcem.NewUCEVCEM(s.service, s.Event)
+	s.evsecc = ucevsecc.NewUCEVSECC(s.service, s.Event)
+	s.evsoc = ucevsoc.NewUCEVSOC(s.service, s.Event)
+	s.opev = ucopev.NewUCOPEV(s.service, s.Event)
+	for _, usecase := range []api.UseCaseInterface{s.evcc, s.evcem, s.evsecc, s.evsoc, s.opev} {
+		usecase.AddFeatures()
+		usecase.AddUseCase()
+		_ = util.SubscribeEvents(s.service, usecase.(spineapi.EventHandlerInterface))
+	}
+}
+
+func (s *CemTestSuite) AfterTest(suiteName, testName string) {
+	util.RemoveServiceEvents(s.service)
+}
+
+func (s *CemTestSuite) Test_EVISO15118WithSoC() {
+	device := NewDevice("ski")
+	evse := EVSE3Phase(device)
+	ev := EVISO15118WithSoC(evse, 42)
+	assert.Nil(s.T(), ev.Entity())
+
+	remote := device.Build(s.service)
+	assert.Equal(s.T(), remote.Device, s.service.LocalDevice().RemoteDeviceForSki("ski"))
+	assert.Equal(s.T(), 2, len(remote.Entities()))
+	assert.Equal(s.T(), []model.AddressEntityType{1, 1}, ev.Entity().Address().Entity)
+
+	for _, usecase := range []api.UseCaseInterface{s.evcc, s.evcem, s.evsoc, s.opev} {
+		supported, err := usecase.IsUseCaseSupported(ev.Entity())
+		assert.Nil(s.T(), err)
+		assert.True(s.T(), supported, usecase.UseCaseName())
+	}
+	supported, err := s.evsecc.IsUseCaseSupported(evse.Entity())
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), supported)
+
+	soc, err := s.evsoc.StateOfCharge(ev.Entity())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 42.0, soc)
+
+	standard, err := s.evcc.CommunicationStandard(ev.Entity())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceConfigurationKeyValueStringTypeISO151182ED1, standard)
+
+	identifications, err := s.evcc.Identifications(ev.Entity())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(identifications))
+	assert.Equal(s.T(), EVIdentification, identifications[0].Value)
+
+	minimum, maximum, _, err := s.evcc.CurrentLimits(ev.Entity())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{EVMinCurrent, EVMinCurrent, EVMinCurrent}, minimum)
+	assert.Equal(s.T(), []float64{EVMaxCurrent, EVMaxCurrent, EVMaxCurrent}, maximum)
+
+	currents, err := s.evcem.CurrentPerPhase(ev.Entity())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{EVCurrent, EVCurrent, EVCurrent}, currents)
+
+	energy, err := s.evcem.EnergyCharged(ev.Entity())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), EVEnergyCharged, energy)
+
+	limits, err := s.opev.LoadControlLimits(ev.Entity())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(limits))
+
+	name, serial, err := s.evsecc.ManufacturerData(evse.Entity())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "Wallbox", name)
+	assert.Equal(s.T(), "evse-0001", serial)
+
+	state, _, err := s.evsecc.OperatingState(evse.Entity())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceDiagnosisOperatingStateTypeNormalOperation, state)
+}
+
+func (s *CemTestSuite) Test_EVIEC61851() {
+	device := NewDevice("ski")
+	ev := EVIEC61851(EVSE3Phase(device))
+	device.Build(s.service)
+
+	supported, err := s.evsoc.IsUseCaseSupported(ev.Entity())
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), supported)
+
+	_, err = s.evsoc.StateOfCharge(ev.Entity())
+	assert.NotNil(s.T(), err)
+
+	standard, err := s.evcc.CommunicationStandard(ev.Entity())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceConfigurationKeyValueStringTypeIEC61851, standard)
+}
+
+func (s *CemTestSuite) Test_Builder() {
+	device := NewDevice("ski").
+		WithAddress("d:_i:meter").
+		WithDeviceType(model.DeviceTypeTypeElectricitySupplySystem)
+	meter := device.AddEntity(model.EntityTypeTypeGridConnectionPointOfPremises, 1).
+		WithData(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData, nil).
+		WithClientFeature(model.FeatureTypeTypeLoadControl).
+		WithUseCase(model.UseCaseActorTypeGridConnectionPoint, model.UseCaseNameTypeMonitoringOfGridConnectionPoint, 2, 3, 4)
+
+	remote := device.Build(s.service)
+	assert.Equal(s.T(), model.AddressDeviceType("d:_i:meter"), *remote.Device.Address())
+	assert.Equal(s.T(), model.DeviceTypeTypeElectricitySupplySystem, *remote.Device.DeviceType())
+
+	features := meter.Entity().Features()
+	assert.Equal(s.T(), 2, len(features))
+	assert.Equal(s.T(), model.RoleTypeServer, features[0].Role())
+	assert.Equal(s.T(), model.RoleTypeClient, features[1].Role())
+
+	useCases := remote.Device.UseCases()
+	assert.Equal(s.T(), 1, len(useCases))
+	assert.Equal(s.T(), meter.Address(), useCases[0].Address.Entity)
+}
+
+func (s *CemTestSuite) Test_PublishEntities() {
+	device := NewDevice("ski")
+	EVISO15118WithSoC(EVSE3Phase(device), 42)
+	device.Build(s.service).PublishEntities()
+
+	assert.Eventually(s.T(), func() bool {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+
+		return slices.Contains(s.events, ucevsoc.EntityAdded) && slices.Contains(s.events, ucevsecc.EntityAdded)
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,204 @@
+// Package cemtest builds fake remote devices for tests of applications using the use cases
+//
+// The devices are added to the local device of a service like a device connected via
+// SHIP, but without a connection: the features of the remote entities are preloaded
+// with data and the messages the local device sends are dropped, unless a writer is set.
+//
+//	device := cemtest.NewDevice("ski")
+//	evse := cemtest.EVSE3Phase(device)
+//	ev := cemtest.EVISO15118WithSoC(evse, 42)
+//	device.Build(service).PublishEntities()
+//
+//	soc, err := evsoc.StateOfCharge(ev.Entity())
+package cemtest
+
+import (
+	"slices"
+
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusutil "github.com/enbility/eebus-go/util"
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+// DeviceBuilder describes a fake remote device with its entities
+type DeviceBuilder struct {
+	ski        string
+	address    model.AddressDeviceType
+	deviceType model.DeviceTypeType
+
+	writer shipapi.ShipConnectionDataWriterInterface
+
+	entities []*EntityBuilder
+}
+
+// create a builder for a remote device
+//
+// parameters:
+//   - ski: the SKI of the remote device, the device address is derived from it
+func NewDevice(ski string) *DeviceBuilder {
+	return &DeviceBuilder{
+		ski:        ski,
+		address:    model.AddressDeviceType("d:_i:" + ski),
+		deviceType: model.DeviceTypeTypeGeneric,
+	}
+}
+
+// set the device address, the default is "d:_i:" followed by the SKI
+func (d *DeviceBuilder) WithAddress(address string) *DeviceBuilder {
+	d.address = model.AddressDeviceType(address)
+	return d
+}
+
+// set the device type, the default is generic
+func (d *DeviceBuilder) WithDeviceType(deviceType model.DeviceTypeType) *DeviceBuilder {
+	d.deviceType = deviceType
+	return d
+}
+
+// set the writer the messages of the local device to the remote device are passed to,
+// by default they are dropped
+func (d *DeviceBuilder) WithWriter(writer shipapi.ShipConnectionDataWriterInterface) *DeviceBuilder {
+	d.writer = writer
+	return d
+}
+
+// add an entity to the device
+//
+// parameters:
+//   - entityType: the type of the entity
+//   - address: the address of the entity, e.g. 1, 1 for the entity "1.1"
+func (d *DeviceBuilder) AddEntity(entityType model.EntityTypeType, address ...model.AddressEntityType) *EntityBuilder {
+	entity := &EntityBuilder{
+		device:     d,
+		entityType: entityType,
+		address:    slices.Clone(address),
+	}
+	d.entities = append(d.entities, entity)
+
+	return entity
+}
+
+// create the remote device and add it to the local device of the service
+//
+// The detailed discovery data and the use case data are applied like replies of
+// the remote device, but no events are published. Call PublishEntities on the
+// result to inform the use cases about the new entities.
+//
+// Building a device with the SKI of a known remote device replaces it.
+func (d *DeviceBuilder) Build(service eebusapi.ServiceInterface) *Device {
+	localDevice := service.LocalDevice()
+
+	writer := d.writer
+	if writer == nil {
+		writer = discardWriter{}
+	}
+	remoteDevice := spine.NewDeviceRemote(localDevice, d.ski, spine.NewSender(writer))
+
+	detailedData := &model.NodeManagementDetailedDiscoveryDataType{
+		DeviceInformation: &model.NodeManagementDetailedDiscoveryDeviceInformationType{
+			Description: &model.NetworkManagementDeviceDescriptionDataType{
+				DeviceAddress: &model.DeviceAddressType{
+					Device: eebusutil.Ptr(d.address),
+				},
+				DeviceType: eebusutil.Ptr(d.deviceType),
+			},
+		},
+	}
+	useCaseData := &model.NodeManagementUseCaseDataType{}
+
+	for _, entity := range d.entities {
+		detailedData.EntityInformation = append(detailedData.EntityInformation,
+			model.NodeManagementDetailedDiscoveryEntityInformationType{
+				Description: &model.NetworkManagementEntityDescriptionDataType{
+					EntityAddress: &model.EntityAddressType{
+						Device: eebusutil.Ptr(d.address),
+						Entity: entity.address,
+					},
+					EntityType: eebusutil.Ptr(entity.entityType),
+				},
+			})
+
+		for index, feature := range entity.features {
+			detailedData.FeatureInformation = append(detailedData.FeatureInformation,
+				feature.information(d.address, entity.address, index+1))
+		}
+
+		for _, useCase := range entity.useCases {
+			useCaseData.UseCaseInformation = append(useCaseData.UseCaseInformation,
+				useCase.information(d.address, entity.address))
+		}
+	}
+
+	remoteDevice.UpdateDevice(detailedData.DeviceInformation.Description)
+	// the entity information is validated before, so no error is expected
+	entities, _ := remoteDevice.AddEntityAndFeatures(true, detailedData)
+
+	nodeManagement := remoteDevice.FeatureByEntityTypeAndRole(
+		remoteDevice.Entity(spine.DeviceInformationAddressEntity),
+		model.FeatureTypeTypeNodeManagement,
+		model.RoleTypeSpecial)
+	_ = nodeManagement.UpdateData(model.FunctionTypeNodeManagementUseCaseData, useCaseData, nil, nil)
+
+	for _, entity := range d.entities {
+		entity.entity = remoteDevice.Entity(entity.address)
+		for _, feature := range entity.features {
+			remoteFeature := entity.entity.FeatureOfTypeAndRole(feature.featureType, feature.role)
+			for _, function := range feature.functions {
+				if function.data != nil {
+					_ = remoteFeature.UpdateData(function.function, function.data, nil, nil)
+				}
+			}
+		}
+	}
+
+	localDevice.AddRemoteDeviceForSki(d.ski, remoteDevice)
+
+	return &Device{
+		Device:   remoteDevice,
+		entities: entities,
+	}
+}
+
+// Device is a built fake remote device
+type Device struct {
+	Device spineapi.DeviceRemoteInterface
+
+	// the entities added by the builder, without the device information entity
+	entities []spineapi.EntityRemoteInterface
+}
+
+// publish the events of a connected device and its new entities, like after the
+// detailed discovery of a device connected via SHIP
+func (d *Device) PublishEntities() *Device {
+	spine.Events.Publish(spineapi.EventPayload{
+		Ski:        d.Device.Ski(),
+		EventType:  spineapi.EventTypeDeviceChange,
+		ChangeType: spineapi.ElementChangeAdd,
+		Device:     d.Device,
+	})
+
+	for _, entity := range d.entities {
+		spine.Events.Publish(spineapi.EventPayload{
+			Ski:        d.Device.Ski(),
+			EventType:  spineapi.EventTypeEntityChange,
+			ChangeType: spineapi.ElementChangeAdd,
+			Device:     d.Device,
+			Entity:     entity,
+		})
+	}
+
+	return d
+}
+
+// return the entities added by the builder
+func (d *Device) Entities() []spineapi.EntityRemoteInterface {
+	return d.entities
+}
+
+// drops the messages the local device sends to a fake device
+type discardWriter struct{}
+
+func (d discardWriter) WriteShipMessageWithPayload([]byte) {}
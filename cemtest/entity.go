@@ -0,0 +1,183 @@
+package cemtest
+
+import (
+	"slices"
+
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// EntityBuilder describes an entity of a fake remote device with its features and use cases
+type EntityBuilder struct {
+	device *DeviceBuilder
+
+	entityType model.EntityTypeType
+	address    []model.AddressEntityType
+
+	features []*feature
+	useCases []useCase
+
+	// the remote entity, set when the device is built
+	entity spineapi.EntityRemoteInterface
+}
+
+// a server or client feature of a fake entity
+type feature struct {
+	featureType model.FeatureTypeType
+	role        model.RoleType
+	functions   []function
+}
+
+// a function of a feature with its operations and data
+type function struct {
+	function model.FunctionType
+	writable bool
+
+	// the data the feature is preloaded with, nil for none
+	data any
+}
+
+// a use case the device announces for an entity
+type useCase struct {
+	actor     model.UseCaseActorType
+	name      model.UseCaseNameType
+	scenarios []model.UseCaseScenarioSupportType
+}
+
+// returns the device the entity belongs to
+func (e *EntityBuilder) Device() *DeviceBuilder {
+	return e.device
+}
+
+// returns the address of the entity
+func (e *EntityBuilder) Address() []model.AddressEntityType {
+	return slices.Clone(e.address)
+}
+
+// add an entity with an address below this entity, e.g. the EV of an EVSE
+func (e *EntityBuilder) AddEntity(entityType model.EntityTypeType, id model.AddressEntityType) *EntityBuilder {
+	return e.device.AddEntity(entityType, append(e.Address(), id)...)
+}
+
+// add a readable function of a server feature with the data the feature is preloaded with
+//
+// The feature is added with the first of its functions. The data has to be a
+// pointer to the data type of the function, e.g. *model.MeasurementListDataType,
+// or nil if the remote device did not provide the data yet.
+func (e *EntityBuilder) WithData(featureType model.FeatureTypeType, fct model.FunctionType, data any) *EntityBuilder {
+	e.addFunction(featureType, model.RoleTypeServer, function{function: fct, data: data})
+	return e
+}
+
+// add a readable and writable function of a server feature with the data the feature is preloaded with
+func (e *EntityBuilder) WithWritableData(featureType model.FeatureTypeType, fct model.FunctionType, data any) *EntityBuilder {
+	e.addFunction(featureType, model.RoleTypeServer, function{function: fct, writable: true, data: data})
+	return e
+}
+
+// add a client feature without functions, like a device reading the data of others
+func (e *EntityBuilder) WithClientFeature(featureType model.FeatureTypeType) *EntityBuilder {
+	e.addFunction(featureType, model.RoleTypeClient, function{})
+	return e
+}
+
+// remove a feature added before, e.g. to build a device lacking a mandatory feature of a preset
+func (e *EntityBuilder) WithoutFeature(featureType model.FeatureTypeType, role model.RoleType) *EntityBuilder {
+	e.features = slices.DeleteFunc(e.features, func(item *feature) bool {
+		return item.featureType == featureType && item.role == role
+	})
+	return e
+}
+
+// announce a use case with the entity as the actor
+func (e *EntityBuilder) WithUseCase(actor model.UseCaseActorType, name model.UseCaseNameType, scenarios ...model.UseCaseScenarioSupportType) *EntityBuilder {
+	e.useCases = append(e.useCases, useCase{
+		actor:     actor,
+		name:      name,
+		scenarios: scenarios,
+	})
+	return e
+}
+
+// remove the announcements of a use case added before
+func (e *EntityBuilder) WithoutUseCase(name model.UseCaseNameType) *EntityBuilder {
+	e.useCases = slices.DeleteFunc(e.useCases, func(item useCase) bool {
+		return item.name == name
+	})
+	return e
+}
+
+// returns the remote entity, nil before the device is built
+func (e *EntityBuilder) Entity() spineapi.EntityRemoteInterface {
+	return e.entity
+}
+
+func (e *EntityBuilder) addFunction(featureType model.FeatureTypeType, role model.RoleType, fct function) {
+	var item *feature
+	for _, f := range e.features {
+		if f.featureType == featureType && f.role == role {
+			item = f
+			break
+		}
+	}
+
+	if item == nil {
+		item = &feature{featureType: featureType, role: role}
+		e.features = append(e.features, item)
+	}
+
+	if fct.function != "" {
+		item.functions = append(item.functions, fct)
+	}
+}
+
+// returns the detailed discovery information of the feature
+func (f *feature) information(device model.AddressDeviceType, entity []model.AddressEntityType, id int) model.NodeManagementDetailedDiscoveryFeatureInformationType {
+	var supportedFunctions []model.FunctionPropertyType
+	for _, fct := range f.functions {
+		operations := &model.PossibleOperationsType{
+			Read: &model.PossibleOperationsReadType{},
+		}
+		if fct.writable {
+			operations.Write = &model.PossibleOperationsWriteType{}
+		}
+
+		supportedFunctions = append(supportedFunctions, model.FunctionPropertyType{
+			Function:           eebusutil.Ptr(fct.function),
+			PossibleOperations: operations,
+		})
+	}
+
+	return model.NodeManagementDetailedDiscoveryFeatureInformationType{
+		Description: &model.NetworkManagementFeatureDescriptionDataType{
+			FeatureAddress: &model.FeatureAddressType{
+				Device:  eebusutil.Ptr(device),
+				Entity:  entity,
+				Feature: eebusutil.Ptr(model.AddressFeatureType(id)),
+			},
+			FeatureType:       eebusutil.Ptr(f.featureType),
+			Role:              eebusutil.Ptr(f.role),
+			SupportedFunction: supportedFunctions,
+		},
+	}
+}
+
+// returns the node management use case information of the use case
+func (u useCase) information(device model.AddressDeviceType, entity []model.AddressEntityType) model.UseCaseInformationDataType {
+	return model.UseCaseInformationDataType{
+		Address: &model.FeatureAddressType{
+			Device: eebusutil.Ptr(device),
+			Entity: entity,
+		},
+		Actor: eebusutil.Ptr(u.actor),
+		UseCaseSupport: []model.UseCaseSupportType{
+			{
+				UseCaseName:      eebusutil.Ptr(u.name),
+				UseCaseVersion:   eebusutil.Ptr(model.SpecificationVersionType("1.0.0")),
+				UseCaseAvailable: eebusutil.Ptr(true),
+				ScenarioSupport:  u.scenarios,
+			},
+		},
+	}
+}
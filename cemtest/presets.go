@@ -0,0 +1,597 @@
+package cemtest
+
+import (
+	"time"
+
+	"github.com/enbility/cemd/util"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+)
+
+// the values the preset entities are preloaded with
+const (
+	// the brand reported in the manufacturer data of all presets
+	Brand = "Demo"
+
+	// the MAC address an EV communicating via ISO15118-2 identifies with
+	EVIdentification = "0123456789AB"
+
+	// the minimum and maximum charging current per phase of the EVs in A
+	EVMinCurrent = 6.0
+	EVMaxCurrent = 16.0
+
+	// the charging current per phase of the EVs in A
+	EVCurrent = 10.0
+
+	// the voltage of each phase in V, the power per phase is EVCurrent * Voltage
+	Voltage = 230.0
+
+	// the energy the EVs charged in Wh
+	EVEnergyCharged = 5000.0
+
+	// the maximum current per phase of the EVSE in A
+	EVSEMaxCurrent = 32.0
+
+	// the maximum number of power limit and incentive slots the EVs accept
+	EVMaxSlots = 30
+
+	// the power at the grid connection point in W, a positive value is consumed from the grid
+	GridPower = 1000.0
+
+	// the PV curtailment limit factor of the grid connection point in %
+	GridCurtailmentLimitFactor = 70.0
+
+	// the peak power and the current production of the PV system in W
+	PVPeakPower = 10000.0
+	PVPower     = 4000.0
+
+	// the charging power of the battery system in W and its state of charge in %
+	BatteryPower         = 2000.0
+	BatteryStateOfCharge = 60.0
+
+	// the power the heat pump consumes in W
+	HeatPumpPower = 3000.0
+
+	// the frequency of the grid in Hz
+	Frequency = 50.0
+)
+
+// the measurement ids of the EV presets, the electrical connection parameter ids are the same
+const (
+	EVMeasurementIdCurrentL1 model.MeasurementIdType = iota
+	EVMeasurementIdCurrentL2
+	EVMeasurementIdCurrentL3
+	EVMeasurementIdPowerL1
+	EVMeasurementIdPowerL2
+	EVMeasurementIdPowerL3
+	EVMeasurementIdEnergyCharged
+	EVMeasurementIdStateOfCharge
+)
+
+// the load control limit ids of the EV presets, the overload protection limits come first,
+// followed by the self consumption limits, both for each phase
+const (
+	EVLimitIdObligation     model.LoadControlLimitIdType = 0
+	EVLimitIdRecommendation model.LoadControlLimitIdType = 3
+)
+
+// add a 3-phase EVSE with the entity address 1
+//
+// The EVSE is in normal operation, announces the EVSECC use case and provides
+// its manufacturer data and the permitted current per phase of up to EVSEMaxCurrent.
+func EVSE3Phase(device *DeviceBuilder) *EntityBuilder {
+	evse := device.AddEntity(model.EntityTypeTypeEVSE, 1)
+
+	withManufacturer(evse, "Wallbox", "evse-0001")
+	withOperatingState(evse, model.DeviceDiagnosisOperatingStateTypeNormalOperation)
+
+	var parameters []parameter
+	for _, phase := range util.PhaseNameMapping {
+		parameters = append(parameters, parameter{phase: phase, min: 0, max: EVSEMaxCurrent})
+	}
+	withElectricalConnection(evse, model.EnergyDirectionTypeConsume, parameters...)
+
+	evse.WithUseCase(model.UseCaseActorTypeEVSE, model.UseCaseNameTypeEVSECommissioningAndConfiguration, 1, 2)
+
+	return evse
+}
+
+// add an EV communicating via ISO15118-2 to an EVSE, the EV entity address is the EVSE address followed by 1
+//
+// The EV charges with EVCurrent on 3 phases, reports its state of charge in %,
+// identifies with EVIdentification and has writable overload protection and self
+// consumption limits. It announces the EVCC, EVCEM, EVSOC, OPEV and OSCEV use cases.
+func EVISO15118WithSoC(evse *EntityBuilder, soc float64) *EntityBuilder {
+	ev := addEV(evse, model.DeviceConfigurationKeyValueStringTypeISO151182ED1, &soc)
+
+	ev.WithData(model.FeatureTypeTypeIdentification, model.FunctionTypeIdentificationListData,
+		&model.IdentificationListDataType{
+			IdentificationData: []model.IdentificationDataType{
+				{
+					IdentificationId:    eebusutil.Ptr(model.IdentificationIdType(0)),
+					IdentificationType:  eebusutil.Ptr(model.IdentificationTypeTypeEui48),
+					IdentificationValue: eebusutil.Ptr(model.IdentificationValueType(EVIdentification)),
+				},
+			},
+		})
+
+	ev.WithUseCase(model.UseCaseActorTypeEV, model.UseCaseNameTypeEVStateOfCharge, 1)
+
+	return ev
+}
+
+// add an EV communicating via IEC61851 to an EVSE, the EV entity address is the EVSE address followed by 1
+//
+// Like EVISO15118WithSoC, but the EV neither reports its state of charge nor an identification.
+func EVIEC61851(evse *EntityBuilder) *EntityBuilder {
+	return addEV(evse, model.DeviceConfigurationKeyValueStringTypeIEC61851, nil)
+}
+
+// add the time series and incentive table of the coordinated charging to an EV
+//
+// The EV has a writable time series for the power limits and a writable incentive
+// table with one tariff, both accept 1 to EVMaxSlots slots. It announces the CEVC use case.
+func EVCoordinatedCharging(ev *EntityBuilder) *EntityBuilder {
+	ev.WithWritableData(model.FeatureTypeTypeTimeSeries, model.FunctionTypeTimeSeriesDescriptionListData,
+		&model.TimeSeriesDescriptionListDataType{
+			TimeSeriesDescriptionData: []model.TimeSeriesDescriptionDataType{
+				{
+					TimeSeriesId:        eebusutil.Ptr(model.TimeSeriesIdType(0)),
+					TimeSeriesType:      eebusutil.Ptr(model.TimeSeriesTypeTypeConstraints),
+					TimeSeriesWriteable: eebusutil.Ptr(true),
+					UpdateRequired:      eebusutil.Ptr(false),
+					Unit:                eebusutil.Ptr(model.UnitOfMeasurementTypeW),
+				},
+				{
+					TimeSeriesId:        eebusutil.Ptr(model.TimeSeriesIdType(1)),
+					TimeSeriesType:      eebusutil.Ptr(model.TimeSeriesTypeTypePlan),
+					TimeSeriesWriteable: eebusutil.Ptr(false),
+					Unit:                eebusutil.Ptr(model.UnitOfMeasurementTypeW),
+				},
+				{
+					TimeSeriesId:        eebusutil.Ptr(model.TimeSeriesIdType(2)),
+					TimeSeriesType:      eebusutil.Ptr(model.TimeSeriesTypeTypeSingleDemand),
+					TimeSeriesWriteable: eebusutil.Ptr(false),
+					Unit:                eebusutil.Ptr(model.UnitOfMeasurementTypeWh),
+				},
+			},
+		})
+	ev.WithData(model.FeatureTypeTypeTimeSeries, model.FunctionTypeTimeSeriesConstraintsListData,
+		&model.TimeSeriesConstraintsListDataType{
+			TimeSeriesConstraintsData: []model.TimeSeriesConstraintsDataType{
+				{
+					TimeSeriesId: eebusutil.Ptr(model.TimeSeriesIdType(0)),
+					SlotCountMin: eebusutil.Ptr(model.TimeSeriesSlotCountType(1)),
+					SlotCountMax: eebusutil.Ptr(model.TimeSeriesSlotCountType(EVMaxSlots)),
+				},
+			},
+		})
+	ev.WithWritableData(model.FeatureTypeTypeTimeSeries, model.FunctionTypeTimeSeriesListData, nil)
+
+	ev.WithWritableData(model.FeatureTypeTypeIncentiveTable, model.FunctionTypeIncentiveTableDescriptionData,
+		&model.IncentiveTableDescriptionDataType{
+			IncentiveTableDescription: []model.IncentiveTableDescriptionType{
+				{
+					TariffDescription: &model.TariffDescriptionDataType{
+						TariffId:        eebusutil.Ptr(model.TariffIdType(0)),
+						TariffWriteable: eebusutil.Ptr(true),
+						UpdateRequired:  eebusutil.Ptr(false),
+						ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeSimpleIncentiveTable),
+					},
+					Tier: []model.IncentiveTableDescriptionTierType{
+						{
+							TierDescription: &model.TierDescriptionDataType{
+								TierId:   eebusutil.Ptr(model.TierIdType(0)),
+								TierType: eebusutil.Ptr(model.TierTypeTypeDynamicCost),
+							},
+							BoundaryDescription: []model.TierBoundaryDescriptionDataType{
+								{
+									BoundaryId:   eebusutil.Ptr(model.TierBoundaryIdType(0)),
+									BoundaryType: eebusutil.Ptr(model.TierBoundaryTypeTypePowerBoundary),
+									BoundaryUnit: eebusutil.Ptr(model.UnitOfMeasurementTypeW),
+								},
+							},
+							IncentiveDescription: []model.IncentiveDescriptionDataType{
+								{
+									IncentiveId:   eebusutil.Ptr(model.IncentiveIdType(0)),
+									IncentiveType: eebusutil.Ptr(model.IncentiveTypeTypeAbsoluteCost),
+									Currency:      eebusutil.Ptr(model.CurrencyTypeEur),
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	ev.WithData(model.FeatureTypeTypeIncentiveTable, model.FunctionTypeIncentiveTableConstraintsData,
+		&model.IncentiveTableConstraintsDataType{
+			IncentiveTableConstraints: []model.IncentiveTableConstraintsType{
+				{
+					Tariff: &model.TariffDataType{
+						TariffId: eebusutil.Ptr(model.TariffIdType(0)),
+					},
+					IncentiveSlotConstraints: &model.TimeTableConstraintsDataType{
+						SlotCountMin: eebusutil.Ptr(model.TimeSlotCountType(1)),
+						SlotCountMax: eebusutil.Ptr(model.TimeSlotCountType(EVMaxSlots)),
+					},
+				},
+			},
+		})
+	ev.WithWritableData(model.FeatureTypeTypeIncentiveTable, model.FunctionTypeIncentiveTableData, nil)
+
+	ev.WithUseCase(model.UseCaseActorTypeEV, model.UseCaseNameTypeCoordinatedEVCharging, 1, 2, 3, 4, 5, 6, 7, 8)
+
+	return ev
+}
+
+// add a grid connection point with the entity address 1, like a smart meter gateway
+//
+// The grid connection point consumes GridPower evenly on 3 phases, provides the
+// PV curtailment limit factor and announces the MGCP use case.
+func GridConnectionPoint(device *DeviceBuilder) *EntityBuilder {
+	grid := device.AddEntity(model.EntityTypeTypeGridConnectionPointOfPremises, 1)
+
+	withManufacturer(grid, "Smart Meter Gateway", "smgw-0001")
+	withConfiguration(grid, model.DeviceConfigurationKeyNameTypePvCurtailmentLimitFactor, model.UnitOfMeasurementTypepct, GridCurtailmentLimitFactor)
+
+	parameters := []parameter{{phase: model.ElectricalConnectionPhaseNameTypeAbc}, {}, {}}
+	measurements := []measurement{
+		{model.MeasurementTypeTypePower, model.ScopeTypeTypeACPowerTotal, model.UnitOfMeasurementTypeW, GridPower},
+		{model.MeasurementTypeTypeEnergy, model.ScopeTypeTypeGridFeedIn, model.UnitOfMeasurementTypeWh, 0},
+		{model.MeasurementTypeTypeEnergy, model.ScopeTypeTypeGridConsumption, model.UnitOfMeasurementTypeWh, 0},
+	}
+	parameters, measurements = withPhaseMeasurements(parameters, measurements, GridPower)
+
+	withElectricalConnection(grid, model.EnergyDirectionTypeConsume, parameters...)
+	withMeasurements(grid, measurements...)
+
+	grid.WithUseCase(model.UseCaseActorTypeGridConnectionPoint, model.UseCaseNameTypeMonitoringOfGridConnectionPoint, 1, 2, 3, 4, 5, 6, 7)
+
+	return grid
+}
+
+// add the inverter of a PV system with the entity address 1
+//
+// The PV system produces PVPower, provides its peak power and announces the VAPD use case.
+func PVSystem(device *DeviceBuilder) *EntityBuilder {
+	pv := device.AddEntity(model.EntityTypeTypePVSystem, 1)
+
+	withManufacturer(pv, "Inverter", "pv-0001")
+	withConfiguration(pv, model.DeviceConfigurationKeyNameTypePeakPowerOfPVSystem, model.UnitOfMeasurementTypeW, PVPeakPower)
+
+	withElectricalConnection(pv, model.EnergyDirectionTypeProduce, parameter{phase: model.ElectricalConnectionPhaseNameTypeAbc}, parameter{})
+	withMeasurements(pv,
+		measurement{model.MeasurementTypeTypePower, model.ScopeTypeTypeACPowerTotal, model.UnitOfMeasurementTypeW, PVPower},
+		measurement{model.MeasurementTypeTypeEnergy, model.ScopeTypeTypeACYieldTotal, model.UnitOfMeasurementTypeWh, 0},
+	)
+
+	pv.WithUseCase(model.UseCaseActorTypePVSystem, model.UseCaseNameTypeVisualizationOfAggregatedPhotovoltaicData, 1, 2, 3)
+
+	return pv
+}
+
+// add a battery system with the entity address 1
+//
+// The battery system charges with BatteryPower, reports BatteryStateOfCharge and
+// announces the VABD use case.
+func BatterySystem(device *DeviceBuilder) *EntityBuilder {
+	battery := device.AddEntity(model.EntityTypeTypeElectricityStorageSystem, 1)
+
+	withManufacturer(battery, "Battery", "battery-0001")
+
+	withElectricalConnection(battery, model.EnergyDirectionTypeConsume,
+		parameter{phase: model.ElectricalConnectionPhaseNameTypeAbc}, parameter{}, parameter{}, parameter{})
+	withMeasurements(battery,
+		measurement{model.MeasurementTypeTypePower, model.ScopeTypeTypeACPowerTotal, model.UnitOfMeasurementTypeW, BatteryPower},
+		measurement{model.MeasurementTypeTypeEnergy, model.ScopeTypeTypeCharge, model.UnitOfMeasurementTypeWh, 0},
+		measurement{model.MeasurementTypeTypeEnergy, model.ScopeTypeTypeDischarge, model.UnitOfMeasurementTypeWh, 0},
+		measurement{model.MeasurementTypeTypePercentage, model.ScopeTypeTypeStateOfCharge, model.UnitOfMeasurementTypepct, BatteryStateOfCharge},
+	)
+
+	battery.WithUseCase(model.UseCaseActorTypeBatterySystem, model.UseCaseNameTypeVisualizationOfAggregatedBatteryData, 1, 2, 3, 4)
+
+	return battery
+}
+
+// add a heat pump with the entity address 1
+//
+// The heat pump consumes HeatPumpPower evenly on 3 phases and announces the MPC use case.
+func HeatPump(device *DeviceBuilder) *EntityBuilder {
+	heatPump := device.AddEntity(model.EntityTypeTypeHeatPumpAppliance, 1)
+
+	withManufacturer(heatPump, "Heat Pump", "hp-0001")
+
+	parameters := []parameter{{phase: model.ElectricalConnectionPhaseNameTypeAbc}, {}}
+	measurements := []measurement{
+		{model.MeasurementTypeTypePower, model.ScopeTypeTypeACPowerTotal, model.UnitOfMeasurementTypeW, HeatPumpPower},
+		{model.MeasurementTypeTypeEnergy, model.ScopeTypeTypeACEnergyConsumed, model.UnitOfMeasurementTypeWh, 0},
+	}
+	for _, phase := range util.PhaseNameMapping {
+		parameters = append(parameters, parameter{phase: phase})
+		measurements = append(measurements, measurement{model.MeasurementTypeTypePower, model.ScopeTypeTypeACPower, model.UnitOfMeasurementTypeW, HeatPumpPower / 3})
+	}
+	parameters, measurements = withPhaseMeasurements(parameters, measurements, HeatPumpPower)
+
+	withElectricalConnection(heatPump, model.EnergyDirectionTypeConsume, parameters...)
+	withMeasurements(heatPump, measurements...)
+
+	heatPump.WithUseCase(model.UseCaseActorTypeMonitoredUnit, model.UseCaseNameTypeMonitoringOfPowerConsumption, 1, 2, 3, 4, 5)
+
+	return heatPump
+}
+
+// add the current and voltage per phase and the frequency for a power distributed evenly to the phases
+func withPhaseMeasurements(parameters []parameter, measurements []measurement, power float64) ([]parameter, []measurement) {
+	for _, phase := range util.PhaseNameMapping {
+		parameters = append(parameters, parameter{phase: phase})
+		measurements = append(measurements, measurement{model.MeasurementTypeTypeCurrent, model.ScopeTypeTypeACCurrent, model.UnitOfMeasurementTypeA, power / 3 / Voltage})
+	}
+	for _, phase := range util.PhaseNameMapping {
+		parameters = append(parameters, parameter{phase: phase})
+		measurements = append(measurements, measurement{model.MeasurementTypeTypeVoltage, model.ScopeTypeTypeACVoltage, model.UnitOfMeasurementTypeV, Voltage})
+	}
+	parameters = append(parameters, parameter{})
+	measurements = append(measurements, measurement{model.MeasurementTypeTypeFrequency, model.ScopeTypeTypeACFrequency, model.UnitOfMeasurementTypeHz, Frequency})
+
+	return parameters, measurements
+}
+
+// add an EV with the data all EV presets share, the state of charge is only reported if it is not nil
+func addEV(evse *EntityBuilder, standard model.DeviceConfigurationKeyValueStringType, soc *float64) *EntityBuilder {
+	ev := evse.AddEntity(model.EntityTypeTypeEV, 1)
+
+	withManufacturer(ev, "EV", "ev-0001")
+	withOperatingState(ev, model.DeviceDiagnosisOperatingStateTypeNormalOperation)
+
+	ev.WithData(model.FeatureTypeTypeDeviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData,
+		&model.DeviceConfigurationKeyValueDescriptionListDataType{
+			DeviceConfigurationKeyValueDescriptionData: []model.DeviceConfigurationKeyValueDescriptionDataType{
+				{
+					KeyId:     eebusutil.Ptr(model.DeviceConfigurationKeyIdType(0)),
+					KeyName:   eebusutil.Ptr(model.DeviceConfigurationKeyNameTypeCommunicationsStandard),
+					ValueType: eebusutil.Ptr(model.DeviceConfigurationKeyValueTypeTypeString),
+				},
+				{
+					KeyId:     eebusutil.Ptr(model.DeviceConfigurationKeyIdType(1)),
+					KeyName:   eebusutil.Ptr(model.DeviceConfigurationKeyNameTypeAsymmetricChargingSupported),
+					ValueType: eebusutil.Ptr(model.DeviceConfigurationKeyValueTypeTypeBoolean),
+				},
+			},
+		})
+	ev.WithData(model.FeatureTypeTypeDeviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueListData,
+		&model.DeviceConfigurationKeyValueListDataType{
+			DeviceConfigurationKeyValueData: []model.DeviceConfigurationKeyValueDataType{
+				{
+					KeyId:             eebusutil.Ptr(model.DeviceConfigurationKeyIdType(0)),
+					Value:             &model.DeviceConfigurationKeyValueValueType{String: eebusutil.Ptr(standard)},
+					IsValueChangeable: eebusutil.Ptr(false),
+				},
+				{
+					KeyId:             eebusutil.Ptr(model.DeviceConfigurationKeyIdType(1)),
+					Value:             &model.DeviceConfigurationKeyValueValueType{Boolean: eebusutil.Ptr(false)},
+					IsValueChangeable: eebusutil.Ptr(false),
+				},
+			},
+		})
+
+	// the parameter and measurement ids are the indices of the EVMeasurementId constants
+	var parameters []parameter
+	var measurements []measurement
+	for _, phase := range util.PhaseNameMapping {
+		parameters = append(parameters, parameter{phase: phase, min: EVMinCurrent, max: EVMaxCurrent})
+		measurements = append(measurements, measurement{model.MeasurementTypeTypeCurrent, model.ScopeTypeTypeACCurrent, model.UnitOfMeasurementTypeA, EVCurrent})
+	}
+	for _, phase := range util.PhaseNameMapping {
+		parameters = append(parameters, parameter{phase: phase})
+		measurements = append(measurements, measurement{model.MeasurementTypeTypePower, model.ScopeTypeTypeACPower, model.UnitOfMeasurementTypeW, EVCurrent * Voltage})
+	}
+	parameters = append(parameters, parameter{})
+	measurements = append(measurements, measurement{model.MeasurementTypeTypeEnergy, model.ScopeTypeTypeCharge, model.UnitOfMeasurementTypeWh, EVEnergyCharged})
+	if soc != nil {
+		parameters = append(parameters, parameter{})
+		measurements = append(measurements, measurement{model.MeasurementTypeTypePercentage, model.ScopeTypeTypeStateOfCharge, model.UnitOfMeasurementTypepct, *soc})
+	}
+
+	withElectricalConnection(ev, model.EnergyDirectionTypeConsume, parameters...)
+	withMeasurements(ev, measurements...)
+	withLoadControlLimits(ev)
+
+	ev.WithUseCase(model.UseCaseActorTypeEV, model.UseCaseNameTypeEVCommissioningAndConfiguration, 1, 2, 3, 4, 5, 6, 7, 8)
+	ev.WithUseCase(model.UseCaseActorTypeEV, model.UseCaseNameTypeMeasurementOfElectricityDuringEVCharging, 1, 2, 3)
+	ev.WithUseCase(model.UseCaseActorTypeEV, model.UseCaseNameTypeOverloadProtectionByEVChargingCurrentCurtailment, 1, 2, 3)
+	ev.WithUseCase(model.UseCaseActorTypeEV, model.UseCaseNameTypeOptimizationOfSelfConsumptionDuringEVCharging, 1, 2, 3)
+
+	return ev
+}
+
+// add the device classification feature with the manufacturer data
+func withManufacturer(entity *EntityBuilder, deviceName, serialNumber string) {
+	entity.WithData(model.FeatureTypeTypeDeviceClassification, model.FunctionTypeDeviceClassificationManufacturerData,
+		&model.DeviceClassificationManufacturerDataType{
+			BrandName:    eebusutil.Ptr(model.DeviceClassificationStringType(Brand)),
+			VendorName:   eebusutil.Ptr(model.DeviceClassificationStringType(Brand)),
+			DeviceName:   eebusutil.Ptr(model.DeviceClassificationStringType(deviceName)),
+			SerialNumber: eebusutil.Ptr(model.DeviceClassificationStringType(serialNumber)),
+		})
+}
+
+// add the device configuration feature with a key with a scaled number value
+func withConfiguration(entity *EntityBuilder, keyName model.DeviceConfigurationKeyNameType, unit model.UnitOfMeasurementType, value float64) {
+	entity.WithData(model.FeatureTypeTypeDeviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData,
+		&model.DeviceConfigurationKeyValueDescriptionListDataType{
+			DeviceConfigurationKeyValueDescriptionData: []model.DeviceConfigurationKeyValueDescriptionDataType{
+				{
+					KeyId:     eebusutil.Ptr(model.DeviceConfigurationKeyIdType(0)),
+					KeyName:   eebusutil.Ptr(keyName),
+					ValueType: eebusutil.Ptr(model.DeviceConfigurationKeyValueTypeTypeScaledNumber),
+					Unit:      eebusutil.Ptr(unit),
+				},
+			},
+		})
+	entity.WithData(model.FeatureTypeTypeDeviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueListData,
+		&model.DeviceConfigurationKeyValueListDataType{
+			DeviceConfigurationKeyValueData: []model.DeviceConfigurationKeyValueDataType{
+				{
+					KeyId:             eebusutil.Ptr(model.DeviceConfigurationKeyIdType(0)),
+					Value:             &model.DeviceConfigurationKeyValueValueType{ScaledNumber: model.NewScaledNumberType(value)},
+					IsValueChangeable: eebusutil.Ptr(false),
+				},
+			},
+		})
+}
+
+// add the device diagnosis feature with the operating state
+func withOperatingState(entity *EntityBuilder, state model.DeviceDiagnosisOperatingStateType) {
+	entity.WithData(model.FeatureTypeTypeDeviceDiagnosis, model.FunctionTypeDeviceDiagnosisStateData,
+		&model.DeviceDiagnosisStateDataType{
+			OperatingState: eebusutil.Ptr(state),
+		})
+}
+
+// an electrical connection parameter
+type parameter struct {
+	// the phase the parameter is measured on, empty for parameters not related to a phase
+	phase model.ElectricalConnectionPhaseNameType
+
+	// the permitted minimum and maximum of the value, only used if max is not 0
+	min, max float64
+}
+
+// add the electrical connection feature with one AC connection on 3 phases
+//
+// The parameter ids and the measurement ids are the indices of the parameters.
+func withElectricalConnection(entity *EntityBuilder, direction model.EnergyDirectionType, parameters ...parameter) {
+	descriptions := &model.ElectricalConnectionParameterDescriptionListDataType{}
+	permittedValues := &model.ElectricalConnectionPermittedValueSetListDataType{}
+
+	for index, item := range parameters {
+		parameterId := eebusutil.Ptr(model.ElectricalConnectionParameterIdType(index))
+
+		description := model.ElectricalConnectionParameterDescriptionDataType{
+			ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+			ParameterId:            parameterId,
+			MeasurementId:          eebusutil.Ptr(model.MeasurementIdType(index)),
+			VoltageType:            eebusutil.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+		}
+		if item.phase != "" {
+			description.AcMeasuredPhases = eebusutil.Ptr(item.phase)
+		}
+		descriptions.ElectricalConnectionParameterDescriptionData = append(
+			descriptions.ElectricalConnectionParameterDescriptionData, description)
+
+		if item.max == 0 {
+			continue
+		}
+
+		permittedValues.ElectricalConnectionPermittedValueSetData = append(permittedValues.ElectricalConnectionPermittedValueSetData,
+			model.ElectricalConnectionPermittedValueSetDataType{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				ParameterId:            parameterId,
+				PermittedValueSet: []model.ScaledNumberSetType{
+					{
+						Range: []model.ScaledNumberRangeType{
+							{
+								Min: model.NewScaledNumberType(item.min),
+								Max: model.NewScaledNumberType(item.max),
+							},
+						},
+					},
+				},
+			})
+	}
+
+	entity.WithData(model.FeatureTypeTypeElectricalConnection, model.FunctionTypeElectricalConnectionDescriptionListData,
+		&model.ElectricalConnectionDescriptionListDataType{
+			ElectricalConnectionDescriptionData: []model.ElectricalConnectionDescriptionDataType{
+				{
+					ElectricalConnectionId:  eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+					PowerSupplyType:         eebusutil.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+					AcConnectedPhases:       eebusutil.Ptr(uint(3)),
+					PositiveEnergyDirection: eebusutil.Ptr(direction),
+				},
+			},
+		})
+	entity.WithData(model.FeatureTypeTypeElectricalConnection, model.FunctionTypeElectricalConnectionParameterDescriptionListData, descriptions)
+	entity.WithData(model.FeatureTypeTypeElectricalConnection, model.FunctionTypeElectricalConnectionPermittedValueSetListData, permittedValues)
+}
+
+// a measured value
+type measurement struct {
+	measurementType model.MeasurementTypeType
+	scope           model.ScopeTypeType
+	unit            model.UnitOfMeasurementType
+	value           float64
+}
+
+// add the measurement feature with the descriptions and current values, the measurement ids are the indices
+func withMeasurements(entity *EntityBuilder, measurements ...measurement) {
+	descriptions := &model.MeasurementDescriptionListDataType{}
+	values := &model.MeasurementListDataType{}
+	timestamp := model.NewAbsoluteOrRelativeTimeTypeFromTime(time.Now())
+
+	for index, item := range measurements {
+		measurementId := eebusutil.Ptr(model.MeasurementIdType(index))
+
+		descriptions.MeasurementDescriptionData = append(descriptions.MeasurementDescriptionData,
+			model.MeasurementDescriptionDataType{
+				MeasurementId:   measurementId,
+				MeasurementType: eebusutil.Ptr(item.measurementType),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				Unit:            eebusutil.Ptr(item.unit),
+				ScopeType:       eebusutil.Ptr(item.scope),
+			})
+
+		values.MeasurementData = append(values.MeasurementData, model.MeasurementDataType{
+			MeasurementId: measurementId,
+			ValueType:     eebusutil.Ptr(model.MeasurementValueTypeTypeValue),
+			Timestamp:     timestamp,
+			Value:         model.NewScaledNumberType(item.value),
+			ValueSource:   eebusutil.Ptr(model.MeasurementValueSourceTypeMeasuredValue),
+			ValueState:    eebusutil.Ptr(model.MeasurementValueStateTypeNormal),
+		})
+	}
+
+	entity.WithData(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData, descriptions)
+	entity.WithData(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData, values)
+}
+
+// add the load control feature with an inactive, writable current limit per phase for the
+// overload protection and for the self consumption, the limits refer to the current measurements
+func withLoadControlLimits(entity *EntityBuilder) {
+	descriptions := &model.LoadControlLimitDescriptionListDataType{}
+	limits := &model.LoadControlLimitListDataType{}
+
+	for _, limit := range []struct {
+		first    model.LoadControlLimitIdType
+		category model.LoadControlCategoryType
+		scope    model.ScopeTypeType
+	}{
+		{EVLimitIdObligation, model.LoadControlCategoryTypeObligation, model.ScopeTypeTypeOverloadProtection},
+		{EVLimitIdRecommendation, model.LoadControlCategoryTypeRecommendation, model.ScopeTypeTypeSelfConsumption},
+	} {
+		for phase := range util.PhaseNameMapping {
+			limitId := eebusutil.Ptr(limit.first + model.LoadControlLimitIdType(phase))
+
+			descriptions.LoadControlLimitDescriptionData = append(descriptions.LoadControlLimitDescriptionData,
+				model.LoadControlLimitDescriptionDataType{
+					LimitId:        limitId,
+					LimitType:      eebusutil.Ptr(model.LoadControlLimitTypeTypeMaxValueLimit),
+					LimitCategory:  eebusutil.Ptr(limit.category),
+					LimitDirection: eebusutil.Ptr(model.EnergyDirectionTypeConsume),
+					MeasurementId:  eebusutil.Ptr(EVMeasurementIdCurrentL1 + model.MeasurementIdType(phase)),
+					Unit:           eebusutil.Ptr(model.UnitOfMeasurementTypeA),
+					ScopeType:      eebusutil.Ptr(limit.scope),
+				})
+
+			limits.LoadControlLimitData = append(limits.LoadControlLimitData, model.LoadControlLimitDataType{
+				LimitId:           limitId,
+				IsLimitChangeable: eebusutil.Ptr(true),
+				IsLimitActive:     eebusutil.Ptr(false),
+				Value:             model.NewScaledNumberType(EVMaxCurrent),
+			})
+		}
+	}
+
+	entity.WithData(model.FeatureTypeTypeLoadControl, model.FunctionTypeLoadControlLimitDescriptionListData, descriptions)
+	entity.WithWritableData(model.FeatureTypeTypeLoadControl, model.FunctionTypeLoadControlLimitListData, limits)
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/enbility/cemd/simulator"
+	eebusapi "github.com/enbility/eebus-go/api"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	"github.com/enbility/spine-go/model"
+)
+
+// main app
+func main() {
+	remoteSki := flag.String("remoteski", "", "Optional remote SKI of the CEM to pair with")
+	port := flag.Int("port", 4816, "Optional port for the EEBUS service")
+	crt := flag.String("crt", "sim.crt", "Optional filepath for the cert file")
+	key := flag.String("key", "sim.key", "Optional filepath for the key file")
+	devices := flag.String("devices", "wallbox,pv,battery,grid", "Optional comma separated list of the simulated devices: wallbox, pv, battery, grid")
+	scriptFile := flag.String("script", "", "Optional filepath for a script with the actions of the devices")
+	speed := flag.Float64("speed", 1, "Optional factor the simulated time runs faster than the wall clock")
+	interval := flag.Duration("interval", simulator.DefaultInterval, "Optional simulated duration of each step")
+	debug := flag.Bool("debug", false, "Print debug logs")
+
+	flag.Parse()
+
+	certificate, err := tls.LoadX509KeyPair(*crt, *key)
+	if err != nil {
+		fmt.Println("Using a transient certificate, the SKI changes on every start")
+		certificate, err = cert.CreateCertificate("Demo", "Demo", "DE", "Demo-Unit-Sim")
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	deviceList, err := newDevices(strings.Split(*devices, ","))
+	if err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+
+	configuration, err := eebusapi.NewConfiguration(
+		"Demo", "Demo", "Simulator", "234567890",
+		model.DeviceTypeTypeGeneric,
+		simulator.EntityTypes(deviceList...),
+		*port, certificate, 230, time.Second*4)
+	if err != nil {
+		fmt.Println("Service data is invalid:", err)
+		return
+	}
+
+	sim := simulator.NewSimulator(configuration, &serviceHandler{}, simulator.Options{
+		Interval: *interval,
+		Speed:    *speed,
+	}, &consoleLogger{debug: *debug})
+	for _, device := range deviceList {
+		sim.AddDevice(device)
+	}
+
+	if *scriptFile != "" {
+		file, err := os.Open(*scriptFile)
+		if err != nil {
+			fmt.Println("Error opening script:", err)
+			os.Exit(1)
+		}
+		steps, err := simulator.ParseScript(file)
+		file.Close()
+		if err == nil {
+			err = sim.AddSteps(steps...)
+		}
+		if err != nil {
+			fmt.Println("Error loading script:", err)
+			os.Exit(1)
+		}
+	}
+
+	if err := sim.Setup(); err != nil {
+		fmt.Println("Error setting up simulator:", err)
+		return
+	}
+
+	fmt.Println("SKI:", sim.Service.LocalService().SKI())
+
+	if *remoteSki != "" {
+		sim.Service.RegisterRemoteSKI(*remoteSki, true)
+	}
+
+	sim.Start()
+
+	// Clean exit to make sure mdns shutdown is invoked
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	<-sig
+	// User exit
+
+	sim.Shutdown()
+}
+
+// create the devices by their names, the grid meter measures all other devices
+// and the battery compensates the power at the grid meter
+func newDevices(names []string) ([]simulator.DeviceInterface, error) {
+	var devices []simulator.DeviceInterface
+	var sources []simulator.PowerSourceInterface
+
+	// the grid meter is created first, as the battery needs it
+	var grid *simulator.GridMeter
+	for _, name := range names {
+		if strings.TrimSpace(name) == "grid" {
+			grid = simulator.NewGridMeter(simulator.GridMeterOptions{})
+		}
+	}
+
+	for _, name := range names {
+		switch strings.TrimSpace(name) {
+		case "wallbox":
+			wallbox := simulator.NewWallbox(simulator.WallboxOptions{})
+			devices = append(devices, wallbox)
+			sources = append(sources, wallbox)
+		case "pv":
+			pv := simulator.NewPV(simulator.PVOptions{})
+			devices = append(devices, pv)
+			sources = append(sources, pv)
+		case "battery":
+			options := simulator.BatteryOptions{StateOfCharge: 50}
+			if grid != nil {
+				options.Meter = grid
+			}
+			battery := simulator.NewBattery(options)
+			devices = append(devices, battery)
+			sources = append(sources, battery)
+		case "grid":
+			devices = append(devices, grid)
+		default:
+			return nil, fmt.Errorf("unknown device: %s", name)
+		}
+	}
+
+	if len(devices) == 0 {
+		return nil, errors.New("no devices")
+	}
+
+	if grid != nil {
+		for _, source := range sources {
+			grid.AddSource(source)
+		}
+	}
+
+	return devices, nil
+}
+
+// prints the connection and pairing state of the CEM
+type serviceHandler struct{}
+
+var _ eebusapi.ServiceReaderInterface = (*serviceHandler)(nil)
+
+func (h *serviceHandler) RemoteSKIConnected(service eebusapi.ServiceInterface, ski string) {
+	fmt.Println("Connected:", ski)
+}
+
+func (h *serviceHandler) RemoteSKIDisconnected(service eebusapi.ServiceInterface, ski string) {
+	fmt.Println("Disconnected:", ski)
+}
+
+func (h *serviceHandler) VisibleRemoteServicesUpdated(service eebusapi.ServiceInterface, entries []shipapi.RemoteService) {
+}
+
+func (h *serviceHandler) ServiceShipIDUpdate(ski string, shipdID string) {}
+
+func (h *serviceHandler) ServicePairingDetailUpdate(ski string, detail *shipapi.ConnectionStateDetail) {
+	fmt.Println("Pairing state of", ski+":", detail.State())
+}
+
+// prints the logs to the console, debug and trace logs only if enabled
+type consoleLogger struct {
+	debug bool
+}
+
+func (l *consoleLogger) Trace(args ...interface{}) {
+	if l.debug {
+		l.print("TRACE", fmt.Sprint(args...))
+	}
+}
+
+func (l *consoleLogger) Tracef(format string, args ...interface{}) {
+	if l.debug {
+		l.print("TRACE", fmt.Sprintf(format, args...))
+	}
+}
+
+func (l *consoleLogger) Debug(args ...interface{}) {
+	if l.debug {
+		l.print("DEBUG", fmt.Sprint(args...))
+	}
+}
+
+func (l *consoleLogger) Debugf(format string, args ...interface{}) {
+	if l.debug {
+		l.print("DEBUG", fmt.Sprintf(format, args...))
+	}
+}
+
+func (l *consoleLogger) Info(args ...interface{}) {
+	l.print("INFO ", fmt.Sprint(args...))
+}
+
+func (l *consoleLogger) Infof(format string, args ...interface{}) {
+	l.print("INFO ", fmt.Sprintf(format, args...))
+}
+
+func (l *consoleLogger) Error(args ...interface{}) {
+	l.print("ERROR", fmt.Sprint(args...))
+}
+
+func (l *consoleLogger) Errorf(format string, args ...interface{}) {
+	l.print("ERROR", fmt.Sprintf(format, args...))
+}
+
+func (l *consoleLogger) print(level, msg string) {
+	fmt.Println(time.Now().Format("15:04:05.000"), level, msg)
+}
@@ -0,0 +1,516 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/enbility/ship-go/cert"
+)
+
+const certUsage = `Usage: main cert <command> [options]
+
+Commands:
+  generate  create and save a new certificate and key
+  show      print the SKI and fingerprint of a certificate
+  rotate    replace an existing certificate and key with a new one
+  validate  check if a certificate and key can be used for EEBUS
+
+Run "main cert <command> -h" to see the options of a command.
+`
+
+// handle the certificate subcommands
+func certCommand(args []string) error {
+	if len(args) == 0 {
+		fmt.Print(certUsage)
+		return errors.New("missing cert command")
+	}
+
+	switch args[0] {
+	case "generate":
+		return certGenerate(args[1:])
+	case "show":
+		return certShow(args[1:])
+	case "rotate":
+		return certRotate(args[1:])
+	case "validate":
+		return certValidate(args[1:])
+	}
+
+	fmt.Print(certUsage)
+	return fmt.Errorf("unknown cert command: %s", args[0])
+}
+
+// the subject details of a new certificate
+type certSubject struct {
+	organization, unit, country, commonName *string
+}
+
+func addCertSubjectFlags(flags *flag.FlagSet) certSubject {
+	return certSubject{
+		organization: flags.String("org", "Demo", "Organization (O) of the certificate"),
+		unit:         flags.String("unit", "Demo", "Organizational unit (OU) of the certificate"),
+		country:      flags.String("country", "DE", "Country (C) of the certificate"),
+		commonName:   flags.String("cn", "Demo-Unit-10", "Common name (CN) of the certificate, e.g. deviceModel-deviceSerialNumber"),
+	}
+}
+
+func certGenerate(args []string) error {
+	flags := flag.NewFlagSet("cert generate", flag.ExitOnError)
+	crt := flags.String("crt", "cert.crt", "Filepath for the cert file")
+	key := flags.String("key", "cert.key", "Filepath for the key file")
+	force := flags.Bool("force", false, "Overwrite existing files")
+	subject := addCertSubjectFlags(flags)
+	_ = flags.Parse(args)
+
+	if !*force {
+		for _, path := range []string{*crt, *key} {
+			if _, err := os.Stat(path); err == nil {
+				return fmt.Errorf("%s already exists, use rotate to replace it", path)
+			}
+		}
+	}
+
+	certificate, err := cert.CreateCertificate(*subject.unit, *subject.organization, *subject.country, *subject.commonName)
+	if err != nil {
+		return err
+	}
+
+	suffix, err := newCertFiles(*crt, *key).replace(certificate)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Saved certificate file", *crt, "and key file", *key)
+	if suffix != "" {
+		fmt.Println("The previous files are saved with the suffix", suffix)
+	}
+
+	return printCertificateDetails(certificate)
+}
+
+func certShow(args []string) error {
+	flags := flag.NewFlagSet("cert show", flag.ExitOnError)
+	crt := flags.String("crt", "cert.crt", "Filepath for the cert file")
+	_ = flags.Parse(args)
+
+	data, err := os.ReadFile(*crt)
+	if err != nil {
+		return err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return fmt.Errorf("%s does not contain a PEM encoded certificate", *crt)
+	}
+
+	return printCertificateDetails(tls.Certificate{Certificate: [][]byte{block.Bytes}})
+}
+
+func certRotate(args []string) error {
+	flags := flag.NewFlagSet("cert rotate", flag.ExitOnError)
+	crt := flags.String("crt", "cert.crt", "Filepath for the cert file")
+	key := flags.String("key", "cert.key", "Filepath for the key file")
+	subject := addCertSubjectFlags(flags)
+	_ = flags.Parse(args)
+
+	setFlags := make(map[string]bool)
+	flags.Visit(func(f *flag.Flag) { setFlags[f.Name] = true })
+
+	return rotateCertificate(newCertFiles(*crt, *key), subject, setFlags)
+}
+
+// replace the certificate and key with a new certificate,
+// the subject of the existing certificate is kept unless it is set in setFlags
+func rotateCertificate(files *certFiles, subject certSubject, setFlags map[string]bool) error {
+	oldCertificate, err := tls.LoadX509KeyPair(files.crt, files.key)
+	if err != nil {
+		return err
+	}
+	oldLeaf, err := x509.ParseCertificate(oldCertificate.Certificate[0])
+	if err != nil {
+		return err
+	}
+	oldSki, err := cert.SkiFromCertificate(oldLeaf)
+	if err != nil {
+		return err
+	}
+
+	if !setFlags["org"] && len(oldLeaf.Subject.Organization) > 0 {
+		*subject.organization = oldLeaf.Subject.Organization[0]
+	}
+	if !setFlags["unit"] && len(oldLeaf.Subject.OrganizationalUnit) > 0 {
+		*subject.unit = oldLeaf.Subject.OrganizationalUnit[0]
+	}
+	if !setFlags["country"] && len(oldLeaf.Subject.Country) > 0 {
+		*subject.country = oldLeaf.Subject.Country[0]
+	}
+	if !setFlags["cn"] {
+		*subject.commonName = oldLeaf.Subject.CommonName
+	}
+
+	certificate, err := cert.CreateCertificate(*subject.unit, *subject.organization, *subject.country, *subject.commonName)
+	if err != nil {
+		return err
+	}
+
+	suffix, err := files.replace(certificate)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Rotated certificate, the previous files are saved with the suffix", suffix)
+	fmt.Println("Previous SKI:", oldSki)
+	if err := printCertificateDetails(certificate); err != nil {
+		return err
+	}
+	fmt.Println("All remote devices need to be paired again with the new SKI")
+
+	return nil
+}
+
+func certValidate(args []string) error {
+	flags := flag.NewFlagSet("cert validate", flag.ExitOnError)
+	crt := flags.String("crt", "cert.crt", "Filepath for the cert file")
+	key := flags.String("key", "cert.key", "Filepath for the key file")
+	_ = flags.Parse(args)
+
+	certificate, err := tls.LoadX509KeyPair(*crt, *key)
+	if err != nil {
+		return err
+	}
+
+	if err := validateCertificate(certificate); err != nil {
+		return err
+	}
+
+	fmt.Println("Certificate file", *crt, "and key file", *key, "are valid")
+
+	return printCertificateDetails(certificate)
+}
+
+// check if a certificate can be used for an EEBUS service
+func validateCertificate(certificate tls.Certificate) error {
+	if len(certificate.Certificate) == 0 {
+		return errors.New("missing certificate")
+	}
+
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		return err
+	}
+
+	// SHIP 9.1: the cipher suites require an ECDSA P-256 key
+	publicKey, ok := leaf.PublicKey.(*ecdsa.PublicKey)
+	if !ok || publicKey.Curve != elliptic.P256() {
+		return errors.New("certificate does not use an ECDSA P-256 key")
+	}
+
+	// SHIP 12.2: the SKI is taken from the subject key identifier
+	if _, err := cert.SkiFromCertificate(leaf); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if now.Before(leaf.NotBefore) {
+		return fmt.Errorf("certificate is not valid before %s", leaf.NotBefore.Format(time.RFC3339))
+	}
+	if now.After(leaf.NotAfter) {
+		return fmt.Errorf("certificate expired on %s", leaf.NotAfter.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// the certificate and key files of a service
+//
+// The two files can't be replaced with one atomic rename. Before they are replaced,
+// a backup of the existing files is saved with a common suffix. If the replacement
+// fails, the backups are restored. If it is interrupted, e.g. by a crash, load
+// detects the mismatching files and restores the backups.
+type certFiles struct {
+	crt, key string
+
+	// renames a file, replaced in tests to simulate failures
+	rename func(oldPath, newPath string) error
+}
+
+func newCertFiles(crt, key string) *certFiles {
+	return &certFiles{
+		crt:    crt,
+		key:    key,
+		rename: os.Rename,
+	}
+}
+
+// the prefix of the suffix of backup files, followed by the time of the backup
+const certBackupSuffix = ".bak-"
+
+// load the certificate and key
+//
+// if the files don't match because a replacement was interrupted,
+// the backup of the previous files is restored and loaded
+func (f *certFiles) load() (tls.Certificate, error) {
+	certificate, err := tls.LoadX509KeyPair(f.crt, f.key)
+	if err == nil {
+		return certificate, nil
+	}
+
+	suffix := f.interruptedReplacement()
+	if suffix == "" {
+		return certificate, err
+	}
+
+	if _, backupErr := tls.LoadX509KeyPair(f.crt+suffix, f.key+suffix); backupErr != nil {
+		return certificate, err
+	}
+
+	if restoreErr := f.restore(suffix); restoreErr != nil {
+		return certificate, fmt.Errorf("%w, restoring the backup with the suffix %s failed: %w", err, suffix, restoreErr)
+	}
+
+	fmt.Println("Restored the certificate and key files with the suffix", suffix, "after an interrupted replacement")
+
+	return tls.LoadX509KeyPair(f.crt, f.key)
+}
+
+// returns the suffix of the newest backup if exactly one of the files still
+// equals its backup, so the other one was replaced and the replacement interrupted,
+// otherwise an empty string
+func (f *certFiles) interruptedReplacement() string {
+	backups, err := filepath.Glob(f.crt + certBackupSuffix + "*")
+	if err != nil {
+		return ""
+	}
+
+	var suffixes []string
+	for _, backup := range backups {
+		suffix := strings.TrimPrefix(backup, f.crt)
+		if _, err := os.Stat(f.key + suffix); err == nil {
+			suffixes = append(suffixes, suffix)
+		}
+	}
+	if len(suffixes) == 0 {
+		return ""
+	}
+
+	// the suffixes end with the time of the backup
+	slices.Sort(suffixes)
+	suffix := suffixes[len(suffixes)-1]
+
+	if sameContent(f.crt, f.crt+suffix) != sameContent(f.key, f.key+suffix) {
+		return suffix
+	}
+
+	return ""
+}
+
+// replace the files with a new certificate and key
+//
+// the existing files are saved with a backup suffix, which is returned,
+// and restored if the new files can not be saved.
+// If there are no existing files, the returned suffix is empty.
+func (f *certFiles) replace(certificate tls.Certificate) (string, error) {
+	var existing []string
+	for _, path := range []string{f.crt, f.key} {
+		if _, err := os.Stat(path); err == nil {
+			existing = append(existing, path)
+		}
+	}
+
+	var suffix string
+	if len(existing) > 0 {
+		suffix = certBackupSuffix + time.Now().Format("20060102150405")
+		for _, path := range existing {
+			if _, err := os.Stat(path + suffix); err == nil {
+				return "", fmt.Errorf("backup file %s already exists", path+suffix)
+			}
+			if err := f.copy(path, path+suffix); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	if err := f.save(certificate); err != nil {
+		if suffix != "" {
+			if restoreErr := f.restore(suffix); restoreErr != nil {
+				return "", fmt.Errorf("%w, restoring the backup with the suffix %s failed: %w", err, suffix, restoreErr)
+			}
+		}
+		return "", err
+	}
+
+	return suffix, nil
+}
+
+// replace the files with their backups with a suffix
+func (f *certFiles) restore(suffix string) error {
+	for _, path := range []string{f.crt, f.key} {
+		if _, err := os.Stat(path + suffix); err != nil {
+			continue
+		}
+		if err := f.copy(path+suffix, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// write the certificate and the private key as PEM files
+func (f *certFiles) save(certificate tls.Certificate) error {
+	privateKey, ok := certificate.PrivateKey.(*ecdsa.PrivateKey)
+	if !ok {
+		return errors.New("unsupported private key type")
+	}
+
+	keyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		return err
+	}
+
+	crtData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Certificate[0]})
+	keyData := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes})
+
+	// both files are completely written before any of the existing files is replaced
+	crtTemp, err := writeTempFile(f.crt, crtData)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(crtTemp)
+
+	keyTemp, err := writeTempFile(f.key, keyData)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(keyTemp)
+
+	if err := f.rename(crtTemp, f.crt); err != nil {
+		return err
+	}
+	if err := f.rename(keyTemp, f.key); err != nil {
+		return err
+	}
+
+	if err := syncDir(filepath.Dir(f.crt)); err != nil {
+		return err
+	}
+
+	return syncDir(filepath.Dir(f.key))
+}
+
+// replace the file at target with a copy of source, using a temporary file
+func (f *certFiles) copy(source, target string) error {
+	data, err := os.ReadFile(source)
+	if err != nil {
+		return err
+	}
+
+	temp, err := writeTempFile(target, data)
+	if err != nil {
+		return err
+	}
+
+	if err := f.rename(temp, target); err != nil {
+		os.Remove(temp)
+		return err
+	}
+
+	return syncDir(filepath.Dir(target))
+}
+
+// returns if two files can be read and have the same content
+func sameContent(first, second string) bool {
+	a, err := os.ReadFile(first)
+	if err != nil {
+		return false
+	}
+
+	b, err := os.ReadFile(second)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(a, b)
+}
+
+// write data to a new temporary file in the directory of path and sync it to disk,
+// returns the path of the temporary file
+func writeTempFile(path string, data []byte) (string, error) {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = file.Write(data)
+	if err == nil {
+		err = file.Chmod(0600)
+	}
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+
+	return file.Name(), nil
+}
+
+// sync a directory, so renames within it are persisted
+func syncDir(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+
+	return dir.Sync()
+}
+
+// print the SKI, fingerprint, subject and validity of a certificate
+func printCertificateDetails(certificate tls.Certificate) error {
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		return err
+	}
+
+	ski, err := cert.SkiFromCertificate(leaf)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("SKI:        ", ski)
+	fmt.Println("Fingerprint:", fingerprint(leaf.Raw))
+	fmt.Println("Subject:    ", leaf.Subject.String())
+	fmt.Println("Valid until:", leaf.NotAfter.Format(time.RFC3339))
+
+	return nil
+}
+
+// return the SHA-256 fingerprint of a DER encoded certificate
+func fingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+
+	return strings.Join(parts, ":")
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"crypto/tls"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// create a certificate and key file in dir with the generate command
+func generateFiles(t *testing.T, dir, name string) (string, string) {
+	crt := filepath.Join(dir, name+".crt")
+	key := filepath.Join(dir, name+".key")
+
+	err := certCommand([]string{"generate", "-crt", crt, "-key", key, "-cn", name})
+	assert.Nil(t, err)
+
+	return crt, key
+}
+
+func readFile(t *testing.T, path string) string {
+	data, err := os.ReadFile(path)
+	assert.Nil(t, err)
+
+	return string(data)
+}
+
+// returns the backup files in dir
+func backupFiles(t *testing.T, dir string) []string {
+	backups, err := filepath.Glob(filepath.Join(dir, "*"+certBackupSuffix+"*"))
+	assert.Nil(t, err)
+
+	return backups
+}
+
+func Test_CertCommand(t *testing.T) {
+	tests := []struct {
+		name string
+		// returns the arguments of the command
+		args  func(t *testing.T, dir string) []string
+		err   string
+		check func(t *testing.T, dir string)
+	}{
+		{
+			name: "missing command",
+			args: func(t *testing.T, dir string) []string { return nil },
+			err:  "missing cert command",
+		},
+		{
+			name: "unknown command",
+			args: func(t *testing.T, dir string) []string { return []string{"renew"} },
+			err:  "unknown cert command: renew",
+		},
+		{
+			name: "generate",
+			args: func(t *testing.T, dir string) []string {
+				return []string{"generate", "-crt", filepath.Join(dir, "new.crt"), "-key", filepath.Join(dir, "new.key")}
+			},
+			check: func(t *testing.T, dir string) {
+				certificate, err := tls.LoadX509KeyPair(filepath.Join(dir, "new.crt"), filepath.Join(dir, "new.key"))
+				assert.Nil(t, err)
+				assert.Nil(t, validateCertificate(certificate))
+				assert.Equal(t, 0, len(backupFiles(t, dir)))
+
+				info, err := os.Stat(filepath.Join(dir, "new.key"))
+				assert.Nil(t, err)
+				assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+			},
+		},
+		{
+			name: "generate refuses to overwrite",
+			args: func(t *testing.T, dir string) []string {
+				crt, key := generateFiles(t, dir, "cert")
+				return []string{"generate", "-crt", crt, "-key", key}
+			},
+			err: "already exists, use rotate to replace it",
+		},
+		{
+			name: "generate with force keeps a backup",
+			args: func(t *testing.T, dir string) []string {
+				crt, key := generateFiles(t, dir, "cert")
+				return []string{"generate", "-crt", crt, "-key", key, "-force"}
+			},
+			check: func(t *testing.T, dir string) {
+				assert.Equal(t, 2, len(backupFiles(t, dir)))
+
+				_, err := tls.LoadX509KeyPair(filepath.Join(dir, "cert.crt"), filepath.Join(dir, "cert.key"))
+				assert.Nil(t, err)
+			},
+		},
+		{
+			name: "show",
+			args: func(t *testing.T, dir string) []string {
+				crt, _ := generateFiles(t, dir, "cert")
+				return []string{"show", "-crt", crt}
+			},
+		},
+		{
+			name: "show without a certificate",
+			args: func(t *testing.T, dir string) []string {
+				_, key := generateFiles(t, dir, "cert")
+				return []string{"show", "-crt", key}
+			},
+			err: "does not contain a PEM encoded certificate",
+		},
+		{
+			name: "validate",
+			args: func(t *testing.T, dir string) []string {
+				crt, key := generateFiles(t, dir, "cert")
+				return []string{"validate", "-crt", crt, "-key", key}
+			},
+		},
+		{
+			name: "validate a mismatched pair",
+			args: func(t *testing.T, dir string) []string {
+				crt, _ := generateFiles(t, dir, "first")
+				_, key := generateFiles(t, dir, "second")
+				return []string{"validate", "-crt", crt, "-key", key}
+			},
+			err: "private key does not match public key",
+		},
+		{
+			name: "rotate keeps a backup",
+			args: func(t *testing.T, dir string) []string {
+				crt, key := generateFiles(t, dir, "cert")
+				assert.Nil(t, os.WriteFile(filepath.Join(dir, "previous.crt"), []byte(readFile(t, crt)), 0600))
+				assert.Nil(t, os.WriteFile(filepath.Join(dir, "previous.key"), []byte(readFile(t, key)), 0600))
+				return []string{"rotate", "-crt", crt, "-key", key}
+			},
+			check: func(t *testing.T, dir string) {
+				crt, key := filepath.Join(dir, "cert.crt"), filepath.Join(dir, "cert.key")
+				assert.NotEqual(t, readFile(t, filepath.Join(dir, "previous.crt")), readFile(t, crt))
+				assert.NotEqual(t, readFile(t, filepath.Join(dir, "previous.key")), readFile(t, key))
+
+				certificate, err := tls.LoadX509KeyPair(crt, key)
+				assert.Nil(t, err)
+				assert.Nil(t, validateCertificate(certificate))
+
+				backups := backupFiles(t, dir)
+				assert.Equal(t, 2, len(backups))
+				for _, backup := range backups {
+					name := "previous" + filepath.Ext(strings.Split(backup, certBackupSuffix)[0])
+					assert.Equal(t, readFile(t, filepath.Join(dir, name)), readFile(t, backup))
+				}
+			},
+		},
+		{
+			name: "rotate a mismatched pair",
+			args: func(t *testing.T, dir string) []string {
+				crt, _ := generateFiles(t, dir, "first")
+				_, key := generateFiles(t, dir, "second")
+				return []string{"rotate", "-crt", crt, "-key", key}
+			},
+			err: "private key does not match public key",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			err := certCommand(test.args(t, dir))
+			if test.err != "" {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), test.err)
+				}
+				return
+			}
+
+			assert.Nil(t, err)
+			if test.check != nil {
+				test.check(t, dir)
+			}
+		})
+	}
+}
+
+func Test_CertRotateFailedRename(t *testing.T) {
+	dir := t.TempDir()
+	crt, key := generateFiles(t, dir, "cert")
+	previousCrt, previousKey := readFile(t, crt), readFile(t, key)
+
+	// the key is not replaced after the certificate was replaced
+	files := newCertFiles(crt, key)
+	failed := false
+	files.rename = func(oldPath, newPath string) error {
+		if newPath == key && !failed {
+			failed = true
+			return errors.New("rename failed")
+		}
+		return os.Rename(oldPath, newPath)
+	}
+
+	err := rotateCertificate(files, addCertSubjectFlags(flag.NewFlagSet("test", flag.ContinueOnError)), nil)
+	assert.NotNil(t, err)
+	assert.True(t, failed)
+
+	// the backup is restored
+	assert.Equal(t, previousCrt, readFile(t, crt))
+	assert.Equal(t, previousKey, readFile(t, key))
+
+	_, err = tls.LoadX509KeyPair(crt, key)
+	assert.Nil(t, err)
+}
+
+func Test_CertLoad(t *testing.T) {
+	dir := t.TempDir()
+	crt, key := generateFiles(t, dir, "cert")
+	previousCrt, previousKey := readFile(t, crt), readFile(t, key)
+
+	_, err := newCertFiles(crt, key).load()
+	assert.Nil(t, err)
+
+	// a mismatched pair without a backup is not changed
+	otherCrt, _ := generateFiles(t, dir, "other")
+	assert.Nil(t, os.WriteFile(crt, []byte(readFile(t, otherCrt)), 0600))
+
+	_, err = newCertFiles(crt, key).load()
+	assert.NotNil(t, err)
+
+	// an interrupted rotation replaced only the certificate
+	suffix := certBackupSuffix + "20240101000000"
+	assert.Nil(t, os.WriteFile(crt+suffix, []byte(previousCrt), 0600))
+	assert.Nil(t, os.WriteFile(key+suffix, []byte(previousKey), 0600))
+	assert.Nil(t, os.WriteFile(crt, []byte(readFile(t, otherCrt)), 0600))
+
+	certificate, err := newCertFiles(crt, key).load()
+	assert.Nil(t, err)
+	assert.Nil(t, validateCertificate(certificate))
+	assert.Equal(t, previousCrt, readFile(t, crt))
+	assert.Equal(t, previousKey, readFile(t, key))
+
+	// files which both differ from the newest backup are not an interrupted rotation
+	_, otherKey := generateFiles(t, dir, "third")
+	assert.Nil(t, os.WriteFile(crt, []byte(readFile(t, otherCrt)), 0600))
+	assert.Nil(t, os.WriteFile(key, []byte(readFile(t, otherKey)), 0600))
+
+	_, err = newCertFiles(crt, key).load()
+	assert.NotNil(t, err)
+	assert.Equal(t, readFile(t, otherCrt), readFile(t, crt))
+}
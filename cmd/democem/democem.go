@@ -1,23 +1,69 @@
 package democem
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+
 	"github.com/enbility/cemd/cem"
-	"github.com/enbility/cemd/ucevsecc"
+	"github.com/enbility/cemd/config"
+	"github.com/enbility/cemd/metrics"
+	"github.com/enbility/cemd/modbus"
+	"github.com/enbility/cemd/mqtt"
+	"github.com/enbility/cemd/registry"
 	eebusapi "github.com/enbility/eebus-go/api"
 	"github.com/enbility/ship-go/logging"
 )
 
 type DemoCem struct {
 	cem *cem.Cem
+
+	config *config.Config
+
+	registry *registry.Registry
+
+	exporter *metrics.Exporter
+
+	bridge *mqtt.Bridge
+
+	facade *modbus.Facade
 }
 
-func NewDemoCem(configuration *eebusapi.Configuration) *DemoCem {
-	demo := &DemoCem{}
+func NewDemoCem(
+	cfg *config.Config,
+	configuration *eebusapi.Configuration,
+	store registry.StoreInterface,
+	policy registry.ApprovalPolicyInterface,
+	recorder *cem.Recorder) (*DemoCem, error) {
+	demo := &DemoCem{
+		config: cfg,
+	}
+
+	reg, err := registry.NewRegistry(store, policy, demo)
+	if err != nil {
+		return nil, err
+	}
+	demo.registry = reg
 
-	noLogging := &logging.NoLogging{}
-	demo.cem = cem.NewCEM(configuration, demo, demo.eventCB, noLogging)
+	demo.cem = cem.NewCEM(configuration, reg, demo.eventCB, &logging.NoLogging{})
+	demo.cem.Currency = cfg.CurrencyType()
+	if recorder != nil {
+		if err := demo.cem.SetRecorder(recorder); err != nil {
+			return nil, err
+		}
+	}
+	reg.SetService(demo.cem.Service)
 
-	return demo
+	if cfg.Metrics.Listen != "" {
+		demo.exporter = metrics.NewExporter(demo.cem.Service)
+		demo.exporter.SetDeviceNameProvider(demo.deviceName)
+	}
+
+	return demo, nil
 }
 
 func (d *DemoCem) Setup() error {
@@ -25,10 +71,157 @@ func (d *DemoCem) Setup() error {
 		return err
 	}
 
-	evsecc := ucevsecc.NewUCEVSECC(d.cem.Service, d.eventCB)
-	d.cem.AddUseCase(evsecc)
+	if d.config.MQTT.Broker != "" {
+		if err := d.setupBridge(); err != nil {
+			return fmt.Errorf("mqtt: %w", err)
+		}
+	}
+
+	if d.config.Modbus.Listen != "" {
+		d.facade = modbus.NewFacade()
+		for unit, ski := range d.config.Modbus.Units {
+			if err := d.facade.AddUnit(byte(unit), ski); err != nil {
+				return fmt.Errorf("modbus.units %d: %w", unit, err)
+			}
+		}
+	}
+
+	for _, name := range config.UseCaseNames {
+		if !d.config.UseCaseEnabled(name) {
+			continue
+		}
+
+		usecase := useCaseConstructors[name](d.cem.Service, d.eventCB)
+		if err := applyUseCaseOptions(usecase, d.config.UseCases[name]); err != nil {
+			return fmt.Errorf("usecases.%s: %w", name, err)
+		}
+		d.cem.AddUseCase(usecase)
+
+		if d.exporter != nil {
+			d.exporter.AddUseCase(usecase)
+		}
+		if d.bridge != nil {
+			d.bridge.AddUseCase(usecase)
+		}
+		if d.facade != nil {
+			d.facade.AddUseCase(usecase)
+		}
+	}
+
+	if err := d.registry.Setup(); err != nil {
+		return err
+	}
 
 	d.cem.Start()
 
+	if d.bridge != nil {
+		if err := d.bridge.Start(); err != nil {
+			return fmt.Errorf("mqtt: %w", err)
+		}
+	}
+
+	if d.facade != nil {
+		go func() {
+			if err := d.facade.ListenAndServe(d.config.Modbus.Listen); err != nil {
+				fmt.Println("modbus:", err)
+			}
+		}()
+	}
+
+	if d.exporter != nil {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", d.exporter)
+
+		go func() {
+			if err := http.ListenAndServe(d.config.Metrics.Listen, mux); err != nil {
+				fmt.Println("metrics:", err)
+			}
+		}()
+	}
+
 	return nil
 }
+
+// connect to the MQTT broker and create the bridge
+func (d *DemoCem) setupBridge() error {
+	cfg := d.config.MQTT
+	options := mqtt.BridgeOptions{
+		TopicPrefix:     cfg.TopicPrefix,
+		Discovery:       cfg.Discovery,
+		DiscoveryPrefix: cfg.DiscoveryPrefix,
+	}
+
+	var tlsConfig *tls.Config
+	if cfg.TLS {
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+
+		if cfg.CAFile != "" {
+			data, err := os.ReadFile(cfg.CAFile)
+			if err != nil {
+				return err
+			}
+
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(data) {
+				return fmt.Errorf("no certificates found in %s", cfg.CAFile)
+			}
+		}
+	}
+
+	// the bridge is created after the client, but needs to publish its state again after a reconnect
+	var bridge atomic.Pointer[mqtt.Bridge]
+
+	client, err := mqtt.Connect(mqtt.ClientOptions{
+		Address:       cfg.Broker,
+		TLSConfig:     tlsConfig,
+		ClientID:      cfg.ClientID,
+		Username:      cfg.Username,
+		Password:      cfg.Password,
+		Will:          mqtt.WillMessage(options),
+		AutoReconnect: true,
+		OnConnectionLost: func(err error) {
+			fmt.Println("mqtt: connection lost, reconnecting:", err)
+		},
+		OnReconnect: func() {
+			fmt.Println("mqtt: reconnected")
+
+			if bridge := bridge.Load(); bridge != nil {
+				if err := bridge.Start(); err != nil {
+					fmt.Println("mqtt:", err)
+				}
+			}
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.bridge = mqtt.NewBridge(client, options)
+	d.bridge.SetDeviceNameProvider(d.deviceName)
+	bridge.Store(d.bridge)
+
+	return nil
+}
+
+// return the name of a device in the registry for the metrics labels and MQTT discovery
+func (d *DemoCem) deviceName(ski string) string {
+	device, err := d.registry.Device(ski)
+	if err != nil {
+		return ""
+	}
+
+	if device.Name != "" {
+		return device.Name
+	}
+
+	return strings.TrimSpace(device.Brand + " " + device.Model)
+}
+
+// Start pairing with a remote SKI if it is not yet trusted
+func (d *DemoCem) PairRemoteSKI(ski string) {
+	if device, err := d.registry.Device(ski); err == nil && device.Trusted {
+		return
+	}
+
+	d.registry.Pair(ski)
+}
@@ -1,10 +1,59 @@
 package democem
 
 import (
+	"fmt"
+
 	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
 	spineapi "github.com/enbility/spine-go/api"
 )
 
 // Handle incoming usecase specific events
 func (h *DemoCem) eventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	switch event {
+	case cem.RemoteServicesUpdated, cem.PairingWaitingForTrust, cem.PairingTrusted, cem.PairingDenied, cem.PairingCanceled, cem.PairingError:
+		// the service events are not related to a connected device
+		h.serviceEvent(ski, event)
+		return
+	}
+
+	if h.exporter != nil {
+		h.exporter.HandleEvent(ski, device, entity, event)
+	}
+	if h.bridge != nil {
+		h.bridge.HandleEvent(ski, device, entity, event)
+	}
+	if h.facade != nil {
+		h.facade.HandleEvent(ski, device, entity, event)
+	}
+}
+
+// print the visible remote services and pairing state changes
+func (h *DemoCem) serviceEvent(ski string, event api.EventType) {
+	switch event {
+	case cem.RemoteServicesUpdated:
+		fmt.Println("Visible EEBUS services:")
+		for _, service := range h.cem.VisibleRemoteServices() {
+			state := "not paired"
+			if service.Registered {
+				state = "paired"
+			}
+			fmt.Printf("  %s %s %s (%s), %s\n", service.Ski, service.Brand, service.Model, service.DeviceType, state)
+		}
+
+	case cem.PairingWaitingForTrust:
+		fmt.Println("Pairing with SKI", ski, "is waiting for trust")
+
+	case cem.PairingTrusted:
+		fmt.Println("SKI", ski, "is trusted")
+
+	case cem.PairingDenied:
+		fmt.Println("SKI", ski, "denied the pairing")
+
+	case cem.PairingCanceled:
+		fmt.Println("Pairing with SKI", ski, "was canceled")
+
+	case cem.PairingError:
+		fmt.Println("Pairing with SKI", ski, "failed")
+	}
 }
@@ -13,11 +13,13 @@ func (d *DemoCem) RemoteSKIConnected(service eebusapi.ServiceInterface, ski stri
 
 func (d *DemoCem) RemoteSKIDisconnected(service eebusapi.ServiceInterface, ski string) {}
 
+// the visible services are tracked by the CEM and reported via the cem.RemoteServicesUpdated event
 func (d *DemoCem) VisibleRemoteServicesUpdated(service eebusapi.ServiceInterface, entries []shipapi.RemoteService) {
 }
 
 func (h *DemoCem) ServiceShipIDUpdate(ski string, shipdID string) {}
 
+// the pairing state changes are reported via the cem pairing events
 func (h *DemoCem) ServicePairingDetailUpdate(ski string, detail *shipapi.ConnectionStateDetail) {}
 
 func (h *DemoCem) AllowWaitingForTrust(ski string) bool { return true }
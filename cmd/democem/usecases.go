@@ -0,0 +1,81 @@
+package democem
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/config"
+	"github.com/enbility/cemd/uccevc"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevcem"
+	"github.com/enbility/cemd/ucevsecc"
+	"github.com/enbility/cemd/ucevsoc"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmpc"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/ucoscev"
+	"github.com/enbility/cemd/ucvabd"
+	"github.com/enbility/cemd/ucvapd"
+	eebusapi "github.com/enbility/eebus-go/api"
+)
+
+type useCaseConstructor func(eebusapi.ServiceInterface, api.EventHandlerCB) api.UseCaseInterface
+
+// the use cases which can be enabled in the configuration, the key is the config name
+var useCaseConstructors = map[string]useCaseConstructor{
+	"cevc": func(s eebusapi.ServiceInterface, cb api.EventHandlerCB) api.UseCaseInterface {
+		return uccevc.NewUCCEVC(s, cb)
+	},
+	"evcc": func(s eebusapi.ServiceInterface, cb api.EventHandlerCB) api.UseCaseInterface {
+		return ucevcc.NewUCEVCC(s, cb)
+	},
+	"evcem": func(s eebusapi.ServiceInterface, cb api.EventHandlerCB) api.UseCaseInterface {
+		return ucevcem.NewUCEVCEM(s, cb)
+	},
+	"evsecc": func(s eebusapi.ServiceInterface, cb api.EventHandlerCB) api.UseCaseInterface {
+		return ucevsecc.NewUCEVSECC(s, cb)
+	},
+	"evsoc": func(s eebusapi.ServiceInterface, cb api.EventHandlerCB) api.UseCaseInterface {
+		return ucevsoc.NewUCEVSOC(s, cb)
+	},
+	"mgcp": func(s eebusapi.ServiceInterface, cb api.EventHandlerCB) api.UseCaseInterface {
+		return ucmgcp.NewUCMGCP(s, cb)
+	},
+	"mpc": func(s eebusapi.ServiceInterface, cb api.EventHandlerCB) api.UseCaseInterface {
+		return ucmpc.NewUCMPC(s, cb)
+	},
+	"opev": func(s eebusapi.ServiceInterface, cb api.EventHandlerCB) api.UseCaseInterface {
+		return ucopev.NewUCOPEV(s, cb)
+	},
+	"oscev": func(s eebusapi.ServiceInterface, cb api.EventHandlerCB) api.UseCaseInterface {
+		return ucoscev.NewUCOSCEV(s, cb)
+	},
+	"vabd": func(s eebusapi.ServiceInterface, cb api.EventHandlerCB) api.UseCaseInterface {
+		return ucvabd.NewUCVABD(s, cb)
+	},
+	"vapd": func(s eebusapi.ServiceInterface, cb api.EventHandlerCB) api.UseCaseInterface {
+		return ucvapd.NewUCVAPD(s, cb)
+	},
+}
+
+// apply the options of a use case configuration
+//
+// supported options:
+//   - staleThreshold: the age after which measurements are reported as stale, e.g. 30s
+func applyUseCaseOptions(usecase api.UseCaseInterface, cfg config.UseCaseConfig) error {
+	if value, ok := cfg.Options["staleThreshold"]; ok {
+		threshold, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("staleThreshold: %w", err)
+		}
+
+		staleUsecase, ok := usecase.(interface{ SetStaleThreshold(time.Duration) })
+		if !ok {
+			return fmt.Errorf("staleThreshold is not supported by %s", usecase.UseCaseName())
+		}
+		staleUsecase.SetStaleThreshold(threshold)
+	}
+
+	return nil
+}
@@ -1,76 +1,141 @@
 package main
 
 import (
-	"crypto/tls"
 	"flag"
 	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
-	"time"
 
+	"github.com/enbility/cemd/cem"
 	"github.com/enbility/cemd/cmd/democem"
-	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/cemd/config"
+	"github.com/enbility/cemd/registry"
 	"github.com/enbility/ship-go/cert"
-	"github.com/enbility/spine-go/model"
 )
 
 // main app
 func main() {
-	remoteSki := flag.String("remoteski", "", "The remote device SKI")
+	if len(os.Args) > 1 && os.Args[1] == "cert" {
+		if err := certCommand(os.Args[2:]); err != nil {
+			fmt.Println("Error:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	configFile := flag.String("config", "", "Optional filepath for a YAML or JSON config file, flags override its values")
+	remoteSki := flag.String("remoteski", "", "Optional remote device SKI to pair with")
+	registryFile := flag.String("registry", "devices.json", "Optional filepath for the device registry file")
+	pairing := flag.String("pairing", "allowlist", "Optional policy for incoming pairing requests: auto, allowlist (remoteski and the configured allow list) or manual")
 	port := flag.Int("port", 4815, "Optional port for the EEBUS service")
 	crt := flag.String("crt", "cert.crt", "Optional filepath for the cert file")
 	key := flag.String("key", "cert.key", "Optional filepath for the key file")
 	iface := flag.String("iface", "", "Optional network interface the EEBUS connection should be limited to")
+	recordFile := flag.String("record", "", "Optional filepath to record all SPINE datagrams to, for replaying them in tests")
+	transientCert := flag.Bool("transient-cert", false, "Use a temporary certificate if the cert and key files can not be loaded, this changes the SKI on every start")
 
 	flag.Parse()
 
-	if len(os.Args) == 1 || remoteSki == nil || *remoteSki == "" {
-		flag.Usage()
-		return
+	cfg, err := config.Load(*configFile)
+	if err != nil {
+		fmt.Println("Error loading configuration:", err)
+		os.Exit(1)
+	}
+
+	// explicitly set flags take precedence over the config file and environment
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "registry":
+			cfg.Registry.File = *registryFile
+		case "pairing":
+			cfg.Registry.Pairing = *pairing
+		case "port":
+			cfg.Service.Port = *port
+		case "crt":
+			cfg.Certificate.CertFile = *crt
+		case "key":
+			cfg.Certificate.KeyFile = *key
+		case "iface":
+			cfg.Service.Interfaces = []string{*iface}
+		}
+	})
+	if *remoteSki != "" {
+		cfg.Registry.AllowList = append(cfg.Registry.AllowList, *remoteSki)
+	}
+	if err := cfg.Validate(); err != nil {
+		fmt.Println("Invalid configuration:", err)
+		os.Exit(1)
+	}
+
+	var policy registry.ApprovalPolicyInterface
+	switch cfg.Registry.Pairing {
+	case "auto":
+		policy = &registry.AutoAcceptPolicy{}
+	case "allowlist":
+		policy = registry.NewAllowListPolicy(cfg.Registry.AllowList...)
+	case "manual":
+		policy = &registry.ManualPolicy{}
 	}
 
-	certificate, err := tls.LoadX509KeyPair(*crt, *key)
+	// an interrupted certificate rotation is recovered from the backup files
+	certificate, err := newCertFiles(cfg.Certificate.CertFile, cfg.Certificate.KeyFile).load()
+	if err == nil {
+		err = validateCertificate(certificate)
+	}
 	if err != nil {
+		if !*transientCert {
+			fmt.Println("Error loading certificate:", err)
+			fmt.Println("Create one with \"cert generate\" or start with -transient-cert")
+			os.Exit(1)
+		}
+
+		fmt.Println("Using a transient certificate, all pairings will be lost on restart")
 		certificate, err = cert.CreateCertificate("Demo", "Demo", "DE", "Demo-Unit-10")
 		if err != nil {
 			log.Fatal(err)
 		}
 	} else {
-		fmt.Println("Using certificate file", *crt, "and key file", *key)
+		fmt.Println("Using certificate file", cfg.Certificate.CertFile, "and key file", cfg.Certificate.KeyFile)
+	}
+	if err := printCertificateDetails(certificate); err != nil {
+		log.Fatal(err)
 	}
 
-	configuration, err := eebusapi.NewConfiguration(
-		"Demo",
-		"Demo",
-		"HEMS",
-		"123456789",
-		model.DeviceTypeTypeEnergyManagementSystem,
-		[]model.EntityTypeType{model.EntityTypeTypeCEM},
-		*port,
-		certificate,
-		230,
-		time.Second*4)
+	configuration, err := cfg.ServiceConfiguration(certificate)
 	if err != nil {
 		fmt.Println("Service data is invalid:", err)
 		return
 	}
 
-	if iface != nil && *iface != "" {
-		ifaces := []string{*iface}
+	var recorder *cem.Recorder
+	if *recordFile != "" {
+		file, err := os.OpenFile(*recordFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			fmt.Println("Error opening recording file:", err)
+			os.Exit(1)
+		}
+		defer file.Close()
 
-		configuration.SetInterfaces(ifaces)
+		recorder = cem.NewRecorder(file)
+		fmt.Println("Recording SPINE datagrams to", *recordFile)
+	}
+
+	demo, err := democem.NewDemoCem(cfg, configuration, registry.NewFileStore(cfg.Registry.File), policy, recorder)
+	if err != nil {
+		fmt.Println("Error loading device registry: ", err)
+		return
 	}
 
-	demo := democem.NewDemoCem(configuration)
 	if err := demo.Setup(); err != nil {
 		fmt.Println("Error setting up cem: ", err)
 		return
 	}
 
-	// remoteService := shipapi.NewServiceDetails(*remoteSki)
-	// demo.emobilityScenario.RegisterRemoteDevice(remoteService, nil)
+	if *remoteSki != "" {
+		demo.PairRemoteSKI(*remoteSki)
+	}
 
 	// Clean exit to make sure mdns shutdown is invoked
 	sig := make(chan os.Signal, 1)
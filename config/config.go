@@ -0,0 +1,220 @@
+package config
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"gopkg.in/yaml.v3"
+)
+
+// return the default configuration, which matches the demo setup
+func Default() *Config {
+	return &Config{
+		Service: ServiceConfig{
+			Vendor:           "Demo",
+			Brand:            "Demo",
+			Model:            "HEMS",
+			Serial:           "123456789",
+			DeviceType:       string(model.DeviceTypeTypeEnergyManagementSystem),
+			Port:             4815,
+			HeartbeatTimeout: Duration(time.Second * 4),
+		},
+		Certificate: CertificateConfig{
+			CertFile: "cert.crt",
+			KeyFile:  "cert.key",
+		},
+		Registry: RegistryConfig{
+			File:    "devices.json",
+			Pairing: "allowlist",
+		},
+		MQTT: MQTTConfig{
+			ClientID:        "cemd",
+			TopicPrefix:     "cemd",
+			DiscoveryPrefix: "homeassistant",
+		},
+		Voltage:  230,
+		Currency: string(model.CurrencyTypeEur),
+		UseCases: map[string]UseCaseConfig{
+			"evsecc": {Enabled: true},
+		},
+	}
+}
+
+// load the configuration
+//
+// the defaults are overwritten by the config file, if a path is provided,
+// and then by the environment variables. The result is validated.
+func Load(path string) (*Config, error) {
+	config := Default()
+
+	if path != "" {
+		if err := config.readFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := config.ApplyEnvironment(os.LookupEnv); err != nil {
+		return nil, err
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// read a JSON or YAML file, depending on the file extension
+func (c *Config) readFile(path string) error {
+	extension := strings.ToLower(filepath.Ext(path))
+	if !slices.Contains([]string{".json", ".yaml", ".yml"}, extension) {
+		return ErrUnsupportedFormat
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	// use cases listed in the file replace the default use cases instead of being merged
+	defaultUseCases := c.UseCases
+	c.UseCases = nil
+
+	if extension == ".json" {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(c)
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(c)
+	}
+	// an empty file does not change anything
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	if c.UseCases == nil {
+		c.UseCases = defaultUseCases
+	}
+
+	return nil
+}
+
+var currencyRegex = regexp.MustCompile("^[A-Z]{3}$")
+
+// check the configuration and return all problems found
+func (c *Config) Validate() error {
+	var errs []error
+
+	required := map[string]string{
+		"service.brand":        c.Service.Brand,
+		"service.model":        c.Service.Model,
+		"service.serial":       c.Service.Serial,
+		"service.deviceType":   c.Service.DeviceType,
+		"certificate.certFile": c.Certificate.CertFile,
+		"certificate.keyFile":  c.Certificate.KeyFile,
+	}
+	for _, name := range sortedKeys(required) {
+		if required[name] == "" {
+			errs = append(errs, fmt.Errorf("%s is required", name))
+		}
+	}
+
+	if c.Service.Port < 1 || c.Service.Port > 65535 {
+		errs = append(errs, fmt.Errorf("service.port %d is not between 1 and 65535", c.Service.Port))
+	}
+
+	if c.Service.HeartbeatTimeout <= 0 {
+		errs = append(errs, errors.New("service.heartbeatTimeout needs to be positive"))
+	}
+
+	if c.MQTT.Broker != "" && c.MQTT.TopicPrefix == "" {
+		errs = append(errs, errors.New("mqtt.topicPrefix is required"))
+	}
+
+	for _, unit := range sortedKeys(c.Modbus.Units) {
+		if unit < 1 || unit > 247 {
+			errs = append(errs, fmt.Errorf("modbus.units %d is not between 1 and 247", unit))
+		}
+	}
+
+	if c.Voltage <= 0 {
+		errs = append(errs, fmt.Errorf("voltage %v needs to be positive", c.Voltage))
+	}
+
+	if !currencyRegex.MatchString(c.Currency) {
+		errs = append(errs, fmt.Errorf("currency %q is not an ISO 4217 code, e.g. EUR", c.Currency))
+	}
+
+	if !slices.Contains(PairingPolicies, c.Registry.Pairing) {
+		errs = append(errs, fmt.Errorf("registry.pairing %q is not one of %s", c.Registry.Pairing, strings.Join(PairingPolicies, ", ")))
+	}
+
+	for _, name := range sortedKeys(c.UseCases) {
+		if !slices.Contains(UseCaseNames, name) {
+			errs = append(errs, fmt.Errorf("usecases.%s is not one of %s", name, strings.Join(UseCaseNames, ", ")))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// return if a use case is enabled
+func (c *Config) UseCaseEnabled(name string) bool {
+	usecase, ok := c.UseCases[name]
+
+	return ok && usecase.Enabled
+}
+
+// return the configured currency
+func (c *Config) CurrencyType() model.CurrencyType {
+	return model.CurrencyType(c.Currency)
+}
+
+// return the EEBUS service configuration using the provided certificate
+func (c *Config) ServiceConfiguration(certificate tls.Certificate) (*eebusapi.Configuration, error) {
+	configuration, err := eebusapi.NewConfiguration(
+		c.Service.Vendor,
+		c.Service.Brand,
+		c.Service.Model,
+		c.Service.Serial,
+		model.DeviceTypeType(c.Service.DeviceType),
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		c.Service.Port,
+		certificate,
+		c.Voltage,
+		time.Duration(c.Service.HeartbeatTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.Service.Interfaces) > 0 {
+		configuration.SetInterfaces(c.Service.Interfaces)
+	}
+
+	return configuration, nil
+}
+
+func sortedKeys[K cmp.Ordered, T any](data map[K]T) []K {
+	keys := make([]K, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,213 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/enbility/ship-go/cert"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+const yamlConfig = `
+service:
+  vendor: Vendor
+  brand: Brand
+  model: Model
+  serial: "0001"
+  deviceType: EnergyManagementSystem
+  port: 4712
+  interfaces: [eth0]
+  heartbeatTimeout: 10s
+certificate:
+  certFile: hems.crt
+  keyFile: hems.key
+registry:
+  file: registry.json
+  pairing: manual
+metrics:
+  listen: ":9100"
+modbus:
+  listen: ":5020"
+  units:
+    1: ski1
+mqtt:
+  broker: "localhost:1883"
+  discovery: true
+voltage: 240
+currency: CHF
+usecases:
+  evcc:
+    enabled: true
+  evcem:
+    enabled: false
+    options:
+      key: value
+`
+
+const jsonConfig = `{
+  "service": {"brand": "Brand", "port": 4713},
+  "usecases": {"mgcp": {"enabled": true}}
+}`
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	err := os.WriteFile(path, []byte(content), 0600)
+	assert.Nil(t, err)
+
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	config, err := Load("")
+	assert.Nil(t, err)
+	assert.Equal(t, Default(), config)
+
+	config, err = Load(writeFile(t, "config.yaml", yamlConfig))
+	assert.Nil(t, err)
+	assert.Equal(t, "Vendor", config.Service.Vendor)
+	assert.Equal(t, "0001", config.Service.Serial)
+	assert.Equal(t, 4712, config.Service.Port)
+	assert.Equal(t, []string{"eth0"}, config.Service.Interfaces)
+	assert.Equal(t, Duration(time.Second*10), config.Service.HeartbeatTimeout)
+	assert.Equal(t, "hems.crt", config.Certificate.CertFile)
+	assert.Equal(t, "manual", config.Registry.Pairing)
+	assert.Equal(t, ":9100", config.Metrics.Listen)
+	assert.Equal(t, "localhost:1883", config.MQTT.Broker)
+	assert.Equal(t, ":5020", config.Modbus.Listen)
+	assert.Equal(t, map[int]string{1: "ski1"}, config.Modbus.Units)
+	assert.Equal(t, true, config.MQTT.Discovery)
+	assert.Equal(t, "cemd", config.MQTT.TopicPrefix)
+	assert.Equal(t, 240.0, config.Voltage)
+	assert.Equal(t, model.CurrencyTypeChf, config.CurrencyType())
+	assert.Equal(t, true, config.UseCaseEnabled("evcc"))
+	assert.Equal(t, false, config.UseCaseEnabled("evcem"))
+	assert.Equal(t, false, config.UseCaseEnabled("evsecc"))
+	assert.Equal(t, "value", config.UseCases["evcem"].Options["key"])
+
+	// values not in the file keep their defaults
+	config, err = Load(writeFile(t, "config.json", jsonConfig))
+	assert.Nil(t, err)
+	assert.Equal(t, "Brand", config.Service.Brand)
+	assert.Equal(t, "HEMS", config.Service.Model)
+	assert.Equal(t, 4713, config.Service.Port)
+	assert.Equal(t, true, config.UseCaseEnabled("mgcp"))
+
+	config, err = Load(writeFile(t, "config.yml", ""))
+	assert.Nil(t, err)
+	assert.Equal(t, Default(), config)
+
+	_, err = Load(writeFile(t, "config.toml", ""))
+	assert.Equal(t, ErrUnsupportedFormat, err)
+
+	_, err = Load(writeFile(t, "config.json", `{"service": {"unknown": 1}}`))
+	assert.NotNil(t, err)
+
+	_, err = Load(writeFile(t, "config.yaml", "service:\n  heartbeatTimeout: soon\n"))
+	assert.NotNil(t, err)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.NotNil(t, err)
+
+	_, err = Load(writeFile(t, "config.yaml", "voltage: -1\n"))
+	assert.ErrorContains(t, err, "voltage")
+}
+
+func TestValidate(t *testing.T) {
+	config := Default()
+	assert.Nil(t, config.Validate())
+
+	config.Service.Brand = ""
+	config.Service.Port = 0
+	config.Service.HeartbeatTimeout = 0
+	config.Certificate.KeyFile = ""
+	config.Currency = "euro"
+	config.Registry.Pairing = "always"
+	config.UseCases["unknown"] = UseCaseConfig{Enabled: true}
+	config.MQTT.Broker = "localhost:1883"
+	config.MQTT.TopicPrefix = ""
+	config.Modbus.Units = map[int]string{0: "ski1", 1: "ski2"}
+
+	err := config.Validate()
+	assert.NotNil(t, err)
+	for _, item := range []string{
+		"service.brand is required",
+		"certificate.keyFile is required",
+		"service.port 0",
+		"service.heartbeatTimeout",
+		"currency \"euro\"",
+		"registry.pairing \"always\"",
+		"usecases.unknown",
+		"mqtt.topicPrefix",
+		"modbus.units 0",
+	} {
+		assert.ErrorContains(t, err, item)
+	}
+}
+
+func TestApplyEnvironment(t *testing.T) {
+	env := map[string]string{
+		"CEMD_BRAND":             "EnvBrand",
+		"CEMD_PORT":              "5000",
+		"CEMD_INTERFACES":        "eth0, wlan0,",
+		"CEMD_HEARTBEAT_TIMEOUT": "6s",
+		"CEMD_VOLTAGE":           "120",
+		"CEMD_CURRENCY":          "USD",
+		"CEMD_ALLOW_LIST":        "ski1,ski2",
+		"CEMD_USECASES":          "evcc,evsoc",
+		"CEMD_METRICS_LISTEN":    "localhost:9100",
+		"CEMD_MQTT_BROKER":       "broker:1883",
+		"CEMD_MODBUS_LISTEN":     ":5020",
+		"CEMD_MQTT_TOPIC_PREFIX": "home/cemd",
+	}
+	lookup := func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+
+	config := Default()
+	err := config.ApplyEnvironment(lookup)
+	assert.Nil(t, err)
+	assert.Nil(t, config.Validate())
+	assert.Equal(t, "EnvBrand", config.Service.Brand)
+	assert.Equal(t, 5000, config.Service.Port)
+	assert.Equal(t, []string{"eth0", "wlan0"}, config.Service.Interfaces)
+	assert.Equal(t, Duration(time.Second*6), config.Service.HeartbeatTimeout)
+	assert.Equal(t, 120.0, config.Voltage)
+	assert.Equal(t, model.CurrencyTypeUsd, config.CurrencyType())
+	assert.Equal(t, []string{"ski1", "ski2"}, config.Registry.AllowList)
+	assert.Equal(t, "localhost:9100", config.Metrics.Listen)
+	assert.Equal(t, "broker:1883", config.MQTT.Broker)
+	assert.Equal(t, ":5020", config.Modbus.Listen)
+	assert.Equal(t, "home/cemd", config.MQTT.TopicPrefix)
+	assert.Equal(t, true, config.UseCaseEnabled("evcc"))
+	assert.Equal(t, true, config.UseCaseEnabled("evsoc"))
+	assert.Equal(t, false, config.UseCaseEnabled("evsecc"))
+
+	for _, key := range []string{"CEMD_PORT", "CEMD_HEARTBEAT_TIMEOUT", "CEMD_VOLTAGE"} {
+		env[key] = "invalid"
+		err = Default().ApplyEnvironment(lookup)
+		assert.ErrorContains(t, err, key)
+		delete(env, key)
+	}
+}
+
+func TestServiceConfiguration(t *testing.T) {
+	certificate, err := cert.CreateCertificate("test", "test", "DE", "test")
+	assert.Nil(t, err)
+
+	config := Default()
+	config.Service.Interfaces = []string{"eth0"}
+
+	configuration, err := config.ServiceConfiguration(certificate)
+	assert.Nil(t, err)
+	assert.Equal(t, 4815, configuration.Port())
+	assert.Equal(t, []string{"eth0"}, configuration.Interfaces())
+	assert.Equal(t, 230.0, configuration.Voltage())
+
+	config.Service.Model = ""
+	_, err = config.ServiceConfiguration(certificate)
+	assert.NotNil(t, err)
+}
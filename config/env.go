@@ -0,0 +1,113 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// the prefix of all environment variables
+const EnvPrefix = "CEMD_"
+
+// overwrite settings with environment variables
+//
+// supported variables:
+//   - CEMD_VENDOR, CEMD_BRAND, CEMD_MODEL, CEMD_SERIAL, CEMD_DEVICE_TYPE
+//   - CEMD_PORT, CEMD_INTERFACES (comma separated), CEMD_HEARTBEAT_TIMEOUT (e.g. 4s)
+//   - CEMD_CERT_FILE, CEMD_KEY_FILE
+//   - CEMD_REGISTRY_FILE, CEMD_PAIRING, CEMD_ALLOW_LIST (comma separated)
+//   - CEMD_METRICS_LISTEN
+//   - CEMD_MODBUS_LISTEN
+//   - CEMD_MQTT_BROKER, CEMD_MQTT_CLIENT_ID, CEMD_MQTT_USERNAME, CEMD_MQTT_PASSWORD, CEMD_MQTT_TOPIC_PREFIX
+//   - CEMD_VOLTAGE, CEMD_CURRENCY
+//   - CEMD_USECASES (comma separated), enables only the listed use cases
+//
+// parameters:
+//   - lookup: the function used to read a variable, e.g. os.LookupEnv
+func (c *Config) ApplyEnvironment(lookup func(key string) (string, bool)) error {
+	values := map[string]*string{
+		"VENDOR":            &c.Service.Vendor,
+		"BRAND":             &c.Service.Brand,
+		"MODEL":             &c.Service.Model,
+		"SERIAL":            &c.Service.Serial,
+		"DEVICE_TYPE":       &c.Service.DeviceType,
+		"CERT_FILE":         &c.Certificate.CertFile,
+		"KEY_FILE":          &c.Certificate.KeyFile,
+		"REGISTRY_FILE":     &c.Registry.File,
+		"PAIRING":           &c.Registry.Pairing,
+		"CURRENCY":          &c.Currency,
+		"METRICS_LISTEN":    &c.Metrics.Listen,
+		"MODBUS_LISTEN":     &c.Modbus.Listen,
+		"MQTT_BROKER":       &c.MQTT.Broker,
+		"MQTT_CLIENT_ID":    &c.MQTT.ClientID,
+		"MQTT_USERNAME":     &c.MQTT.Username,
+		"MQTT_PASSWORD":     &c.MQTT.Password,
+		"MQTT_TOPIC_PREFIX": &c.MQTT.TopicPrefix,
+	}
+	for _, name := range sortedKeys(values) {
+		if value, ok := lookup(EnvPrefix + name); ok {
+			*values[name] = value
+		}
+	}
+
+	lists := map[string]*[]string{
+		"INTERFACES": &c.Service.Interfaces,
+		"ALLOW_LIST": &c.Registry.AllowList,
+	}
+	for _, name := range sortedKeys(lists) {
+		if value, ok := lookup(EnvPrefix + name); ok {
+			*lists[name] = splitList(value)
+		}
+	}
+
+	if value, ok := lookup(EnvPrefix + "PORT"); ok {
+		port, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%sPORT: %w", EnvPrefix, err)
+		}
+		c.Service.Port = port
+	}
+
+	if value, ok := lookup(EnvPrefix + "HEARTBEAT_TIMEOUT"); ok {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("%sHEARTBEAT_TIMEOUT: %w", EnvPrefix, err)
+		}
+		c.Service.HeartbeatTimeout = Duration(timeout)
+	}
+
+	if value, ok := lookup(EnvPrefix + "VOLTAGE"); ok {
+		voltage, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%sVOLTAGE: %w", EnvPrefix, err)
+		}
+		c.Voltage = voltage
+	}
+
+	if value, ok := lookup(EnvPrefix + "USECASES"); ok {
+		usecases := make(map[string]UseCaseConfig)
+		for _, name := range splitList(value) {
+			// keep the options of use cases configured in the file
+			usecase := c.UseCases[name]
+			usecase.Enabled = true
+			usecases[name] = usecase
+		}
+		c.UseCases = usecases
+	}
+
+	return nil
+}
+
+// split a comma separated list and remove empty items
+func splitList(value string) []string {
+	var result []string
+
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
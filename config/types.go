@@ -0,0 +1,166 @@
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+// Contains all settings of a CEM deployment
+type Config struct {
+	// the EEBUS service identity and network settings
+	Service ServiceConfig `json:"service" yaml:"service"`
+
+	// the certificate and key files
+	Certificate CertificateConfig `json:"certificate" yaml:"certificate"`
+
+	// the device registry settings
+	Registry RegistryConfig `json:"registry" yaml:"registry"`
+
+	// the metrics exporter settings
+	Metrics MetricsConfig `json:"metrics" yaml:"metrics"`
+
+	// the MQTT bridge settings
+	MQTT MQTTConfig `json:"mqtt" yaml:"mqtt"`
+
+	// the Modbus TCP server settings
+	Modbus ModbusConfig `json:"modbus" yaml:"modbus"`
+
+	// the sites grid voltage, used e.g. to calculate power values from currents
+	Voltage float64 `json:"voltage" yaml:"voltage"`
+
+	// the ISO 4217 currency code used for incentives, e.g. EUR
+	Currency string `json:"currency" yaml:"currency"`
+
+	// the use cases to enable and their options, the key is the use case name, e.g. evcc
+	UseCases map[string]UseCaseConfig `json:"usecases" yaml:"usecases"`
+}
+
+// Contains the EEBUS service identity and network settings
+type ServiceConfig struct {
+	// the vendors IANA PEN, if not set brand will be used instead
+	Vendor string `json:"vendor" yaml:"vendor"`
+
+	Brand  string `json:"brand" yaml:"brand"`
+	Model  string `json:"model" yaml:"model"`
+	Serial string `json:"serial" yaml:"serial"`
+
+	// the SPINE device type, e.g. EnergyManagementSystem
+	DeviceType string `json:"deviceType" yaml:"deviceType"`
+
+	// the port of the websocket server
+	Port int `json:"port" yaml:"port"`
+
+	// the network interfaces the service should be limited to, all if empty
+	Interfaces []string `json:"interfaces" yaml:"interfaces"`
+
+	// the SHIP heartbeat timeout
+	HeartbeatTimeout Duration `json:"heartbeatTimeout" yaml:"heartbeatTimeout"`
+}
+
+// Contains the paths of the certificate and key files
+type CertificateConfig struct {
+	CertFile string `json:"certFile" yaml:"certFile"`
+	KeyFile  string `json:"keyFile" yaml:"keyFile"`
+}
+
+// Contains the device registry settings
+type RegistryConfig struct {
+	// the file the paired devices are stored in
+	File string `json:"file" yaml:"file"`
+
+	// the policy for incoming pairing requests: auto, allowlist or manual
+	Pairing string `json:"pairing" yaml:"pairing"`
+
+	// the SKIs accepted by the allowlist policy
+	AllowList []string `json:"allowList" yaml:"allowList"`
+}
+
+// Contains the metrics exporter settings
+type MetricsConfig struct {
+	// the address the /metrics endpoint listens on, e.g. ":9100", disabled if empty
+	Listen string `json:"listen" yaml:"listen"`
+}
+
+// Contains the MQTT bridge settings
+type MQTTConfig struct {
+	// the address of the broker, e.g. "localhost:1883", disabled if empty
+	Broker string `json:"broker" yaml:"broker"`
+
+	// if the connection to the broker uses TLS
+	TLS bool `json:"tls" yaml:"tls"`
+
+	// the PEM file with the CA certificates to verify the broker, the system certificates are used if empty
+	CAFile string `json:"caFile" yaml:"caFile"`
+
+	ClientID string `json:"clientId" yaml:"clientId"`
+	Username string `json:"username" yaml:"username"`
+	Password string `json:"password" yaml:"password"`
+
+	// the prefix of all topics
+	TopicPrefix string `json:"topicPrefix" yaml:"topicPrefix"`
+
+	// if Home Assistant discovery messages should be published
+	Discovery bool `json:"discovery" yaml:"discovery"`
+
+	// the prefix of the discovery topics
+	DiscoveryPrefix string `json:"discoveryPrefix" yaml:"discoveryPrefix"`
+}
+
+// Contains the Modbus TCP server settings
+type ModbusConfig struct {
+	// the address the server listens on, e.g. ":502", disabled if empty
+	Listen string `json:"listen" yaml:"listen"`
+
+	// the remote device SKI of each Modbus unit, the key is the unit identifier
+	Units map[int]string `json:"units" yaml:"units"`
+}
+
+// Contains the settings of a use case
+type UseCaseConfig struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+
+	// use case specific options
+	Options map[string]string `json:"options" yaml:"options"`
+}
+
+// A time.Duration which is represented as a string like "4s" in config files
+type Duration time.Duration
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	value, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(value)
+
+	return nil
+}
+
+// the names of all supported use cases
+var UseCaseNames = []string{
+	"cevc",
+	"evcc",
+	"evcem",
+	"evsecc",
+	"evsoc",
+	"mgcp",
+	"mpc",
+	"opev",
+	"oscev",
+	"vabd",
+	"vapd",
+}
+
+// the supported pairing policies
+var PairingPolicies = []string{
+	"auto",
+	"allowlist",
+	"manual",
+}
+
+var ErrUnsupportedFormat = errors.New("unsupported config file format, use .json, .yaml or .yml")
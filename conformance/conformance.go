@@ -0,0 +1,285 @@
+// Package conformance verifies the use cases against the requirements of the EEBUS use case specifications
+//
+// Each use case package enumerates the mandatory requirements of its
+// specification in a conformance test, e.g. the features, functions,
+// subscriptions and bindings the CEM has to use and the time it may take to
+// respond. Run checks each requirement against a simulated remote device and
+// returns a report with the result of each requirement ID:
+//
+//	report := conformance.Run(conformance.Setup{
+//		UseCase: func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) api.UseCaseInterface {
+//			return ucopev.NewUCOPEV(service, eventCB)
+//		},
+//		Remote: func(device *cemtest.DeviceBuilder) *cemtest.EntityBuilder {
+//			return cemtest.EVISO15118WithSoC(cemtest.EVSE3Phase(device), 50)
+//		},
+//	}, requirements)
+//
+// The requirement IDs are assigned by cemd, they are not the IDs of the
+// specifications. An ID names the use case and the scenario the requirement
+// belongs to, followed by a number within that scenario, e.g. OPEV-S1-04 for
+// scenario 1 of OPEV. Requirements of the whole use case or of several
+// scenarios use G instead of the scenario, e.g. OPEV-G-01. The scenarios are
+// taken from the use case code, the requirements were not verified against
+// the sections of the specifications, so a passing report is no certification.
+//
+// Verify runs the requirements in a test. If the environment variable
+// CEMD_CONFORMANCE_REPORTS is set, the reports are also written to that
+// directory.
+package conformance
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cemtest"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// the time the simulated remote device waits for the CEM by default
+const DefaultTimeout = 5 * time.Second
+
+// the environment variable with the directory the reports of Verify are written to
+const ReportDirEnv = "CEMD_CONFORMANCE_REPORTS"
+
+// the reference of requirements the specifications don't define, but cemd expects, e.g. response times
+const ImplementationReference = "cemd implementation"
+
+// the SKI of the simulated remote device
+const RemoteSki = "conformanceremoteski"
+
+// the aspect of the use case a requirement is about
+type RequirementType string
+
+const (
+	RequirementTypeUseCase      RequirementType = "usecase"
+	RequirementTypeFeature      RequirementType = "feature"
+	RequirementTypeFunction     RequirementType = "function"
+	RequirementTypeSubscription RequirementType = "subscription"
+	RequirementTypeBinding      RequirementType = "binding"
+	RequirementTypeTiming       RequirementType = "timing"
+)
+
+// a requirement of a use case specification
+type Requirement struct {
+	// the ID of the requirement, unique within the use case, e.g. "OPEV-S1-04"
+	//
+	// the format is <use case>-S<scenario>-<number>, or <use case>-G-<number>
+	// for requirements of the whole use case, see the package documentation
+	Id string
+
+	Type RequirementType
+
+	// what is required, e.g. "the CEM binds to the LoadControl server feature"
+	Description string
+
+	// the document and section the requirement is taken from
+	Reference string
+
+	// verify the requirement, returns an error describing the violation
+	Check func(c *Context) error
+}
+
+// the use case and the simulated remote device used to verify the requirements
+type Setup struct {
+	// create the use case, its features and the use case support are added by Run
+	UseCase func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) api.UseCaseInterface
+
+	// optional, create the use cases the use case has to be used with, e.g. OPEV for OSCEV
+	Requires func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) []api.UseCaseInterface
+
+	// add the entities of the remote device, returns the entity the use case is verified against
+	Remote func(device *cemtest.DeviceBuilder) *cemtest.EntityBuilder
+}
+
+// Context is the state a requirement is checked with
+//
+// Each requirement is checked with a new service and use case, the remote
+// device is connected and the initial requests are answered.
+type Context struct {
+	Setup Setup
+
+	Service eebusapi.ServiceInterface
+	UseCase api.UseCaseInterface
+	Remote  *Remote
+
+	// the remote entity returned by Setup.Remote
+	Entity spineapi.EntityRemoteInterface
+}
+
+// returns the local CEM entity
+func (c *Context) LocalEntity() spineapi.EntityLocalInterface {
+	return c.Service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+}
+
+// returns the server feature of the remote entity
+//
+// possible errors:
+//   - the remote entity does not have a server feature of the type
+func (c *Context) RemoteFeature(featureType model.FeatureTypeType) (spineapi.FeatureRemoteInterface, error) {
+	feature := c.Entity.FeatureOfTypeAndRole(featureType, model.RoleTypeServer)
+	if feature == nil {
+		return nil, fmt.Errorf("the remote entity has no %s server feature", featureType)
+	}
+
+	return feature, nil
+}
+
+// run the requirements against the simulated remote device
+func Run(setup Setup, requirements []Requirement) *Report {
+	report := &Report{}
+
+	for _, requirement := range requirements {
+		start := time.Now()
+		useCase, err := check(setup, requirement)
+
+		if report.UseCase == "" {
+			report.UseCase = useCase
+		}
+
+		result := Result{
+			Id:          requirement.Id,
+			Type:        requirement.Type,
+			Description: requirement.Description,
+			Reference:   requirement.Reference,
+			Passed:      err == nil,
+			Duration:    time.Since(start),
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	return report
+}
+
+// check a requirement with a new context, returns the name of the use case
+func check(setup Setup, requirement Requirement) (model.UseCaseNameType, error) {
+	c, err := newContext(setup)
+	if c != nil {
+		defer c.close()
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if requirement.Check == nil {
+		return c.UseCase.UseCaseName(), errors.New("the requirement has no check")
+	}
+
+	return c.UseCase.UseCaseName(), requirement.Check(c)
+}
+
+// create a service with the use case and connect the simulated remote device
+func newContext(setup Setup) (*Context, error) {
+	certificate, err := cert.CreateCertificate("Demo", "Demo", "DE", "Demo-Unit-Conformance")
+	if err != nil {
+		return nil, err
+	}
+
+	configuration, err := eebusapi.NewConfiguration(
+		"Demo", "Demo", "HEMS", "123456789",
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		4815, certificate, 230, time.Second*4)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Context{Setup: setup}
+
+	c.Service = service.NewService(configuration, serviceHandler{})
+	if err := c.Service.Setup(); err != nil {
+		return nil, err
+	}
+
+	eventCB := func(string, spineapi.DeviceRemoteInterface, spineapi.EntityRemoteInterface, api.EventType) {}
+
+	c.UseCase = setup.UseCase(c.Service, eventCB)
+	useCases := []api.UseCaseInterface{c.UseCase}
+	if setup.Requires != nil {
+		useCases = append(useCases, setup.Requires(c.Service, eventCB)...)
+	}
+	for _, useCase := range useCases {
+		useCase.AddFeatures()
+		useCase.AddUseCase()
+
+		// the use cases are used without a CEM passing them the events
+		if handler, ok := useCase.(spineapi.EventHandlerInterface); ok {
+			if err := util.SubscribeEvents(c.Service, handler); err != nil {
+				return c, err
+			}
+		}
+	}
+
+	c.Remote = newRemote(c.Service)
+	entity := setup.Remote(cemtest.NewDevice(RemoteSki))
+	if err := c.Remote.connect(entity.Device()); err != nil {
+		return c, err
+	}
+	c.Entity = entity.Entity()
+
+	return c, nil
+}
+
+func (c *Context) close() {
+	if c.Remote != nil {
+		c.Remote.close()
+	}
+	util.RemoveServiceEvents(c.Service)
+}
+
+// the subset of testing.T used by Verify
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Logf(format string, args ...any)
+}
+
+// run the requirements in a test, each failed requirement fails the test
+//
+// The report is logged and, if the environment variable CEMD_CONFORMANCE_REPORTS
+// is set, written to that directory.
+func Verify(t TestingT, setup Setup, requirements []Requirement) *Report {
+	t.Helper()
+
+	report := Run(setup, requirements)
+	t.Logf("\n%s", report)
+
+	for _, result := range report.Failed() {
+		t.Errorf("%s %s: %s", result.Id, result.Description, result.Error)
+	}
+
+	if dir := os.Getenv(ReportDirEnv); dir != "" {
+		if err := report.WriteFiles(dir); err != nil {
+			t.Errorf("writing the report: %s", err)
+		}
+	}
+
+	return report
+}
+
+// the service handler of the CEM, the remote device is not connected via SHIP
+type serviceHandler struct{}
+
+func (h serviceHandler) RemoteSKIConnected(service eebusapi.ServiceInterface, ski string) {}
+
+func (h serviceHandler) RemoteSKIDisconnected(service eebusapi.ServiceInterface, ski string) {}
+
+func (h serviceHandler) VisibleRemoteServicesUpdated(service eebusapi.ServiceInterface, entries []shipapi.RemoteService) {
+}
+
+func (h serviceHandler) ServiceShipIDUpdate(ski string, shipdID string) {}
+
+func (h serviceHandler) ServicePairingDetailUpdate(ski string, detail *shipapi.ConnectionStateDetail) {
+}
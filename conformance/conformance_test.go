@@ -0,0 +1,213 @@
+package conformance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cemtest"
+	"github.com/enbility/cemd/ucevsoc"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestConformanceSuite(t *testing.T) {
+	suite.Run(t, new(ConformanceSuite))
+}
+
+type ConformanceSuite struct {
+	suite.Suite
+
+	setup Setup
+}
+
+func (s *ConformanceSuite) BeforeTest(suiteName, testName string) {
+	s.setup = Setup{
+		UseCase: func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) api.UseCaseInterface {
+			return ucevsoc.NewUCEVSOC(service, eventCB)
+		},
+		Remote: func(device *cemtest.DeviceBuilder) *cemtest.EntityBuilder {
+			return cemtest.EVISO15118WithSoC(cemtest.EVSE3Phase(device), 50)
+		},
+	}
+}
+
+func (s *ConformanceSuite) Test_Run() {
+	report := Run(s.setup, []Requirement{
+		UseCaseAnnounced("EVSOC-01", "spec", model.UseCaseActorTypeCEM, 1),
+		RemoteUseCase("EVSOC-02", "spec", model.UseCaseActorTypeEV, 1),
+		ClientFeature("EVSOC-03", "spec", model.FeatureTypeTypeMeasurement),
+		ServerFeature("EVSOC-04", "spec", model.FeatureTypeTypeMeasurement),
+		Subscription("EVSOC-05", "spec", model.FeatureTypeTypeMeasurement),
+		Read("EVSOC-06", "spec", model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData),
+		ResponseTime("EVSOC-07", "spec",
+			Announcement(),
+			ReadResponse(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData), time.Second),
+		ResponseTime("EVSOC-08", "spec",
+			Notification(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData),
+			ReadResponse(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData), time.Second),
+	})
+
+	assert.Equal(s.T(), model.UseCaseNameTypeEVStateOfCharge, report.UseCase)
+	assert.Equal(s.T(), 8, len(report.Results))
+	assert.True(s.T(), report.Passed(), report.String())
+	assert.Equal(s.T(), "EVSOC-01", report.Results[0].Id)
+	assert.Equal(s.T(), RequirementTypeUseCase, report.Results[0].Type)
+	assert.Equal(s.T(), "spec", report.Results[0].Reference)
+}
+
+func (s *ConformanceSuite) Test_Violations() {
+	report := Run(s.setup, []Requirement{
+		UseCaseAnnounced("ERR-01", "spec", model.UseCaseActorTypeCEM, 2),
+		UseCaseAnnounced("ERR-02", "spec", model.UseCaseActorTypeEV, 1),
+		RemoteUseCase("ERR-03", "spec", model.UseCaseActorTypeEVSE, 1),
+		EntityType("ERR-04", "spec", model.EntityTypeTypeEVSE),
+		ClientFeature("ERR-05", "spec", model.FeatureTypeTypeLoadControl),
+		ServerFeature("ERR-06", "spec", model.FeatureTypeTypeLoadControl),
+		Binding("ERR-07", "spec", model.FeatureTypeTypeMeasurement),
+		Subscription("ERR-08", "spec", model.FeatureTypeTypeLoadControl),
+		Read("ERR-09", "spec", model.FeatureTypeTypeLoadControl, model.FunctionTypeLoadControlLimitListData),
+		Read("ERR-10", "spec", model.FeatureTypeTypeTimeSeries, model.FunctionTypeTimeSeriesListData),
+		Write("ERR-11", "spec", model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData,
+			func(c *Context) error { return errors.New("not supported") }),
+		Write("ERR-12", "spec", model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData,
+			func(c *Context) error { return nil }),
+		ResponseTime("ERR-13", "spec",
+			Notification(model.FeatureTypeTypeLoadControl, model.FunctionTypeLoadControlLimitListData),
+			ReadResponse(model.FeatureTypeTypeLoadControl, model.FunctionTypeLoadControlLimitListData), time.Second),
+		{Id: "ERR-14", Description: "no check"},
+		{Id: "ERR-15", Description: "custom check", Check: func(c *Context) error { return errors.New("violated") }},
+		ResponseTime("ERR-16", "spec",
+			Notification(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData),
+			ReadResponse(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData), 100*time.Millisecond),
+	})
+
+	assert.False(s.T(), report.Passed())
+	assert.Equal(s.T(), len(report.Results), len(report.Failed()))
+	for _, result := range report.Failed() {
+		assert.NotEmpty(s.T(), result.Error, result.Id)
+	}
+	assert.Equal(s.T(), "violated", report.Results[14].Error)
+
+	// EVSOC doesn't read the values again when it is notified about them
+	assert.Contains(s.T(), report.Results[15].Error, "did not respond")
+}
+
+func (s *ConformanceSuite) Test_ResponseTime() {
+	// the response is measured from the trigger, not from the start of the check
+	slow := Trigger{
+		Description: "a trigger in the future",
+		Fire: func(c *Context) (time.Time, error) {
+			return time.Now().Add(time.Hour), nil
+		},
+	}
+
+	report := Run(s.setup, []Requirement{
+		ResponseTime("TIME-01", "spec", slow,
+			ReadResponse(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData), 10*time.Millisecond),
+		ResponseTime("TIME-02", "spec", Announcement(), BindingResponse(model.FeatureTypeTypeMeasurement), 10*time.Millisecond),
+	})
+
+	assert.Equal(s.T(), "the CEM reads measurementDescriptionListData within 10ms after a trigger in the future", report.Results[0].Description)
+	assert.Contains(s.T(), report.Results[0].Error, "did not respond")
+	assert.Contains(s.T(), report.Results[1].Error, "did not respond")
+}
+
+func (s *ConformanceSuite) Test_Write() {
+	// EVSOC does not bind to the load control feature, so the write lacks the binding
+	write := func(c *Context) error {
+		c.LocalEntity().GetOrAddFeature(model.FeatureTypeTypeLoadControl, model.RoleTypeClient)
+
+		loadControl, err := features.NewLoadControl(c.LocalEntity(), c.Entity)
+		if err != nil {
+			return err
+		}
+
+		_, err = loadControl.WriteLimitValues([]model.LoadControlLimitDataType{
+			{
+				LimitId:       eebusutil.Ptr(cemtest.EVLimitIdObligation),
+				IsLimitActive: eebusutil.Ptr(true),
+				Value:         model.NewScaledNumberType(cemtest.EVMinCurrent),
+			},
+		})
+		return err
+	}
+
+	report := Run(s.setup, []Requirement{
+		Write("WRITE-01", "spec", model.FeatureTypeTypeLoadControl, model.FunctionTypeLoadControlLimitListData, write),
+	})
+	assert.False(s.T(), report.Passed())
+	assert.Contains(s.T(), report.Results[0].Error, "without a binding")
+}
+
+func (s *ConformanceSuite) Test_Report() {
+	report := &Report{
+		UseCase: model.UseCaseNameTypeEVStateOfCharge,
+		Results: []Result{
+			{Id: "EVSOC-01", Type: RequirementTypeFeature, Description: "passing", Passed: true},
+			{Id: "EVSOC-02", Type: RequirementTypeBinding, Description: "failing", Error: "violated"},
+		},
+	}
+
+	assert.False(s.T(), report.Passed())
+	assert.Equal(s.T(), 1, len(report.Failed()))
+
+	text := report.String()
+	assert.Contains(s.T(), text, "1 of 2 requirements passed")
+	assert.Regexp(s.T(), `EVSOC-01\s+feature\s+PASS\s+passing`, text)
+	assert.Regexp(s.T(), `EVSOC-02\s+binding\s+FAIL\s+failing\n\s+violated`, text)
+
+	dir := filepath.Join(s.T().TempDir(), "reports")
+	assert.Nil(s.T(), report.WriteFiles(dir))
+
+	data, err := os.ReadFile(filepath.Join(dir, string(report.UseCase)+".txt"))
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), text, string(data))
+
+	data, err = os.ReadFile(filepath.Join(dir, string(report.UseCase)+".json"))
+	assert.Nil(s.T(), err)
+	var decoded Report
+	assert.Nil(s.T(), json.Unmarshal(data, &decoded))
+	assert.Equal(s.T(), *report, decoded)
+}
+
+type testingT struct {
+	errors []string
+	logs   []string
+}
+
+func (t *testingT) Helper() {}
+
+func (t *testingT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *testingT) Logf(format string, args ...any) {
+	t.logs = append(t.logs, fmt.Sprintf(format, args...))
+}
+
+func (s *ConformanceSuite) Test_Verify() {
+	dir := s.T().TempDir()
+	s.T().Setenv(ReportDirEnv, dir)
+
+	t := &testingT{}
+	report := Verify(t, s.setup, []Requirement{
+		ClientFeature("EVSOC-01", "spec", model.FeatureTypeTypeMeasurement),
+		ClientFeature("EVSOC-02", "spec", model.FeatureTypeTypeLoadControl),
+	})
+
+	assert.Equal(s.T(), 1, len(report.Failed()))
+	assert.Equal(s.T(), 1, len(t.errors))
+	assert.Contains(s.T(), t.errors[0], "EVSOC-02")
+	assert.Equal(s.T(), 1, len(t.logs))
+	assert.FileExists(s.T(), filepath.Join(dir, string(model.UseCaseNameTypeEVStateOfCharge)+".json"))
+}
@@ -0,0 +1,318 @@
+package conformance
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/enbility/cemd/cemtest"
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+var ErrTimeout = errors.New("timeout")
+
+// a datagram the CEM sent to the remote device and the time it was sent
+type Message struct {
+	Time     time.Time
+	Datagram model.DatagramType
+}
+
+// Remote is a simulated remote device which answers the messages of the CEM
+//
+// The device is a cemtest device without a SHIP connection. All messages the
+// CEM sends are recorded. Reads are answered with the data of the remote
+// feature, calls and writes requesting an acknowledgement with a result
+// without error. Writes also update the data of the remote feature. The
+// answers are processed in order on a separate goroutine, like the messages
+// of a real connection.
+type Remote struct {
+	service eebusapi.ServiceInterface
+	device  *cemtest.Device
+
+	messages []Message
+	queue    []model.DatagramType
+
+	// the time the entities were announced to the CEM
+	announced time.Time
+
+	// the number of messages queued or being answered
+	pending int
+
+	msgCounter model.MsgCounterType
+
+	closed bool
+	cond   *sync.Cond
+	mux    sync.Mutex
+}
+
+func newRemote(service eebusapi.ServiceInterface) *Remote {
+	r := &Remote{
+		service:    service,
+		msgCounter: 1000,
+	}
+	r.cond = sync.NewCond(&r.mux)
+
+	go r.run()
+
+	return r
+}
+
+// build the device and publish its entities, then wait until the CEM and the
+// remote device are done with the initial requests
+func (r *Remote) connect(builder *cemtest.DeviceBuilder) error {
+	r.device = builder.WithWriter(r).Build(r.service)
+
+	r.mux.Lock()
+	r.announced = time.Now()
+	r.mux.Unlock()
+
+	r.device.PublishEntities()
+
+	return r.Settle(DefaultTimeout)
+}
+
+// stop answering messages
+func (r *Remote) close() {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.closed = true
+	r.cond.Broadcast()
+}
+
+// returns the remote device
+func (r *Remote) Device() spineapi.DeviceRemoteInterface {
+	return r.device.Device
+}
+
+// returns the time the entities of the remote device were announced to the CEM
+func (r *Remote) Announced() time.Time {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.announced
+}
+
+// returns the messages the CEM sent to the remote device in order
+func (r *Remote) Messages() []Message {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return slices.Clone(r.messages)
+}
+
+// wait until all messages of the CEM are answered and no answer caused a new message
+//
+// possible errors:
+//   - ErrTimeout if the messages are not answered within the timeout
+func (r *Remote) Settle(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		r.mux.Lock()
+		pending := r.pending
+		r.mux.Unlock()
+
+		if pending == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return ErrTimeout
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// send a message from a remote feature to a local feature and return the message counter
+//
+// The message is processed synchronously, like a message received via SHIP.
+func (r *Remote) Send(
+	classifier model.CmdClassifierType,
+	source, destination *model.FeatureAddressType,
+	ackRequest bool,
+	cmd model.CmdType) (model.MsgCounterType, error) {
+	r.mux.Lock()
+	r.msgCounter++
+	msgCounter := r.msgCounter
+	r.mux.Unlock()
+
+	header := model.HeaderType{
+		SpecificationVersion: eebusutil.Ptr(model.SpecificationVersionType("1.3.0")),
+		AddressSource:        source,
+		AddressDestination:   destination,
+		MsgCounter:           eebusutil.Ptr(msgCounter),
+		CmdClassifier:        eebusutil.Ptr(classifier),
+	}
+	if ackRequest {
+		header.AckRequest = eebusutil.Ptr(true)
+	}
+
+	return msgCounter, r.process(model.DatagramType{
+		Header:  header,
+		Payload: model.PayloadType{Cmd: []model.CmdType{cmd}},
+	})
+}
+
+// wait for the message of the CEM referencing a message counter of the remote device
+//
+// possible errors:
+//   - ErrTimeout if no message references the counter within the timeout
+func (r *Remote) WaitForReference(msgCounter model.MsgCounterType, timeout time.Duration) (Message, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		for _, message := range r.Messages() {
+			reference := message.Datagram.Header.MsgCounterReference
+			if reference != nil && *reference == msgCounter {
+				return message, nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return Message{}, ErrTimeout
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// wait for the first message of the CEM sent at or after a time and matching a condition
+//
+// possible errors:
+//   - ErrTimeout if no message matches within the timeout
+func (r *Remote) WaitForMessage(since time.Time, timeout time.Duration, match func(message Message) bool) (Message, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		for _, message := range r.Messages() {
+			if !message.Time.Before(since) && match(message) {
+				return message, nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return Message{}, ErrTimeout
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// process a message of the remote device in the local device
+func (r *Remote) process(datagram model.DatagramType) error {
+	return r.service.LocalDevice().ProcessCmd(datagram, r.device.Device)
+}
+
+// shipapi.ShipConnectionDataWriterInterface
+
+// record a message of the CEM and queue it to be answered
+func (r *Remote) WriteShipMessageWithPayload(message []byte) {
+	datagram := model.Datagram{}
+	if err := json.Unmarshal(message, &datagram); err != nil {
+		return
+	}
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.messages = append(r.messages, Message{Time: time.Now(), Datagram: datagram.Datagram})
+	r.queue = append(r.queue, datagram.Datagram)
+	r.pending++
+	r.cond.Signal()
+}
+
+// answer the queued messages in order
+func (r *Remote) run() {
+	for {
+		r.mux.Lock()
+		for len(r.queue) == 0 && !r.closed {
+			r.cond.Wait()
+		}
+		if r.closed {
+			r.mux.Unlock()
+			return
+		}
+		datagram := r.queue[0]
+		r.queue = r.queue[1:]
+		r.mux.Unlock()
+
+		r.answer(datagram)
+
+		r.mux.Lock()
+		r.pending--
+		r.mux.Unlock()
+	}
+}
+
+// answer a message of the CEM like a remote device
+func (r *Remote) answer(datagram model.DatagramType) {
+	header := datagram.Header
+	if header.CmdClassifier == nil || header.AddressSource == nil || header.AddressDestination == nil ||
+		header.MsgCounter == nil || len(datagram.Payload.Cmd) == 0 {
+		return
+	}
+
+	feature := r.device.Device.FeatureByAddress(header.AddressDestination)
+	if feature == nil {
+		return
+	}
+
+	cmd := datagram.Payload.Cmd[0]
+	data, err := cmd.Data()
+
+	switch *header.CmdClassifier {
+	case model.CmdClassifierTypeRead:
+		if err != nil || data.Function == nil {
+			return
+		}
+
+		reply := model.CmdType{}
+		reply.SetDataForFunction(*data.Function, feature.DataCopy(*data.Function))
+		r.reply(header, model.CmdClassifierTypeReply, reply)
+		return
+
+	case model.CmdClassifierTypeWrite:
+		if err == nil && data.Function != nil {
+			filterPartial, filterDelete := cmd.ExtractFilter()
+			_ = feature.UpdateData(*data.Function, data.Value, filterPartial, filterDelete)
+		}
+
+	case model.CmdClassifierTypeCall:
+	default:
+		return
+	}
+
+	if header.AckRequest != nil && *header.AckRequest {
+		r.reply(header, model.CmdClassifierTypeResult, model.CmdType{
+			ResultData: &model.ResultDataType{
+				ErrorNumber: eebusutil.Ptr(model.ErrorNumberTypeNoError),
+			},
+		})
+	}
+}
+
+// send the answer to a message of the CEM
+func (r *Remote) reply(request model.HeaderType, classifier model.CmdClassifierType, cmd model.CmdType) {
+	r.mux.Lock()
+	r.msgCounter++
+	msgCounter := r.msgCounter
+	r.mux.Unlock()
+
+	_ = r.process(model.DatagramType{
+		Header: model.HeaderType{
+			SpecificationVersion: request.SpecificationVersion,
+			AddressSource:        request.AddressDestination,
+			AddressDestination:   request.AddressSource,
+			MsgCounter:           eebusutil.Ptr(msgCounter),
+			MsgCounterReference:  request.MsgCounter,
+			CmdClassifier:        eebusutil.Ptr(classifier),
+		},
+		Payload: model.PayloadType{Cmd: []model.CmdType{cmd}},
+	})
+}
@@ -0,0 +1,96 @@
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/enbility/spine-go/model"
+)
+
+// the result of checking a requirement
+type Result struct {
+	Id          string          `json:"id"`
+	Type        RequirementType `json:"type"`
+	Description string          `json:"description"`
+	Reference   string          `json:"reference,omitempty"`
+
+	Passed bool `json:"passed"`
+
+	// the violation of the requirement, empty if it passed
+	Error string `json:"error,omitempty"`
+
+	// the time it took to check the requirement
+	Duration time.Duration `json:"duration"`
+}
+
+// Report contains the results of the requirements of a use case
+type Report struct {
+	UseCase model.UseCaseNameType `json:"usecase"`
+	Results []Result              `json:"results"`
+}
+
+// returns if all requirements passed
+func (r *Report) Passed() bool {
+	return len(r.Failed()) == 0
+}
+
+// returns the results of the failed requirements
+func (r *Report) Failed() []Result {
+	var result []Result
+	for _, item := range r.Results {
+		if !item.Passed {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// returns the report as a table with a line per requirement
+func (r *Report) String() string {
+	var builder strings.Builder
+
+	passed := len(r.Results) - len(r.Failed())
+	fmt.Fprintf(&builder, "Conformance report %s: %d of %d requirements passed\n\n", r.UseCase, passed, len(r.Results))
+
+	writer := tabwriter.NewWriter(&builder, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tTYPE\tRESULT\tREQUIREMENT")
+	for _, item := range r.Results {
+		status := "PASS"
+		if !item.Passed {
+			status = "FAIL"
+		}
+
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", item.Id, item.Type, status, item.Description)
+		if item.Error != "" {
+			fmt.Fprintf(writer, "\t\t\t  %s\n", item.Error)
+		}
+	}
+	_ = writer.Flush()
+
+	return builder.String()
+}
+
+// write the report as <usecase>.txt and <usecase>.json to a directory
+func (r *Report) WriteFiles(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	name := filepath.Join(dir, string(r.UseCase))
+	if err := os.WriteFile(name+".txt", []byte(r.String()), 0644); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(name+".json", data, 0644)
+}
@@ -0,0 +1,362 @@
+package conformance
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/enbility/cemd/cemtest"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// the CEM announces the use case with an actor and at least the given scenarios
+//
+// The simulated remote device reads the use case data of the CEM node management.
+func UseCaseAnnounced(id, reference string, actor model.UseCaseActorType, scenarios ...model.UseCaseScenarioSupportType) Requirement {
+	return Requirement{
+		Id:          id,
+		Type:        RequirementTypeUseCase,
+		Description: fmt.Sprintf("the CEM announces the use case as %s with the scenarios %v", actor, scenarios),
+		Reference:   reference,
+		Check: func(c *Context) error {
+			localNodeManagement := c.Service.LocalDevice().NodeManagement()
+			remoteNodeManagement := c.Remote.Device().FeatureByEntityTypeAndRole(
+				c.Remote.Device().Entity([]model.AddressEntityType{0}),
+				model.FeatureTypeTypeNodeManagement,
+				model.RoleTypeSpecial)
+
+			msgCounter, err := c.Remote.Send(model.CmdClassifierTypeRead,
+				remoteNodeManagement.Address(), localNodeManagement.Address(), false,
+				model.CmdType{NodeManagementUseCaseData: &model.NodeManagementUseCaseDataType{}})
+			if err != nil {
+				return err
+			}
+
+			reply, err := c.Remote.WaitForReference(msgCounter, DefaultTimeout)
+			if err != nil {
+				return fmt.Errorf("no reply to the use case data read: %w", err)
+			}
+
+			data := reply.Datagram.Payload.Cmd[0].NodeManagementUseCaseData
+			if data == nil {
+				return errors.New("the reply contains no use case data")
+			}
+
+			for _, information := range data.UseCaseInformation {
+				if information.Actor == nil || *information.Actor != actor {
+					continue
+				}
+
+				for _, support := range information.UseCaseSupport {
+					if support.UseCaseName == nil || *support.UseCaseName != c.UseCase.UseCaseName() {
+						continue
+					}
+
+					for _, scenario := range scenarios {
+						if !slices.Contains(support.ScenarioSupport, scenario) {
+							return fmt.Errorf("scenario %d is not announced", scenario)
+						}
+					}
+
+					return nil
+				}
+			}
+
+			return fmt.Errorf("the use case is not announced with the actor %s", actor)
+		},
+	}
+}
+
+// the use case supports remote entities of a type
+func EntityType(id, reference string, entityType model.EntityTypeType) Requirement {
+	return Requirement{
+		Id:          id,
+		Type:        RequirementTypeUseCase,
+		Description: fmt.Sprintf("the CEM supports the use case with %s entities", entityType),
+		Reference:   reference,
+		Check: func(c *Context) error {
+			if !slices.Contains(c.UseCase.RemoteRequirements().EntityTypes, entityType) {
+				return fmt.Errorf("the entity type %s is not compatible", entityType)
+			}
+
+			if c.Entity.EntityType() == entityType && !slices.Contains(c.UseCase.CompatibleEntities(), c.Entity) {
+				return errors.New("the connected remote entity is not known to the use case")
+			}
+
+			return nil
+		},
+	}
+}
+
+// the use case is only supported if the remote device announces it with an actor and the mandatory scenarios
+func RemoteUseCase(id, reference string, actor model.UseCaseActorType, scenarios ...model.UseCaseScenarioSupportType) Requirement {
+	return Requirement{
+		Id:          id,
+		Type:        RequirementTypeUseCase,
+		Description: fmt.Sprintf("the remote device has to announce the use case as %s with the scenarios %v", actor, scenarios),
+		Reference:   reference,
+		Check: func(c *Context) error {
+			requirements := c.UseCase.RemoteRequirements()
+			if !slices.Contains(requirements.Actors, actor) {
+				return fmt.Errorf("the actor %s is not accepted", actor)
+			}
+
+			if supported, err := c.UseCase.IsUseCaseSupported(c.Entity); err != nil || !supported {
+				return fmt.Errorf("the use case is not supported by the remote entity: %v", err)
+			}
+
+			// a remote device lacking any of the mandatory scenarios does not support the use case
+			for index, scenario := range scenarios {
+				entity := c.buildRemote(fmt.Sprintf("scenario%d", index), func(entity *cemtest.EntityBuilder) {
+					entity.WithoutUseCase(c.UseCase.UseCaseName())
+					entity.WithUseCase(actor, c.UseCase.UseCaseName(), slices.DeleteFunc(slices.Clone(scenarios),
+						func(item model.UseCaseScenarioSupportType) bool {
+							return item == scenario
+						})...)
+				})
+
+				if supported, _ := c.UseCase.IsUseCaseSupported(entity); supported {
+					return fmt.Errorf("the use case is supported without scenario %d", scenario)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// the CEM has a client feature of a type
+func ClientFeature(id, reference string, featureType model.FeatureTypeType) Requirement {
+	return Requirement{
+		Id:          id,
+		Type:        RequirementTypeFeature,
+		Description: fmt.Sprintf("the CEM has the %s client feature", featureType),
+		Reference:   reference,
+		Check: func(c *Context) error {
+			if c.LocalEntity().FeatureOfTypeAndRole(featureType, model.RoleTypeClient) == nil {
+				return fmt.Errorf("the CEM entity has no %s client feature", featureType)
+			}
+
+			if !slices.Contains(c.UseCase.ClientFeatures(), featureType) {
+				return fmt.Errorf("the use case does not list the %s client feature", featureType)
+			}
+
+			return nil
+		},
+	}
+}
+
+// the use case is only supported if the remote entity has a server feature of a type
+func ServerFeature(id, reference string, featureType model.FeatureTypeType) Requirement {
+	return Requirement{
+		Id:          id,
+		Type:        RequirementTypeFeature,
+		Description: fmt.Sprintf("the remote entity has to provide the %s server feature", featureType),
+		Reference:   reference,
+		Check: func(c *Context) error {
+			if !slices.Contains(c.UseCase.RemoteRequirements().ServerFeatures, featureType) {
+				return fmt.Errorf("the %s server feature is not required", featureType)
+			}
+
+			if supported, err := c.UseCase.IsUseCaseSupported(c.Entity); err != nil || !supported {
+				return fmt.Errorf("the use case is not supported by the remote entity: %v", err)
+			}
+
+			entity := c.buildRemote("without", func(entity *cemtest.EntityBuilder) {
+				entity.WithoutFeature(featureType, model.RoleTypeServer)
+			})
+			if supported, _ := c.UseCase.IsUseCaseSupported(entity); supported {
+				return fmt.Errorf("the use case is supported without the %s server feature", featureType)
+			}
+
+			return nil
+		},
+	}
+}
+
+// the CEM reads a function of a remote server feature when the entity connects
+func Read(id, reference string, featureType model.FeatureTypeType, function model.FunctionType) Requirement {
+	return Requirement{
+		Id:          id,
+		Type:        RequirementTypeFunction,
+		Description: fmt.Sprintf("the CEM reads %s", function),
+		Reference:   reference,
+		Check: func(c *Context) error {
+			feature, err := c.RemoteFeature(featureType)
+			if err != nil {
+				return err
+			}
+
+			if index := findCmd(c.Remote.Messages(), model.CmdClassifierTypeRead, feature.Address(), function); index < 0 {
+				return fmt.Errorf("%s is not read", function)
+			}
+
+			return nil
+		},
+	}
+}
+
+// the CEM subscribes to a remote server feature when the entity connects
+func Subscription(id, reference string, featureType model.FeatureTypeType) Requirement {
+	return Requirement{
+		Id:          id,
+		Type:        RequirementTypeSubscription,
+		Description: fmt.Sprintf("the CEM subscribes to the %s server feature", featureType),
+		Reference:   reference,
+		Check: func(c *Context) error {
+			feature, err := c.RemoteFeature(featureType)
+			if err != nil {
+				return err
+			}
+
+			if findRequest(c.Remote.Messages(), c.localClientFeature(featureType), feature, false) < 0 {
+				return fmt.Errorf("no subscription request for the %s server feature", featureType)
+			}
+
+			return nil
+		},
+	}
+}
+
+// the CEM binds to a remote server feature when the entity connects
+func Binding(id, reference string, featureType model.FeatureTypeType) Requirement {
+	return Requirement{
+		Id:          id,
+		Type:        RequirementTypeBinding,
+		Description: fmt.Sprintf("the CEM binds to the %s server feature", featureType),
+		Reference:   reference,
+		Check: func(c *Context) error {
+			feature, err := c.RemoteFeature(featureType)
+			if err != nil {
+				return err
+			}
+
+			if findRequest(c.Remote.Messages(), c.localClientFeature(featureType), feature, true) < 0 {
+				return fmt.Errorf("no binding request for the %s server feature", featureType)
+			}
+
+			return nil
+		},
+	}
+}
+
+// the CEM writes a function of a remote server feature only after it requested a binding
+//
+// The write function triggers the write using the API of the use case.
+func Write(id, reference string, featureType model.FeatureTypeType, function model.FunctionType, write func(c *Context) error) Requirement {
+	return Requirement{
+		Id:          id,
+		Type:        RequirementTypeFunction,
+		Description: fmt.Sprintf("the CEM writes %s with a binding", function),
+		Reference:   reference,
+		Check: func(c *Context) error {
+			feature, err := c.RemoteFeature(featureType)
+			if err != nil {
+				return err
+			}
+
+			if err := write(c); err != nil {
+				return fmt.Errorf("writing failed: %w", err)
+			}
+			if err := c.Remote.Settle(DefaultTimeout); err != nil {
+				return err
+			}
+
+			messages := c.Remote.Messages()
+			writeIndex := findCmd(messages, model.CmdClassifierTypeWrite, feature.Address(), function)
+			if writeIndex < 0 {
+				return fmt.Errorf("%s is not written", function)
+			}
+
+			bindingIndex := findRequest(messages, c.localClientFeature(featureType), feature, true)
+			if bindingIndex < 0 || bindingIndex > writeIndex {
+				return fmt.Errorf("%s is written without a binding", function)
+			}
+
+			return nil
+		},
+	}
+}
+
+// returns the client feature of the CEM entity, nil if there is none
+func (c *Context) localClientFeature(featureType model.FeatureTypeType) spineapi.FeatureLocalInterface {
+	return c.LocalEntity().FeatureOfTypeAndRole(featureType, model.RoleTypeClient)
+}
+
+// build another remote device like the one of the setup with a modification, returns the entity
+func (c *Context) buildRemote(suffix string, modify func(entity *cemtest.EntityBuilder)) spineapi.EntityRemoteInterface {
+	entity := c.Setup.Remote(cemtest.NewDevice(RemoteSki + "-" + suffix))
+	modify(entity)
+	entity.Device().Build(c.Service)
+
+	return entity.Entity()
+}
+
+// returns the index of the first message with a classifier and function sent to a feature, -1 if there is none
+func findCmd(messages []Message, classifier model.CmdClassifierType, destination *model.FeatureAddressType, function model.FunctionType) int {
+	for index, message := range messages {
+		header := message.Datagram.Header
+		if header.CmdClassifier == nil || *header.CmdClassifier != classifier ||
+			!sameAddress(header.AddressDestination, destination) {
+			continue
+		}
+
+		for _, cmd := range message.Datagram.Payload.Cmd {
+			if data, err := cmd.Data(); err == nil && data.Function != nil && *data.Function == function {
+				return index
+			}
+		}
+	}
+
+	return -1
+}
+
+// returns the index of the first subscription or binding request of a local client feature
+// for a remote server feature, -1 if there is none
+func findRequest(messages []Message, client spineapi.FeatureLocalInterface, server spineapi.FeatureRemoteInterface, binding bool) int {
+	if client == nil {
+		return -1
+	}
+
+	for index, message := range messages {
+		header := message.Datagram.Header
+		if header.CmdClassifier == nil || *header.CmdClassifier != model.CmdClassifierTypeCall {
+			continue
+		}
+
+		for _, cmd := range message.Datagram.Payload.Cmd {
+			var clientAddress, serverAddress *model.FeatureAddressType
+			switch {
+			case binding && cmd.NodeManagementBindingRequestCall != nil && cmd.NodeManagementBindingRequestCall.BindingRequest != nil:
+				clientAddress = cmd.NodeManagementBindingRequestCall.BindingRequest.ClientAddress
+				serverAddress = cmd.NodeManagementBindingRequestCall.BindingRequest.ServerAddress
+			case !binding && cmd.NodeManagementSubscriptionRequestCall != nil && cmd.NodeManagementSubscriptionRequestCall.SubscriptionRequest != nil:
+				clientAddress = cmd.NodeManagementSubscriptionRequestCall.SubscriptionRequest.ClientAddress
+				serverAddress = cmd.NodeManagementSubscriptionRequestCall.SubscriptionRequest.ServerAddress
+			default:
+				continue
+			}
+
+			if sameAddress(clientAddress, client.Address()) && sameAddress(serverAddress, server.Address()) {
+				return index
+			}
+		}
+	}
+
+	return -1
+}
+
+// returns if two feature addresses are the same, the device is only compared if both are set
+func sameAddress(a, b *model.FeatureAddressType) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	if a.Device != nil && b.Device != nil && *a.Device != *b.Device {
+		return false
+	}
+
+	return reflect.DeepEqual(a.Entity, b.Entity) && reflect.DeepEqual(a.Feature, b.Feature)
+}
@@ -0,0 +1,128 @@
+package conformance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/enbility/spine-go/model"
+)
+
+// an action of the simulated remote device the CEM has to respond to
+type Trigger struct {
+	// what happens, e.g. "the announcement of the remote entity"
+	Description string
+
+	// trigger the action, returns the time it was triggered
+	Fire func(c *Context) (time.Time, error)
+}
+
+// the remote device announces its entities
+//
+// The remote device is connected and announces its entities when the context
+// is created, so this returns the time of that announcement.
+func Announcement() Trigger {
+	return Trigger{
+		Description: "the announcement of the remote entity",
+		Fire: func(c *Context) (time.Time, error) {
+			return c.Remote.Announced(), nil
+		},
+	}
+}
+
+// a remote server feature notifies the CEM about the current data of a function
+func Notification(featureType model.FeatureTypeType, function model.FunctionType) Trigger {
+	return Trigger{
+		Description: fmt.Sprintf("a notification of %s", function),
+		Fire: func(c *Context) (time.Time, error) {
+			feature, err := c.RemoteFeature(featureType)
+			if err != nil {
+				return time.Time{}, err
+			}
+
+			localFeature := c.localClientFeature(featureType)
+			if localFeature == nil {
+				return time.Time{}, fmt.Errorf("the CEM entity has no %s client feature", featureType)
+			}
+
+			cmd := model.CmdType{}
+			cmd.SetDataForFunction(function, feature.DataCopy(function))
+
+			start := time.Now()
+			_, err = c.Remote.Send(model.CmdClassifierTypeNotify, feature.Address(), localFeature.Address(), false, cmd)
+
+			return start, err
+		},
+	}
+}
+
+// a message the CEM sends in response to a trigger
+type Response struct {
+	// what the CEM does, e.g. "reads measurementListData"
+	Description string
+
+	// returns if a message of the CEM is the response
+	Match func(c *Context, message Message) bool
+}
+
+// the CEM reads a function of a remote server feature
+func ReadResponse(featureType model.FeatureTypeType, function model.FunctionType) Response {
+	return Response{
+		Description: fmt.Sprintf("reads %s", function),
+		Match: func(c *Context, message Message) bool {
+			feature, err := c.RemoteFeature(featureType)
+			if err != nil {
+				return false
+			}
+
+			return findCmd([]Message{message}, model.CmdClassifierTypeRead, feature.Address(), function) == 0
+		},
+	}
+}
+
+// the CEM requests a binding to a remote server feature
+func BindingResponse(featureType model.FeatureTypeType) Response {
+	return Response{
+		Description: fmt.Sprintf("binds to the %s server feature", featureType),
+		Match: func(c *Context, message Message) bool {
+			feature, err := c.RemoteFeature(featureType)
+			if err != nil {
+				return false
+			}
+
+			return findRequest([]Message{message}, c.localClientFeature(featureType), feature, true) == 0
+		},
+	}
+}
+
+// the use case responds to an action of the remote device with a message within a delay
+//
+// This measures the use case code, from the trigger to the first matching
+// message the CEM sent afterwards, e.g. from a notification of descriptions to
+// the read of the values they describe.
+func ResponseTime(id, reference string, trigger Trigger, response Response, maxDelay time.Duration) Requirement {
+	return Requirement{
+		Id:          id,
+		Type:        RequirementTypeTiming,
+		Description: fmt.Sprintf("the CEM %s within %s after %s", response.Description, maxDelay, trigger.Description),
+		Reference:   reference,
+		Check: func(c *Context) error {
+			start, err := trigger.Fire(c)
+			if err != nil {
+				return err
+			}
+
+			message, err := c.Remote.WaitForMessage(start, maxDelay, func(message Message) bool {
+				return response.Match(c, message)
+			})
+			if err != nil {
+				return fmt.Errorf("the CEM did not respond within %s", maxDelay)
+			}
+
+			if delay := message.Time.Sub(start); delay > maxDelay {
+				return fmt.Errorf("the response took %s", delay)
+			}
+
+			return nil
+		},
+	}
+}
@@ -7,6 +7,7 @@ require (
 	github.com/enbility/ship-go v0.0.0-20240228111631-eaf1f283f9b9
 	github.com/enbility/spine-go v0.0.0-20240228085027-5102eacf33f3
 	github.com/stretchr/testify v1.8.4
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -28,5 +29,4 @@ require (
 	golang.org/x/net v0.21.0 // indirect
 	golang.org/x/sys v0.17.0 // indirect
 	golang.org/x/tools v0.17.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,41 @@
+package history
+
+import "time"
+
+//go:generate mockery
+
+// Implemented by History
+type HistoryInterface interface {
+	// return the keys of all recorded series
+	Series() []SeriesKey
+
+	// add a sample to a series, the series is created if it does not exist
+	//
+	// possible errors:
+	//   - ErrClosed if the history was closed
+	//   - and others if the sample could not be persisted
+	Record(key SeriesKey, sample Sample) error
+
+	// return all samples of a series within [from, to), sorted by time
+	//
+	// samples older than the retention are not returned
+	//
+	// possible errors:
+	//   - ErrSeriesNotFound if the series is not known
+	Query(key SeriesKey, from, to time.Time) ([]Sample, error)
+
+	// return the min, max and average values of a series per interval within [from, to)
+	//
+	// the intervals start at from, intervals without samples are omitted
+	//
+	// possible errors:
+	//   - ErrSeriesNotFound if the series is not known
+	//   - ErrInvalidInterval if the interval is not positive
+	QueryAggregated(key SeriesKey, from, to time.Time, interval time.Duration) ([]AggregatedSample, error)
+
+	// remove all series which have no samples within the retention
+	Prune() error
+
+	// stop recording and close all files
+	Close() error
+}
@@ -0,0 +1,74 @@
+package history
+
+import (
+	"time"
+
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// handle SPINE events
+func (h *History) HandleEvent(payload spineapi.EventPayload) {
+	if payload.Entity == nil ||
+		payload.EventType != spineapi.EventTypeDataChange ||
+		payload.ChangeType != spineapi.ElementChangeUpdate {
+		return
+	}
+
+	data, ok := payload.Data.(*model.MeasurementListDataType)
+	if !ok || data == nil {
+		return
+	}
+
+	h.measurementDataUpdate(payload.Ski, payload.Entity, data.MeasurementData)
+}
+
+// record the received measurement values
+func (h *History) measurementDataUpdate(ski string, entity spineapi.EntityRemoteInterface, data []model.MeasurementDataType) {
+	measurement, err := util.Measurement(h.service, entity)
+	if err != nil {
+		return
+	}
+
+	// the electrical connection is optional, it only provides the phase details
+	electricalConnection, _ := util.ElectricalConnection(h.service, entity)
+
+	now := time.Now()
+
+	for _, item := range data {
+		if item.MeasurementId == nil || item.Value == nil ||
+			(item.ValueState != nil && *item.ValueState == model.MeasurementValueStateTypeError) {
+			continue
+		}
+
+		desc, err := measurement.GetDescriptionForMeasurementId(*item.MeasurementId)
+		if err != nil || desc.MeasurementType == nil || desc.ScopeType == nil {
+			continue
+		}
+
+		var phase model.ElectricalConnectionPhaseNameType
+		if electricalConnection != nil {
+			if param, err := electricalConnection.GetParameterDescriptionForMeasurementId(*item.MeasurementId); err == nil &&
+				param.AcMeasuredPhases != nil {
+				phase = *param.AcMeasuredPhases
+			}
+		}
+
+		sample := Sample{
+			Time:  now,
+			Value: item.Value.GetValue(),
+		}
+		if item.Timestamp != nil {
+			if timestamp, err := item.Timestamp.GetTime(); err == nil {
+				sample.Time = timestamp
+			}
+		}
+
+		key := NewSeriesKey(ski, entity, QuantityName(*desc.MeasurementType, *desc.ScopeType, phase))
+		if err := h.Record(key, sample); err != nil {
+			logging.Log().Error("history: error recording sample:", err)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package history
+
+import (
+	"time"
+
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *HistorySuite) Test_Events() {
+	payload := spineapi.EventPayload{
+		Ski:    remoteSki,
+		Entity: s.meterEntity,
+	}
+	s.sut.HandleEvent(payload)
+
+	payload.EventType = spineapi.EventTypeDataChange
+	payload.ChangeType = spineapi.ElementChangeUpdate
+	payload.Data = eebusutil.Ptr(model.MeasurementDescriptionListDataType{})
+	s.sut.HandleEvent(payload)
+
+	payload.Data = eebusutil.Ptr(model.MeasurementListDataType{})
+	s.sut.HandleEvent(payload)
+
+	assert.Equal(s.T(), 0, len(s.sut.Series()))
+}
+
+func (s *HistorySuite) Test_measurementDataUpdate() {
+	timestamp := time.Now().Add(-time.Minute).Truncate(time.Second).UTC()
+	data := []model.MeasurementDataType{
+		{
+			MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+			Value:         model.NewScaledNumberType(1000),
+			Timestamp:     model.NewAbsoluteOrRelativeTimeTypeFromTime(timestamp),
+		},
+		{
+			MeasurementId: eebusutil.Ptr(model.MeasurementIdType(1)),
+			Value:         model.NewScaledNumberType(5),
+		},
+		{
+			MeasurementId: eebusutil.Ptr(model.MeasurementIdType(2)),
+			Value:         model.NewScaledNumberType(6),
+			ValueState:    eebusutil.Ptr(model.MeasurementValueStateTypeError),
+		},
+	}
+
+	// no descriptions yet
+	s.sut.measurementDataUpdate(remoteSki, s.meterEntity, data)
+	assert.Equal(s.T(), 0, len(s.sut.Series()))
+
+	descData := &model.MeasurementDescriptionListDataType{
+		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(0)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypePower),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACPowerTotal),
+			},
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(1)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypeCurrent),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACCurrent),
+			},
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(2)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypeCurrent),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACCurrent),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.meterEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	fErr := rFeature.UpdateData(model.FunctionTypeMeasurementDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	paramData := &model.ElectricalConnectionParameterDescriptionListDataType{
+		ElectricalConnectionParameterDescriptionData: []model.ElectricalConnectionParameterDescriptionDataType{
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				ParameterId:            eebusutil.Ptr(model.ElectricalConnectionParameterIdType(1)),
+				MeasurementId:          eebusutil.Ptr(model.MeasurementIdType(1)),
+				AcMeasuredPhases:       eebusutil.Ptr(model.ElectricalConnectionPhaseNameTypeA),
+			},
+		},
+	}
+
+	rElFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.meterEntity, model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, paramData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	payload := spineapi.EventPayload{
+		Ski:        remoteSki,
+		Entity:     s.meterEntity,
+		EventType:  spineapi.EventTypeDataChange,
+		ChangeType: spineapi.ElementChangeUpdate,
+		Data:       &model.MeasurementListDataType{MeasurementData: data},
+	}
+	s.sut.HandleEvent(payload)
+
+	series := s.sut.Series()
+	assert.Equal(s.T(), []SeriesKey{
+		{SKI: remoteSki, Entity: "1.1", Quantity: "current/acCurrent/a"},
+		{SKI: remoteSki, Entity: "1.1", Quantity: "power/acPowerTotal"},
+	}, series)
+
+	powerKey := NewSeriesKey(remoteSki, s.meterEntity, QuantityName(model.MeasurementTypeTypePower, model.ScopeTypeTypeACPowerTotal, ""))
+	samples, err := s.sut.Query(powerKey, time.Now().Add(-time.Hour), time.Now())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(samples))
+	assert.Equal(s.T(), 1000.0, samples[0].Value)
+	assert.True(s.T(), timestamp.Equal(samples[0].Time))
+
+	currentKey := NewSeriesKey(remoteSki, s.meterEntity, "current/acCurrent/a")
+	samples, err = s.sut.Query(currentKey, time.Now().Add(-time.Hour), time.Now().Add(time.Second))
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(samples))
+	assert.Equal(s.T(), 5.0, samples[0].Value)
+}
@@ -0,0 +1,360 @@
+package history
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// the file extension of series files
+const fileExtension = ".ts"
+
+// the minimum time between two automatic prunes while recording
+const pruneInterval = time.Minute
+
+// Time series history of all measurement values reported by remote devices
+//
+// The history subscribes to the SPINE events and records every received
+// measurement value, independent of the use cases in use:
+//
+//	h, err := history.NewHistory(cem.Service, history.Options{Directory: "history"})
+//	defer h.Close()
+//
+//	key := history.NewSeriesKey(ski, entity, history.QuantityName(model.MeasurementTypeTypePower, model.ScopeTypeTypeACPowerTotal, ""))
+//	values, err := h.QueryAggregated(key, time.Now().Add(-24*time.Hour), time.Now(), 5*time.Minute)
+type History struct {
+	service eebusapi.ServiceInterface
+
+	options Options
+
+	series map[SeriesKey]*ringBuffer
+
+	lastPrune time.Time
+	closed    bool
+
+	mux sync.Mutex
+}
+
+var _ HistoryInterface = (*History)(nil)
+
+// create a new history and load all series stored in the options directory
+//
+// parameters:
+//   - service: the EEBUS service whose measurements should be recorded, can be nil if samples are only added with Record
+//   - options: the storage settings, a Capacity of 0 uses DefaultCapacity
+func NewHistory(service eebusapi.ServiceInterface, options Options) (*History, error) {
+	if options.Capacity <= 0 {
+		options.Capacity = DefaultCapacity
+	}
+
+	history := &History{
+		service: service,
+		options: options,
+		series:  make(map[SeriesKey]*ringBuffer),
+	}
+
+	if options.Directory != "" {
+		if err := history.load(); err != nil {
+			return nil, err
+		}
+	}
+
+	if service != nil {
+		_ = util.SubscribeEvents(service, history)
+	}
+
+	return history, nil
+}
+
+// load all series files from the directory
+func (h *History) load() error {
+	if err := os.MkdirAll(h.options.Directory, 0700); err != nil {
+		return err
+	}
+
+	paths, err := filepath.Glob(filepath.Join(h.options.Directory, "*"+fileExtension))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		buffer, err := openFileRingBuffer(path)
+		if err != nil {
+			logging.Log().Errorf("history: skipping %s: %s", path, err)
+			continue
+		}
+
+		if buffer.capacity() != h.options.Capacity {
+			if buffer, err = h.resize(buffer, path); err != nil {
+				_ = h.closeAll()
+				return err
+			}
+		}
+
+		h.series[buffer.key] = buffer
+	}
+
+	return nil
+}
+
+// recreate a buffer with the configured capacity, keeping the newest samples
+func (h *History) resize(buffer *ringBuffer, path string) (*ringBuffer, error) {
+	samples := buffer.all()
+	_ = buffer.close()
+
+	if len(samples) > h.options.Capacity {
+		samples = samples[len(samples)-h.options.Capacity:]
+	}
+
+	resized, err := createFileRingBuffer(path, buffer.key, h.options.Capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sample := range samples {
+		if err := resized.add(sample); err != nil {
+			_ = resized.close()
+			return nil, err
+		}
+	}
+
+	return resized, nil
+}
+
+// return the path of the file for a series
+func (h *History) path(key SeriesKey) string {
+	data, _ := json.Marshal(key)
+	hash := sha256.Sum256(data)
+
+	return filepath.Join(h.options.Directory, hex.EncodeToString(hash[:16])+fileExtension)
+}
+
+func (h *History) Series() []SeriesKey {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	result := make([]SeriesKey, 0, len(h.series))
+	for key := range h.series {
+		result = append(result, key)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.SKI != b.SKI {
+			return a.SKI < b.SKI
+		}
+		if a.Entity != b.Entity {
+			return a.Entity < b.Entity
+		}
+		return a.Quantity < b.Quantity
+	})
+
+	return result
+}
+
+func (h *History) Record(key SeriesKey, sample Sample) error {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	if h.closed {
+		return ErrClosed
+	}
+
+	buffer, ok := h.series[key]
+	if !ok {
+		var err error
+		if buffer, err = h.newBuffer(key); err != nil {
+			return err
+		}
+		h.series[key] = buffer
+	}
+
+	err := buffer.add(sample)
+
+	if time.Since(h.lastPrune) > pruneInterval {
+		if err := h.prune(); err != nil {
+			logging.Log().Error("history: error pruning series:", err)
+		}
+	}
+
+	return err
+}
+
+func (h *History) newBuffer(key SeriesKey) (*ringBuffer, error) {
+	if h.options.Directory == "" {
+		return newRingBuffer(key, h.options.Capacity), nil
+	}
+
+	return createFileRingBuffer(h.path(key), key, h.options.Capacity)
+}
+
+func (h *History) Query(key SeriesKey, from, to time.Time) ([]Sample, error) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	buffer, ok := h.series[key]
+	if !ok {
+		return nil, ErrSeriesNotFound
+	}
+
+	if h.options.Retention > 0 {
+		if oldest := time.Now().Add(-h.options.Retention); from.Before(oldest) {
+			from = oldest
+		}
+	}
+
+	var result []Sample
+	for _, sample := range buffer.all() {
+		if sample.Time.Before(from) || !sample.Time.Before(to) {
+			continue
+		}
+
+		result = append(result, sample)
+	}
+
+	// remote devices may report values out of order
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Time.Before(result[j].Time)
+	})
+
+	return result, nil
+}
+
+func (h *History) QueryAggregated(key SeriesKey, from, to time.Time, interval time.Duration) ([]AggregatedSample, error) {
+	if interval <= 0 {
+		return nil, ErrInvalidInterval
+	}
+
+	samples, err := h.Query(key, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []AggregatedSample
+	var sum float64
+
+	for _, sample := range samples {
+		start := from.Add(sample.Time.Sub(from).Truncate(interval))
+
+		if len(result) == 0 || !result[len(result)-1].Start.Equal(start) {
+			if len(result) > 0 {
+				last := &result[len(result)-1]
+				last.Avg = sum / float64(last.Count)
+			}
+
+			result = append(result, AggregatedSample{
+				Start: start,
+				Min:   sample.Value,
+				Max:   sample.Value,
+			})
+			sum = 0
+		}
+
+		last := &result[len(result)-1]
+		last.Min = min(last.Min, sample.Value)
+		last.Max = max(last.Max, sample.Value)
+		last.Count++
+		sum += sample.Value
+	}
+
+	if len(result) > 0 {
+		last := &result[len(result)-1]
+		last.Avg = sum / float64(last.Count)
+	}
+
+	return result, nil
+}
+
+func (h *History) Prune() error {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	return h.prune()
+}
+
+func (h *History) prune() error {
+	h.lastPrune = time.Now()
+
+	if h.options.Retention <= 0 {
+		return nil
+	}
+
+	oldest := time.Now().Add(-h.options.Retention)
+
+	var errs []error
+	for key, buffer := range h.series {
+		if !buffer.newest().Before(oldest) {
+			continue
+		}
+
+		if err := buffer.remove(); err != nil {
+			errs = append(errs, err)
+		}
+		delete(h.series, key)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (h *History) Close() error {
+	if h.service != nil {
+		util.UnsubscribeEvents(h.service, h)
+	}
+
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	h.closed = true
+
+	return h.closeAll()
+}
+
+func (h *History) closeAll() error {
+	var errs []error
+	for _, buffer := range h.series {
+		if err := buffer.close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// return the key of a series for a remote entity
+func NewSeriesKey(ski string, entity spineapi.EntityRemoteInterface, quantity string) SeriesKey {
+	return SeriesKey{
+		SKI:      ski,
+		Entity:   util.EntityAddressString(entity),
+		Quantity: quantity,
+	}
+}
+
+// return the name of a measurement quantity, e.g. "power/acPowerTotal" or "current/acCurrent/a"
+//
+// parameters:
+//   - measurementType: the measurement type, e.g. power
+//   - scope: the scope of the measurement, e.g. acPowerTotal
+//   - phase: the measured phase, empty if the measurement is not phase specific
+func QuantityName(
+	measurementType model.MeasurementTypeType,
+	scope model.ScopeTypeType,
+	phase model.ElectricalConnectionPhaseNameType) string {
+	name := string(measurementType) + "/" + string(scope)
+	if phase != "" {
+		name += "/" + string(phase)
+	}
+
+	return name
+}
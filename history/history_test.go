@@ -0,0 +1,179 @@
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var testKey = SeriesKey{SKI: remoteSki, Entity: "1", Quantity: "power/acPowerTotal"}
+
+func (s *HistorySuite) Test_RecordQuery() {
+	now := time.Now()
+
+	_, err := s.sut.Query(testKey, now.Add(-time.Hour), now)
+	assert.Equal(s.T(), ErrSeriesNotFound, err)
+
+	// recorded out of order
+	for _, minutes := range []int{-1, -3, -2} {
+		err = s.sut.Record(testKey, Sample{Time: now.Add(time.Duration(minutes) * time.Minute), Value: float64(minutes)})
+		assert.Nil(s.T(), err)
+	}
+
+	assert.Equal(s.T(), []SeriesKey{testKey}, s.sut.Series())
+
+	samples, err := s.sut.Query(testKey, now.Add(-time.Hour), now)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{-3, -2, -1}, sampleValues(samples))
+
+	samples, err = s.sut.Query(testKey, now.Add(-time.Hour), now.Add(-3*time.Minute))
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(samples))
+}
+
+func (s *HistorySuite) Test_Capacity() {
+	sut, err := NewHistory(nil, Options{Capacity: 3})
+	assert.Nil(s.T(), err)
+
+	start := time.Now().Add(-time.Hour)
+	for i := 0; i < 5; i++ {
+		err = sut.Record(testKey, Sample{Time: start.Add(time.Duration(i) * time.Minute), Value: float64(i)})
+		assert.Nil(s.T(), err)
+	}
+
+	samples, err := sut.Query(testKey, start, time.Now())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{2, 3, 4}, sampleValues(samples))
+}
+
+func (s *HistorySuite) Test_QueryAggregated() {
+	from := time.Now().Add(-time.Hour).Truncate(time.Minute)
+
+	_, err := s.sut.QueryAggregated(testKey, from, time.Now(), time.Minute)
+	assert.Equal(s.T(), ErrSeriesNotFound, err)
+
+	values := map[time.Duration]float64{
+		0:                10,
+		10 * time.Second: 20,
+		50 * time.Second: 60,
+		3 * time.Minute:  5,
+	}
+	for offset, value := range values {
+		err = s.sut.Record(testKey, Sample{Time: from.Add(offset), Value: value})
+		assert.Nil(s.T(), err)
+	}
+
+	_, err = s.sut.QueryAggregated(testKey, from, time.Now(), 0)
+	assert.Equal(s.T(), ErrInvalidInterval, err)
+
+	data, err := s.sut.QueryAggregated(testKey, from, time.Now(), time.Minute)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []AggregatedSample{
+		{Start: from, Min: 10, Max: 60, Avg: 30, Count: 3},
+		{Start: from.Add(3 * time.Minute), Min: 5, Max: 5, Avg: 5, Count: 1},
+	}, data)
+}
+
+func (s *HistorySuite) Test_Retention() {
+	sut, err := NewHistory(nil, Options{Retention: time.Hour})
+	assert.Nil(s.T(), err)
+
+	oldKey := SeriesKey{SKI: remoteSki, Entity: "2", Quantity: "power/acPowerTotal"}
+	now := time.Now()
+
+	assert.Nil(s.T(), sut.Record(testKey, Sample{Time: now.Add(-2 * time.Hour), Value: 1}))
+	assert.Nil(s.T(), sut.Record(testKey, Sample{Time: now.Add(-time.Minute), Value: 2}))
+	assert.Nil(s.T(), sut.Record(oldKey, Sample{Time: now.Add(-2 * time.Hour), Value: 3}))
+
+	samples, err := sut.Query(testKey, now.Add(-24*time.Hour), now)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{2}, sampleValues(samples))
+
+	err = sut.Prune()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []SeriesKey{testKey}, sut.Series())
+}
+
+func (s *HistorySuite) Test_Persistence() {
+	dir := s.T().TempDir()
+
+	sut, err := NewHistory(nil, Options{Directory: dir, Capacity: 4})
+	assert.Nil(s.T(), err)
+
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	for i := 0; i < 6; i++ {
+		err = sut.Record(testKey, Sample{Time: start.Add(time.Duration(i) * time.Minute), Value: float64(i)})
+		assert.Nil(s.T(), err)
+	}
+
+	err = sut.Close()
+	assert.Nil(s.T(), err)
+
+	err = sut.Record(testKey, Sample{Time: time.Now(), Value: 1})
+	assert.Equal(s.T(), ErrClosed, err)
+
+	// invalid files are skipped
+	err = os.WriteFile(filepath.Join(dir, "invalid"+fileExtension), []byte("invalid"), 0600)
+	assert.Nil(s.T(), err)
+
+	sut, err = NewHistory(nil, Options{Directory: dir, Capacity: 4})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []SeriesKey{testKey}, sut.Series())
+
+	samples, err := sut.Query(testKey, start, time.Now())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{2, 3, 4, 5}, sampleValues(samples))
+	assert.True(s.T(), start.Add(2*time.Minute).Equal(samples[0].Time))
+	assert.Nil(s.T(), sut.Close())
+
+	// a changed capacity keeps the newest samples
+	sut, err = NewHistory(nil, Options{Directory: dir, Capacity: 2})
+	assert.Nil(s.T(), err)
+
+	samples, err = sut.Query(testKey, start, time.Now())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{4, 5}, sampleValues(samples))
+
+	err = sut.Record(testKey, Sample{Time: start.Add(10 * time.Minute), Value: 10})
+	assert.Nil(s.T(), err)
+	assert.Nil(s.T(), sut.Close())
+
+	sut, err = NewHistory(nil, Options{Directory: dir, Capacity: 2, Retention: time.Minute})
+	assert.Nil(s.T(), err)
+
+	samples, err = sut.Query(testKey, start, time.Now())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(samples))
+
+	// expired series are removed including their file
+	err = sut.Prune()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(sut.Series()))
+	assert.Nil(s.T(), sut.Close())
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*"+fileExtension))
+	assert.Equal(s.T(), 1, len(files))
+}
+
+func (s *HistorySuite) Test_QuantityName() {
+	name := QuantityName(model.MeasurementTypeTypePower, model.ScopeTypeTypeACPowerTotal, "")
+	assert.Equal(s.T(), "power/acPowerTotal", name)
+
+	name = QuantityName(model.MeasurementTypeTypeCurrent, model.ScopeTypeTypeACCurrent, model.ElectricalConnectionPhaseNameTypeB)
+	assert.Equal(s.T(), "current/acCurrent/b", name)
+
+	key := NewSeriesKey(remoteSki, nil, name)
+	assert.Equal(s.T(), SeriesKey{SKI: remoteSki, Quantity: name}, key)
+}
+
+func sampleValues(samples []Sample) []float64 {
+	var result []float64
+	for _, sample := range samples {
+		result = append(result, sample.Value)
+	}
+
+	return result
+}
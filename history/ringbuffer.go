@@ -0,0 +1,239 @@
+package history
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"math"
+	"os"
+	"time"
+)
+
+// the file layout of a persisted ring buffer:
+//
+//	magic     [4]byte  "CEMH"
+//	version   uint16
+//	keyLength uint16
+//	capacity  uint32
+//	count     uint64   the total number of written samples
+//	key       [keyLength]byte, the JSON encoded SeriesKey
+//	samples   [capacity] of (unix nano int64, value float64)
+const (
+	fileMagic      = "CEMH"
+	fileVersion    = 1
+	fileHeaderSize = 20
+	countOffset    = 12
+	sampleSize     = 16
+)
+
+// A fixed size buffer of samples, which overwrites the oldest samples when full
+//
+// If a file is set, every sample is also written to the file, so the buffer
+// can be restored after a restart.
+type ringBuffer struct {
+	key SeriesKey
+
+	samples []Sample
+	// the total number of added samples
+	count uint64
+
+	file       *os.File
+	dataOffset int64
+}
+
+// create a new in memory ring buffer
+func newRingBuffer(key SeriesKey, capacity int) *ringBuffer {
+	return &ringBuffer{
+		key:     key,
+		samples: make([]Sample, capacity),
+	}
+}
+
+// create a new ring buffer stored in a file
+//
+// an existing file is truncated
+func createFileRingBuffer(path string, key SeriesKey, capacity int) (*ringBuffer, error) {
+	keyData, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, fileHeaderSize, fileHeaderSize+len(keyData))
+	copy(header, fileMagic)
+	binary.LittleEndian.PutUint16(header[4:], fileVersion)
+	binary.LittleEndian.PutUint16(header[6:], uint16(len(keyData)))
+	binary.LittleEndian.PutUint32(header[8:], uint32(capacity))
+	header = append(header, keyData...)
+
+	if _, err := file.Write(header); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	buffer := newRingBuffer(key, capacity)
+	buffer.file = file
+	buffer.dataOffset = int64(len(header))
+
+	return buffer, nil
+}
+
+// open a ring buffer file and read all samples
+func openFileRingBuffer(path string) (*ringBuffer, error) {
+	file, err := os.OpenFile(path, os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer, err := readRingBuffer(file)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return buffer, nil
+}
+
+func readRingBuffer(file *os.File) (*ringBuffer, error) {
+	header := make([]byte, fileHeaderSize)
+	if _, err := io.ReadFull(file, header); err != nil {
+		return nil, ErrInvalidFile
+	}
+
+	if string(header[:4]) != fileMagic || binary.LittleEndian.Uint16(header[4:]) != fileVersion {
+		return nil, ErrInvalidFile
+	}
+
+	keyLength := int(binary.LittleEndian.Uint16(header[6:]))
+	capacity := int(binary.LittleEndian.Uint32(header[8:]))
+	count := binary.LittleEndian.Uint64(header[countOffset:])
+	if capacity == 0 {
+		return nil, ErrInvalidFile
+	}
+
+	keyData := make([]byte, keyLength)
+	if _, err := io.ReadFull(file, keyData); err != nil {
+		return nil, ErrInvalidFile
+	}
+
+	var key SeriesKey
+	if err := json.Unmarshal(keyData, &key); err != nil {
+		return nil, ErrInvalidFile
+	}
+
+	buffer := newRingBuffer(key, capacity)
+	buffer.file = file
+	buffer.dataOffset = int64(fileHeaderSize + keyLength)
+
+	length := min(count, uint64(capacity))
+	data := make([]byte, length*sampleSize)
+	if _, err := file.ReadAt(data, buffer.dataOffset); err != nil {
+		return nil, ErrInvalidFile
+	}
+
+	for i := 0; i < int(length); i++ {
+		buffer.samples[i] = decodeSample(data[i*sampleSize:])
+	}
+	buffer.count = count
+
+	return buffer, nil
+}
+
+// return the maximum number of samples
+func (r *ringBuffer) capacity() int {
+	return len(r.samples)
+}
+
+// add a sample, overwriting the oldest one if the buffer is full
+func (r *ringBuffer) add(sample Sample) error {
+	index := int(r.count % uint64(r.capacity()))
+	r.samples[index] = sample
+	r.count++
+
+	if r.file == nil {
+		return nil
+	}
+
+	data := make([]byte, sampleSize)
+	encodeSample(data, sample)
+	if _, err := r.file.WriteAt(data, r.dataOffset+int64(index*sampleSize)); err != nil {
+		return err
+	}
+
+	count := make([]byte, 8)
+	binary.LittleEndian.PutUint64(count, r.count)
+	_, err := r.file.WriteAt(count, countOffset)
+
+	return err
+}
+
+// return all stored samples from the oldest to the newest added sample
+func (r *ringBuffer) all() []Sample {
+	if r.count <= uint64(r.capacity()) {
+		return append([]Sample(nil), r.samples[:r.count]...)
+	}
+
+	start := int(r.count % uint64(r.capacity()))
+	result := make([]Sample, 0, r.capacity())
+	result = append(result, r.samples[start:]...)
+	result = append(result, r.samples[:start]...)
+
+	return result
+}
+
+// return the newest sample time, zero if there is no sample
+func (r *ringBuffer) newest() time.Time {
+	var result time.Time
+
+	for _, sample := range r.all() {
+		if sample.Time.After(result) {
+			result = sample.Time
+		}
+	}
+
+	return result
+}
+
+// close the file, if there is one
+func (r *ringBuffer) close() error {
+	if r.file == nil {
+		return nil
+	}
+
+	err := r.file.Sync()
+	if closeErr := r.file.Close(); err == nil {
+		err = closeErr
+	}
+	r.file = nil
+
+	return err
+}
+
+// close and remove the file, if there is one
+func (r *ringBuffer) remove() error {
+	if r.file == nil {
+		return nil
+	}
+
+	path := r.file.Name()
+	_ = r.file.Close()
+	r.file = nil
+
+	return os.Remove(path)
+}
+
+func encodeSample(data []byte, sample Sample) {
+	binary.LittleEndian.PutUint64(data, uint64(sample.Time.UnixNano()))
+	binary.LittleEndian.PutUint64(data[8:], math.Float64bits(sample.Value))
+}
+
+func decodeSample(data []byte) Sample {
+	return Sample{
+		Time:  time.Unix(0, int64(binary.LittleEndian.Uint64(data))),
+		Value: math.Float64frombits(binary.LittleEndian.Uint64(data[8:])),
+	}
+}
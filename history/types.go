@@ -0,0 +1,64 @@
+package history
+
+import (
+	"errors"
+	"time"
+)
+
+// Identifies a recorded series
+type SeriesKey struct {
+	// the SKI of the remote device
+	SKI string `json:"ski"`
+
+	// the address of the remote entity, e.g. "1.1"
+	Entity string `json:"entity"`
+
+	// the name of the quantity, e.g. "power/acPowerTotal", see QuantityName
+	Quantity string `json:"quantity"`
+}
+
+// A single recorded value
+type Sample struct {
+	Time  time.Time
+	Value float64
+}
+
+// The aggregated values of all samples within an interval
+type AggregatedSample struct {
+	// the start of the interval
+	Start time.Time
+
+	Min float64
+	Max float64
+	Avg float64
+
+	// the number of samples within the interval
+	Count int
+}
+
+// Contains the settings of a history
+type Options struct {
+	// the directory the series are stored in, the history is kept in memory only if empty
+	Directory string
+
+	// the maximum number of samples per series, the oldest samples are overwritten
+	Capacity int
+
+	// the duration samples are kept for, 0 keeps samples until they are overwritten
+	Retention time.Duration
+}
+
+const (
+	// the default number of samples per series, 24 hours with one sample per second
+	DefaultCapacity = 24 * 60 * 60
+
+	// the default retention
+	DefaultRetention = 24 * time.Hour
+)
+
+var (
+	ErrSeriesNotFound  = errors.New("series not found")
+	ErrInvalidInterval = errors.New("interval needs to be positive")
+	ErrClosed          = errors.New("history is closed")
+	ErrInvalidFile     = errors.New("invalid history file")
+)
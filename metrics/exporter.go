@@ -0,0 +1,325 @@
+package metrics
+
+import (
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Prometheus and OpenMetrics exporter for the values of the monitoring use cases
+//
+// The exporter needs to receive all CEM and use case events, and it needs
+// to know the use cases whose values should be exported:
+//
+//	exporter := metrics.NewExporter(cem.Service)
+//	cem.AddUseCase(mgcp)
+//	exporter.AddUseCase(mgcp)
+//	http.Handle("/metrics", exporter)
+//
+// The values are read from the use case getters on every scrape.
+type Exporter struct {
+	service eebusapi.ServiceInterface
+
+	metrics []entityMetric
+
+	// the local features the exporter is registered as result handler for
+	resultFeatures []spineapi.FeatureLocalInterface
+
+	deviceNameProvider func(ski string) string
+
+	devices map[string]*deviceState
+
+	mux sync.Mutex
+}
+
+var _ http.Handler = (*Exporter)(nil)
+
+// create a new exporter
+//
+// parameters:
+//   - service: the EEBUS service, used to count the error results of requests
+func NewExporter(service eebusapi.ServiceInterface) *Exporter {
+	return &Exporter{
+		service: service,
+		devices: make(map[string]*deviceState),
+	}
+}
+
+// export the values of a use case
+//
+// supported are the use cases EVCEM, EVSOC, MGCP, MPC, VABD and VAPD, others are ignored.
+// This needs to be called after the use case was added to the CEM.
+func (e *Exporter) AddUseCase(usecase api.UseCaseInterface) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	e.metrics = append(e.metrics, useCaseMetrics(usecase)...)
+
+	e.addResultHandlers()
+}
+
+// register as result handler on all local client features, to count error results
+func (e *Exporter) addResultHandlers() {
+	if e.service == nil || e.service.LocalDevice() == nil {
+		return
+	}
+
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	if localEntity == nil {
+		return
+	}
+
+	for _, feature := range localEntity.Features() {
+		if feature.Role() != model.RoleTypeClient || slices.Contains(e.resultFeatures, feature) {
+			continue
+		}
+
+		feature.AddResultHandler(e)
+		e.resultFeatures = append(e.resultFeatures, feature)
+	}
+}
+
+// set a function which provides the name of a device, e.g. from the device registry
+//
+// if not set or if it returns an empty string, the SPINE device address is used
+func (e *Exporter) SetDeviceNameProvider(provider func(ski string) string) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	e.deviceNameProvider = provider
+}
+
+// handle CEM and use case events
+//
+// this needs to be called with all events the application receives
+func (e *Exporter) HandleEvent(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	state := e.deviceState(ski)
+	if device != nil {
+		state.device = device
+	}
+
+	switch {
+	case event == cem.DeviceConnected:
+		state.connected = true
+	case event == cem.DeviceDisconnected:
+		state.connected = false
+		state.entities = make(map[string]spineapi.EntityRemoteInterface)
+	case entity == nil:
+		return
+	case strings.HasSuffix(string(event), entityRemovedSuffix):
+		// the EntityRemoved events of all use cases report a disconnected entity
+		delete(state.entities, util.EntityAddressString(entity))
+	default:
+		state.entities[util.EntityAddressString(entity)] = entity
+	}
+}
+
+// count error results of requests sent to remote devices
+func (e *Exporter) HandleResult(msg spineapi.ResultMessage) {
+	if msg.Result == nil || msg.Result.ErrorNumber == nil || *msg.Result.ErrorNumber == model.ErrorNumberTypeNoError ||
+		msg.DeviceRemote == nil || msg.FeatureLocal == nil {
+		return
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	state := e.deviceState(msg.DeviceRemote.Ski())
+	if state.device == nil {
+		state.device = msg.DeviceRemote
+	}
+	state.requestErrors[string(msg.FeatureLocal.Type())]++
+}
+
+// return the state of a device, creating it if it does not exist
+func (e *Exporter) deviceState(ski string) *deviceState {
+	state, ok := e.devices[ski]
+	if !ok {
+		state = &deviceState{
+			entities:      make(map[string]spineapi.EntityRemoteInterface),
+			requestErrors: make(map[string]uint64),
+		}
+		e.devices[ski] = state
+	}
+
+	return state
+}
+
+// return the name of a device
+func deviceName(provider func(ski string) string, ski string, device spineapi.DeviceRemoteInterface) string {
+	if provider != nil {
+		if name := provider(ski); name != "" {
+			return name
+		}
+	}
+
+	if device != nil && device.Address() != nil {
+		return string(*device.Address())
+	}
+
+	return ""
+}
+
+// write all metrics in the Prometheus text format, or in the OpenMetrics
+// format if the client accepts it
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	openMetrics := strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text")
+
+	families := e.collect()
+
+	if openMetrics {
+		w.Header().Set("Content-Type", contentTypeOpenMetrics)
+	} else {
+		w.Header().Set("Content-Type", contentTypeText)
+	}
+
+	_ = writeFamilies(w, families, openMetrics)
+}
+
+// read all values and return the metric families
+//
+// the getters and the device name provider are called without holding the
+// lock, as they may block or call back into the exporter
+func (e *Exporter) collect() []*family {
+	snapshots, metrics, provider := e.snapshot()
+
+	connected := &family{
+		name:       namePrefix + "device_connected",
+		help:       "Connection state of the remote device, 1 if connected",
+		metricType: metricTypeGauge,
+	}
+	requestErrors := &family{
+		name:       namePrefix + "request_errors",
+		help:       "Number of error results the remote device sent for requests",
+		metricType: metricTypeCounter,
+	}
+	families := []*family{connected, requestErrors}
+
+	// the use case metrics, the key is the metric name as multiple use cases
+	// may provide the same metric
+	entityFamilies := make(map[string]*family)
+
+	for _, state := range snapshots {
+		deviceLabels := []label{
+			{name: "ski", value: state.ski},
+			{name: "device", value: deviceName(provider, state.ski, state.device)},
+		}
+
+		value := 0.0
+		if state.connected {
+			value = 1
+		}
+		connected.samples = append(connected.samples, sample{labels: deviceLabels, value: value})
+
+		features := make([]string, 0, len(state.requestErrors))
+		for feature := range state.requestErrors {
+			features = append(features, feature)
+		}
+		sort.Strings(features)
+		for _, feature := range features {
+			requestErrors.samples = append(requestErrors.samples, sample{
+				labels: append(slices.Clone(deviceLabels), label{name: "feature", value: feature}),
+				value:  float64(state.requestErrors[feature]),
+			})
+		}
+
+		addresses := make([]string, 0, len(state.entities))
+		for address := range state.entities {
+			addresses = append(addresses, address)
+		}
+		sort.Strings(addresses)
+
+		for _, address := range addresses {
+			entity := state.entities[address]
+			entityLabels := append(slices.Clone(deviceLabels), label{name: "entity", value: address})
+
+			for _, metric := range metrics {
+				values, err := metric.read(entity)
+				if err != nil || len(values) == 0 {
+					continue
+				}
+
+				name := metricName(metric)
+				f, ok := entityFamilies[name]
+				if !ok {
+					f = &family{
+						name:       name,
+						help:       metric.help,
+						unit:       metric.unit,
+						metricType: metricTypeGauge,
+					}
+					entityFamilies[name] = f
+					families = append(families, f)
+				}
+
+				for _, value := range values {
+					labels := entityLabels
+					if metric.perPhase {
+						labels = append(slices.Clone(entityLabels), label{name: "phase", value: string(value.Phase)})
+					}
+
+					f.samples = append(f.samples, sample{labels: labels, value: value.Value})
+				}
+			}
+		}
+	}
+
+	return families
+}
+
+// return a copy of the device states sorted by SKI, the metrics and the device name provider
+func (e *Exporter) snapshot() ([]deviceSnapshot, []entityMetric, func(ski string) string) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	skis := make([]string, 0, len(e.devices))
+	for ski := range e.devices {
+		skis = append(skis, ski)
+	}
+	sort.Strings(skis)
+
+	snapshots := make([]deviceSnapshot, 0, len(skis))
+	for _, ski := range skis {
+		state := e.devices[ski]
+		snapshot := deviceSnapshot{
+			ski: ski,
+			deviceState: deviceState{
+				device:        state.device,
+				connected:     state.connected,
+				entities:      make(map[string]spineapi.EntityRemoteInterface, len(state.entities)),
+				requestErrors: make(map[string]uint64, len(state.requestErrors)),
+			},
+		}
+		for address, entity := range state.entities {
+			snapshot.entities[address] = entity
+		}
+		for feature, count := range state.requestErrors {
+			snapshot.requestErrors[feature] = count
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots, slices.Clone(e.metrics), e.deviceNameProvider
+}
+
+// return the full name of a metric including the prefix and unit
+func metricName(metric entityMetric) string {
+	name := namePrefix + metric.name
+	if metric.unit != "" {
+		name += "_" + metric.unit
+	}
+
+	return name
+}
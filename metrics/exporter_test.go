@@ -0,0 +1,252 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/ucmgcp"
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ExporterSuite) scrape(accept string) (string, string) {
+	request := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+	recorder := httptest.NewRecorder()
+
+	s.sut.ServeHTTP(recorder, request)
+
+	return recorder.Body.String(), recorder.Header().Get("Content-Type")
+}
+
+func (s *ExporterSuite) setPowerData() {
+	descData := &model.MeasurementDescriptionListDataType{
+		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(0)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypePower),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACPowerTotal),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.smgwEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	fErr := rFeature.UpdateData(model.FunctionTypeMeasurementDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	measData := &model.MeasurementListDataType{
+		MeasurementData: []model.MeasurementDataType{
+			{
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+				Value:         model.NewScaledNumberType(1500),
+			},
+		},
+	}
+
+	fErr = rFeature.UpdateData(model.FunctionTypeMeasurementListData, measData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	elDescData := &model.ElectricalConnectionDescriptionListDataType{
+		ElectricalConnectionDescriptionData: []model.ElectricalConnectionDescriptionDataType{
+			{
+				ElectricalConnectionId:  eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				PositiveEnergyDirection: eebusutil.Ptr(model.EnergyDirectionTypeConsume),
+			},
+		},
+	}
+
+	rElFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.smgwEntity, model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionDescriptionListData, elDescData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	elParamData := &model.ElectricalConnectionParameterDescriptionListDataType{
+		ElectricalConnectionParameterDescriptionData: []model.ElectricalConnectionParameterDescriptionDataType{
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				MeasurementId:          eebusutil.Ptr(model.MeasurementIdType(0)),
+			},
+		},
+	}
+
+	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, elParamData, nil, nil)
+	assert.Nil(s.T(), fErr)
+}
+
+func (s *ExporterSuite) Test_ServeHTTP() {
+	body, contentType := s.scrape("")
+	assert.Equal(s.T(), contentTypeText, contentType)
+	assert.Equal(s.T(), "", body)
+
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, nil, cem.DeviceConnected)
+
+	body, _ = s.scrape("")
+	assert.Equal(s.T(),
+		"# HELP cemd_device_connected Connection state of the remote device, 1 if connected\n"+
+			"# TYPE cemd_device_connected gauge\n"+
+			"cemd_device_connected{ski=\"testremoteski\",device=\"remote\"} 1\n",
+		body)
+
+	s.setPowerData()
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.DataUpdatePower)
+	s.sut.SetDeviceNameProvider(func(ski string) string { return "Smart \"Meter\"" })
+
+	body, _ = s.scrape("")
+	assert.Contains(s.T(), body, "cemd_device_connected{ski=\"testremoteski\",device=\"Smart \\\"Meter\\\"\"} 1\n")
+	assert.Contains(s.T(), body, "# TYPE cemd_grid_power_watts gauge\n")
+	assert.Contains(s.T(), body, "cemd_grid_power_watts{ski=\"testremoteski\",device=\"Smart \\\"Meter\\\"\",entity=\"1\"} 1500\n")
+	assert.NotContains(s.T(), body, "cemd_grid_frequency_hertz")
+
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, nil, cem.DeviceDisconnected)
+
+	body, _ = s.scrape("")
+	assert.Contains(s.T(), body, "cemd_device_connected{ski=\"testremoteski\",device=\"Smart \\\"Meter\\\"\"} 0\n")
+	assert.NotContains(s.T(), body, "cemd_grid_power_watts")
+}
+
+func (s *ExporterSuite) Test_EntityEvents() {
+	s.setPowerData()
+
+	// use case events don't change the connection state
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.EntityAdded)
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.DataUpdatePower)
+
+	body, _ := s.scrape("")
+	assert.Contains(s.T(), body, "cemd_device_connected{ski=\"testremoteski\",device=\"remote\"} 0\n")
+	assert.Contains(s.T(), body, "cemd_grid_power_watts{ski=\"testremoteski\",device=\"remote\",entity=\"1\"} 1500\n")
+
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.EntityRemoved)
+
+	body, _ = s.scrape("")
+	assert.NotContains(s.T(), body, "cemd_grid_power_watts")
+}
+
+func (s *ExporterSuite) Test_CollectWithoutLock() {
+	s.setPowerData()
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.DataUpdatePower)
+
+	// the provider may call back into the exporter while a scrape is running
+	s.sut.SetDeviceNameProvider(func(ski string) string {
+		s.sut.HandleEvent(ski, s.remoteDevice, nil, cem.DeviceConnected)
+		return "meter"
+	})
+
+	body, _ := s.scrape("")
+	assert.Contains(s.T(), body, "cemd_grid_power_watts{ski=\"testremoteski\",device=\"meter\",entity=\"1\"} 1500\n")
+}
+
+func (s *ExporterSuite) Test_OpenMetrics() {
+	s.setPowerData()
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.DataUpdatePower)
+
+	body, contentType := s.scrape("application/openmetrics-text; version=1.0.0")
+	assert.Equal(s.T(), contentTypeOpenMetrics, contentType)
+	assert.Contains(s.T(), body, "# UNIT cemd_grid_power_watts watts\n")
+	assert.Contains(s.T(), body, "cemd_grid_power_watts{ski=\"testremoteski\",device=\"remote\",entity=\"1\"} 1500\n")
+	assert.True(s.T(), len(body) > 6 && body[len(body)-6:] == "# EOF\n")
+}
+
+func (s *ExporterSuite) Test_HandleResult() {
+	s.sut.HandleResult(spineapi.ResultMessage{})
+
+	localEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	localFeature := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeMeasurement, model.RoleTypeClient)
+
+	msg := spineapi.ResultMessage{
+		Result: &model.ResultDataType{
+			ErrorNumber: eebusutil.Ptr(model.ErrorNumberTypeNoError),
+		},
+		FeatureLocal: localFeature,
+		DeviceRemote: s.remoteDevice,
+	}
+	s.sut.HandleResult(msg)
+
+	body, _ := s.scrape("")
+	assert.NotContains(s.T(), body, "cemd_request_errors_total")
+
+	msg.Result.ErrorNumber = eebusutil.Ptr(model.ErrorNumberTypeGeneralError)
+	s.sut.HandleResult(msg)
+	s.sut.HandleResult(msg)
+
+	body, _ = s.scrape("")
+	assert.Contains(s.T(), body, "# TYPE cemd_request_errors_total counter\n")
+	assert.Contains(s.T(), body, "cemd_request_errors_total{ski=\"testremoteski\",device=\"remote\",feature=\"Measurement\"} 2\n")
+
+	body, _ = s.scrape("application/openmetrics-text")
+	assert.Contains(s.T(), body, "# TYPE cemd_request_errors counter\n")
+	assert.Contains(s.T(), body, "cemd_request_errors_total{ski=\"testremoteski\",device=\"remote\",feature=\"Measurement\"} 2\n")
+}
+
+func (s *ExporterSuite) Test_AddUseCase() {
+	// result handlers are only registered once per feature
+	count := len(s.sut.resultFeatures)
+	assert.NotEqual(s.T(), 0, count)
+
+	s.sut.AddUseCase(s.mgcp)
+	assert.Equal(s.T(), count, len(s.sut.resultFeatures))
+	assert.Equal(s.T(), 2*len(mgcpMetrics(s.mgcp)), len(s.sut.metrics))
+}
+
+func (s *ExporterSuite) Test_PhaseLabels() {
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.smgwEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	rElFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.smgwEntity, model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+
+	// the device measures the current only on phase B
+	descData := &model.MeasurementDescriptionListDataType{
+		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(0)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypeCurrent),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACCurrent),
+			},
+		},
+	}
+	fErr := rFeature.UpdateData(model.FunctionTypeMeasurementDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	measData := &model.MeasurementListDataType{
+		MeasurementData: []model.MeasurementDataType{
+			{
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+				Value:         model.NewScaledNumberType(10),
+			},
+		},
+	}
+	fErr = rFeature.UpdateData(model.FunctionTypeMeasurementListData, measData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	elDescData := &model.ElectricalConnectionDescriptionListDataType{
+		ElectricalConnectionDescriptionData: []model.ElectricalConnectionDescriptionDataType{
+			{
+				ElectricalConnectionId:  eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				PositiveEnergyDirection: eebusutil.Ptr(model.EnergyDirectionTypeConsume),
+			},
+		},
+	}
+	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionDescriptionListData, elDescData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	elParamData := &model.ElectricalConnectionParameterDescriptionListDataType{
+		ElectricalConnectionParameterDescriptionData: []model.ElectricalConnectionParameterDescriptionDataType{
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				MeasurementId:          eebusutil.Ptr(model.MeasurementIdType(0)),
+				AcMeasuredPhases:       eebusutil.Ptr(model.ElectricalConnectionPhaseNameTypeB),
+			},
+		},
+	}
+	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, elParamData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.DataUpdateCurrentPerPhase)
+
+	body, _ := s.scrape("")
+	assert.Contains(s.T(), body, "cemd_grid_current_amperes{ski=\"testremoteski\",device=\"remote\",entity=\"1\",phase=\"b\"} 10\n")
+	assert.NotContains(s.T(), body, "phase=\"a\"")
+}
@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// write the metric families in the Prometheus text or the OpenMetrics format
+//
+// families without samples are omitted
+func writeFamilies(w io.Writer, families []*family, openMetrics bool) error {
+	writer := bufio.NewWriter(w)
+
+	for _, f := range families {
+		if len(f.samples) == 0 {
+			continue
+		}
+
+		sampleName := f.name
+		familyName := f.name
+		if f.metricType == metricTypeCounter {
+			sampleName += "_total"
+			if !openMetrics {
+				familyName = sampleName
+			}
+		}
+
+		_, _ = writer.WriteString("# HELP " + familyName + " " + escapeHelp(f.help) + "\n")
+		_, _ = writer.WriteString("# TYPE " + familyName + " " + string(f.metricType) + "\n")
+		if openMetrics && f.unit != "" {
+			_, _ = writer.WriteString("# UNIT " + familyName + " " + f.unit + "\n")
+		}
+
+		for _, s := range f.samples {
+			_, _ = writer.WriteString(sampleName)
+			writeLabels(writer, s.labels)
+			_, _ = writer.WriteString(" " + formatValue(s.value) + "\n")
+		}
+	}
+
+	if openMetrics {
+		_, _ = writer.WriteString("# EOF\n")
+	}
+
+	return writer.Flush()
+}
+
+func writeLabels(writer *bufio.Writer, labels []label) {
+	if len(labels) == 0 {
+		return
+	}
+
+	_ = writer.WriteByte('{')
+	for i, l := range labels {
+		if i > 0 {
+			_ = writer.WriteByte(',')
+		}
+		_, _ = writer.WriteString(l.name + "=\"" + escapeLabelValue(l.value) + "\"")
+	}
+	_ = writer.WriteByte('}')
+}
+
+var (
+	helpEscaper       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(value string) string {
+	return helpEscaper.Replace(value)
+}
+
+func escapeLabelValue(value string) string {
+	return labelValueEscaper.Replace(value)
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	}
+
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
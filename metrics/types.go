@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"github.com/enbility/cemd/api"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// the prefix of all metric names
+const namePrefix = "cemd_"
+
+// the suffix of the EntityRemoved events of all use cases
+const entityRemovedSuffix = ".EntityRemoved"
+
+// the content types of the supported exposition formats
+const (
+	contentTypeText        = "text/plain; version=0.0.4; charset=utf-8"
+	contentTypeOpenMetrics = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+type metricType string
+
+const (
+	metricTypeGauge   metricType = "gauge"
+	metricTypeCounter metricType = "counter"
+)
+
+// Describes a gauge whose value is read from a use case getter
+type entityMetric struct {
+	name string
+	help string
+	unit string
+
+	// read the value, or one value per phase if perPhase is true
+	read func(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error)
+
+	perPhase bool
+}
+
+// a metric family with all its samples, as written to the response
+type family struct {
+	name       string
+	help       string
+	unit       string
+	metricType metricType
+
+	samples []sample
+}
+
+// a single value with its labels
+type sample struct {
+	labels []label
+	value  float64
+}
+
+type label struct {
+	name  string
+	value string
+}
+
+// the connection state and counters of a remote device
+type deviceState struct {
+	device    spineapi.DeviceRemoteInterface
+	connected bool
+
+	// the known entities of the device, the key is the entity address
+	entities map[string]spineapi.EntityRemoteInterface
+
+	// the number of error results for requests, the key is the feature type
+	requestErrors map[string]uint64
+}
+
+// a copy of a device state, used to read the values without holding the lock
+type deviceSnapshot struct {
+	deviceState
+
+	ski string
+}
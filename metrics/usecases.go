@@ -0,0 +1,132 @@
+package metrics
+
+import (
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/ucevcem"
+	"github.com/enbility/cemd/ucevsoc"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmpc"
+	"github.com/enbility/cemd/ucvabd"
+	"github.com/enbility/cemd/ucvapd"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// return the metrics provided by a use case, nil if the use case is not supported
+func useCaseMetrics(usecase api.UseCaseInterface) []entityMetric {
+	switch usecase.UseCaseName() {
+	case model.UseCaseNameTypeMonitoringOfGridConnectionPoint:
+		if uc, ok := usecase.(ucmgcp.UCMGCPInterface); ok {
+			return mgcpMetrics(uc)
+		}
+	case model.UseCaseNameTypeMonitoringOfPowerConsumption:
+		if uc, ok := usecase.(ucmpc.UCMCPInterface); ok {
+			return mpcMetrics(uc)
+		}
+	case model.UseCaseNameTypeMeasurementOfElectricityDuringEVCharging:
+		if uc, ok := usecase.(ucevcem.UCEVCEMInterface); ok {
+			return evcemMetrics(uc)
+		}
+	case model.UseCaseNameTypeEVStateOfCharge:
+		if uc, ok := usecase.(ucevsoc.UCEVSOCInterface); ok {
+			return evsocMetrics(uc)
+		}
+	case model.UseCaseNameTypeVisualizationOfAggregatedPhotovoltaicData:
+		if uc, ok := usecase.(ucvapd.UCVAPDInterface); ok {
+			return vapdMetrics(uc)
+		}
+	case model.UseCaseNameTypeVisualizationOfAggregatedBatteryData:
+		if uc, ok := usecase.(ucvabd.UCVABDInterface); ok {
+			return vabdMetrics(uc)
+		}
+	}
+
+	return nil
+}
+
+func mgcpMetrics(uc ucmgcp.UCMGCPInterface) []entityMetric {
+	return []entityMetric{
+		gauge("grid_power", "watts", "Momentary power at the grid connection point, positive for consumption", uc.Power),
+		gauge("grid_power_limitation_factor", "percent", "Maximum allowed feed-in power as percentage of the nominal PV peak power", uc.PowerLimitationFactor),
+		gauge("grid_energy_feed_in", "watthours", "Total energy fed into the grid", uc.EnergyFeedIn),
+		gauge("grid_energy_consumed", "watthours", "Total energy consumed from the grid", uc.EnergyConsumed),
+		phaseGauge("grid_current", "amperes", "Momentary current per phase at the grid connection point", uc.CurrentPerPhaseDetails),
+		phaseGauge("grid_voltage", "volts", "Voltage per phase at the grid connection point", uc.VoltagePerPhaseDetails),
+		gauge("grid_frequency", "hertz", "Frequency at the grid connection point", uc.Frequency),
+	}
+}
+
+func mpcMetrics(uc ucmpc.UCMCPInterface) []entityMetric {
+	return []entityMetric{
+		gauge("consumer_power", "watts", "Momentary power of the consumer, positive for consumption", uc.Power),
+		phaseGauge("consumer_phase_power", "watts", "Momentary power per phase of the consumer", uc.PowerPerPhaseDetails),
+		gauge("consumer_energy_consumed", "watthours", "Total energy consumed by the consumer", uc.EnergyConsumed),
+		gauge("consumer_energy_produced", "watthours", "Total energy produced by the consumer", uc.EnergyProduced),
+		phaseGauge("consumer_current", "amperes", "Momentary current per phase of the consumer", uc.CurrentPerPhaseDetails),
+		phaseGauge("consumer_voltage", "volts", "Voltage per phase of the consumer", uc.VoltagePerPhaseDetails),
+		gauge("consumer_frequency", "hertz", "Frequency of the consumers electrical connection", uc.Frequency),
+	}
+}
+
+func evcemMetrics(uc ucevcem.UCEVCEMInterface) []entityMetric {
+	return []entityMetric{
+		gauge("ev_phases_connected", "", "Number of phases the EV is connected with", func(entity spineapi.EntityRemoteInterface) (float64, error) {
+			value, err := uc.PhasesConnected(entity)
+			return float64(value), err
+		}),
+		phaseGauge("ev_current", "amperes", "Charging current per phase of the EV", uc.CurrentPerPhaseDetails),
+		phaseGauge("ev_power", "watts", "Charging power per phase of the EV", uc.PowerPerPhaseDetails),
+		gauge("ev_energy_charged", "watthours", "Energy charged into the EV", uc.EnergyCharged),
+	}
+}
+
+func evsocMetrics(uc ucevsoc.UCEVSOCInterface) []entityMetric {
+	return []entityMetric{
+		gauge("ev_state_of_charge", "percent", "State of charge of the EV", uc.StateOfCharge),
+	}
+}
+
+func vapdMetrics(uc ucvapd.UCVAPDInterface) []entityMetric {
+	return []entityMetric{
+		gauge("pv_power", "watts", "Momentary production power of the PV system", uc.Power),
+		gauge("pv_power_nominal_peak", "watts", "Nominal peak power of the PV system", uc.PowerNominalPeak),
+		gauge("pv_yield_total", "watthours", "Total yield of the PV system", uc.PVYieldTotal),
+	}
+}
+
+func vabdMetrics(uc ucvabd.UCVABDInterface) []entityMetric {
+	return []entityMetric{
+		gauge("battery_power", "watts", "Momentary power of the battery system, positive for charging", uc.Power),
+		gauge("battery_energy_charged", "watthours", "Total energy charged into the battery system", uc.EnergyCharged),
+		gauge("battery_energy_discharged", "watthours", "Total energy discharged from the battery system", uc.EnergyDischarged),
+		gauge("battery_state_of_charge", "percent", "State of charge of the battery system", uc.StateOfCharge),
+	}
+}
+
+// return a metric for a getter with a single value
+func gauge(name, unit, help string, read func(spineapi.EntityRemoteInterface) (float64, error)) entityMetric {
+	return entityMetric{
+		name: name,
+		unit: unit,
+		help: help,
+		read: func(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error) {
+			value, err := read(entity)
+			if err != nil {
+				return nil, err
+			}
+
+			return []api.MeasurementResult{{Value: value}}, nil
+		},
+	}
+}
+
+// return a metric for a getter with one value per phase, the phase of each value is used as label
+func phaseGauge(name, unit, help string, read func(spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error)) entityMetric {
+	return entityMetric{
+		name:     name,
+		unit:     unit,
+		help:     help,
+		read:     read,
+		perPhase: true,
+	}
+}
@@ -0,0 +1,57 @@
+// Package modbus provides a Modbus TCP server facade, which exposes the
+// values of the EEBUS use cases to controllers only supporting Modbus.
+//
+// Each Modbus unit identifier is mapped to the SKI of a remote device. The
+// values of a use case are read from the first entity of the device which is
+// compatible with the use case.
+//
+// 32 bit values use two registers with the high word first. Values which are
+// not available are reported as 0x80000000 for 32 bit and 0xFFFF for 16 bit
+// registers. Unused registers within a block are reported as 0.
+//
+// Input registers (function code 4):
+//
+//	address  size  type    unit    value
+//	0        1     uint16  -       1 if the device is connected, 0 otherwise
+//
+//	Grid connection point (MGCP)
+//	100      2     int32   W       power, positive for consumption
+//	102      2     int32   Wh      energy fed into the grid
+//	104      2     int32   Wh      energy consumed from the grid
+//	106      6     int32   mA      current per phase L1, L2, L3
+//	112      6     int32   0.1 V   voltage per phase L1, L2, L3
+//	118      2     int32   mHz     frequency
+//	120      2     int32   0.1 %   power limitation factor
+//
+//	EV charging (EVCC, EVCEM, EVSOC, OPEV)
+//	200      1     uint16  -       charge state: 0 unknown, 1 unplugged, 2 error, 3 paused, 4 active, 5 finished
+//	201      1     uint16  -       number of connected phases
+//	202      6     int32   mA      charging current per phase L1, L2, L3
+//	208      6     int32   W       charging power per phase L1, L2, L3
+//	214      2     int32   Wh      charged energy
+//	216      2     int32   0.1 %   state of charge
+//	218      6     int32   mA      current limit per phase L1, L2, L3 (OPEV)
+//
+//	PV system (VAPD)
+//	300      2     int32   W       power
+//	302      2     int32   W       nominal peak power
+//	304      2     int32   Wh      total yield
+//
+//	Battery system (VABD)
+//	400      2     int32   W       power, positive for charging
+//	402      2     int32   Wh      charged energy
+//	404      2     int32   Wh      discharged energy
+//	406      2     int32   0.1 %   state of charge
+//
+// Holding registers (function codes 3, 6 and 16):
+//
+//	address  size  type    unit    value
+//	0        3     uint16  0.1 A   current limit per phase L1, L2, L3 (OPEV)
+//	3        1     uint16  -       1 if the limits are active, 0 to deactivate them
+//	4        1     uint16  s       duration after which written limits expire, 0 if they don't expire
+//
+// Writing the limit or active registers sends the limits with
+// ucopev.WriteLoadControlLimits, which adjusts the values to the range
+// permitted by the EV. Reading the registers returns the limits reported by
+// the EV, so the adjusted values are visible after the EV confirmed them.
+package modbus
@@ -0,0 +1,265 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"sort"
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevcem"
+	"github.com/enbility/cemd/ucevsoc"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/ucvabd"
+	"github.com/enbility/cemd/ucvapd"
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Modbus TCP server facade exposing the use case values in a fixed register map
+//
+// The facade needs to receive all CEM and use case events, and it needs to
+// know the use cases whose values should be provided:
+//
+//	facade := modbus.NewFacade()
+//	cem.AddUseCase(mgcp)
+//	facade.AddUseCase(mgcp)
+//	err := facade.AddUnit(1, gridMeterSKI)
+//	go facade.ListenAndServe(":502")
+//
+// The register map is described in the package documentation.
+type Facade struct {
+	mgcp  ucmgcp.UCMGCPInterface
+	evcc  ucevcc.UCEVCCInterface
+	evcem ucevcem.UCEVCEMInterface
+	evsoc ucevsoc.UCEVSOCInterface
+	opev  ucopev.UCOPEVInterface
+	vapd  ucvapd.UCVAPDInterface
+	vabd  ucvabd.UCVABDInterface
+
+	// the configured units, the key is the unit identifier
+	units map[byte]*unitState
+
+	devices map[string]*deviceState
+
+	listener    net.Listener
+	connections map[net.Conn]struct{}
+	closed      bool
+
+	mux sync.Mutex
+}
+
+// the settings of a Modbus unit
+type unitState struct {
+	ski string
+
+	// the value of the duration holding register
+	duration uint16
+}
+
+// the connection state and known entities of a remote device
+type deviceState struct {
+	connected bool
+	entities  map[string]spineapi.EntityRemoteInterface
+}
+
+// create a new facade
+func NewFacade() *Facade {
+	return &Facade{
+		units:       make(map[byte]*unitState),
+		devices:     make(map[string]*deviceState),
+		connections: make(map[net.Conn]struct{}),
+	}
+}
+
+// provide the values of a use case
+//
+// supported are the use cases EVCC, EVCEM, EVSOC, MGCP, OPEV, VABD and VAPD, others are ignored
+func (f *Facade) AddUseCase(usecase api.UseCaseInterface) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	switch usecase.UseCaseName() {
+	case model.UseCaseNameTypeMonitoringOfGridConnectionPoint:
+		f.mgcp, _ = usecase.(ucmgcp.UCMGCPInterface)
+	case model.UseCaseNameTypeEVCommissioningAndConfiguration:
+		f.evcc, _ = usecase.(ucevcc.UCEVCCInterface)
+	case model.UseCaseNameTypeMeasurementOfElectricityDuringEVCharging:
+		f.evcem, _ = usecase.(ucevcem.UCEVCEMInterface)
+	case model.UseCaseNameTypeEVStateOfCharge:
+		f.evsoc, _ = usecase.(ucevsoc.UCEVSOCInterface)
+	case model.UseCaseNameTypeOverloadProtectionByEVChargingCurrentCurtailment:
+		f.opev, _ = usecase.(ucopev.UCOPEVInterface)
+	case model.UseCaseNameTypeVisualizationOfAggregatedPhotovoltaicData:
+		f.vapd, _ = usecase.(ucvapd.UCVAPDInterface)
+	case model.UseCaseNameTypeVisualizationOfAggregatedBatteryData:
+		f.vabd, _ = usecase.(ucvabd.UCVABDInterface)
+	}
+}
+
+// map a Modbus unit identifier to a remote device
+//
+// parameters:
+//   - unitID: the unit identifier, between 1 and 247
+//   - ski: the SKI of the remote device
+func (f *Facade) AddUnit(unitID byte, ski string) error {
+	if unitID < 1 || unitID > 247 {
+		return ErrInvalidUnit
+	}
+
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	f.units[unitID] = &unitState{ski: ski}
+
+	return nil
+}
+
+// handle CEM and use case events
+//
+// this needs to be called with all events the application receives
+func (f *Facade) HandleEvent(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	state, ok := f.devices[ski]
+	if !ok {
+		state = &deviceState{
+			entities: make(map[string]spineapi.EntityRemoteInterface),
+		}
+		f.devices[ski] = state
+	}
+
+	switch event {
+	case cem.DeviceConnected:
+		state.connected = true
+	case cem.DeviceDisconnected:
+		state.connected = false
+		state.entities = make(map[string]spineapi.EntityRemoteInterface)
+	default:
+		if entity != nil {
+			state.connected = true
+			state.entities[util.EntityAddressString(entity)] = entity
+		}
+	}
+}
+
+// return the first entity of a device compatible with a use case, nil if there is none
+func (f *Facade) entity(state *deviceState, usecase api.UseCaseInterface) spineapi.EntityRemoteInterface {
+	if state == nil || usecase == nil {
+		return nil
+	}
+
+	addresses := make([]string, 0, len(state.entities))
+	for address := range state.entities {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		entity := state.entities[address]
+		if _, err := usecase.IsUseCaseSupported(entity); !errors.Is(err, api.ErrNoCompatibleEntity) {
+			return entity
+		}
+	}
+
+	return nil
+}
+
+// handle a request PDU and return the response PDU, nil if no response should be sent
+func (f *Facade) handleRequest(unitID byte, pdu []byte) []byte {
+	if len(pdu) == 0 {
+		return nil
+	}
+	function := pdu[0]
+
+	response, err := f.handleFunction(unitID, function, pdu[1:])
+	if err != nil {
+		code := exceptionServerDeviceFailure
+		var exception *exceptionError
+		if errors.As(err, &exception) {
+			code = exception.code
+		}
+
+		return []byte{function | 0x80, code}
+	}
+
+	return append([]byte{function}, response...)
+}
+
+func (f *Facade) handleFunction(unitID, function byte, data []byte) ([]byte, error) {
+	switch function {
+	case functionReadHoldingRegisters, functionReadInputRegisters:
+		if len(data) != 4 {
+			return nil, &exceptionError{exceptionIllegalDataValue}
+		}
+		address := int(binary.BigEndian.Uint16(data))
+		quantity := int(binary.BigEndian.Uint16(data[2:]))
+		if quantity < 1 || quantity > maxReadRegisters {
+			return nil, &exceptionError{exceptionIllegalDataValue}
+		}
+
+		var registers []uint16
+		var err error
+		if function == functionReadInputRegisters {
+			registers, err = f.inputRegisters(unitID)
+		} else {
+			registers, err = f.holdingRegisters(unitID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if address+quantity > len(registers) {
+			return nil, &exceptionError{exceptionIllegalDataAddress}
+		}
+
+		response := []byte{byte(quantity * 2)}
+		for _, value := range registers[address : address+quantity] {
+			response = binary.BigEndian.AppendUint16(response, value)
+		}
+
+		return response, nil
+
+	case functionWriteSingleRegister:
+		if len(data) != 4 {
+			return nil, &exceptionError{exceptionIllegalDataValue}
+		}
+		address := int(binary.BigEndian.Uint16(data))
+		value := binary.BigEndian.Uint16(data[2:])
+
+		if err := f.writeHoldingRegisters(unitID, address, []uint16{value}); err != nil {
+			return nil, err
+		}
+
+		return data, nil
+
+	case functionWriteMultipleRegisters:
+		if len(data) < 5 {
+			return nil, &exceptionError{exceptionIllegalDataValue}
+		}
+		address := int(binary.BigEndian.Uint16(data))
+		quantity := int(binary.BigEndian.Uint16(data[2:]))
+		byteCount := int(data[4])
+		if quantity < 1 || quantity > maxWriteRegisters || byteCount != quantity*2 || len(data) != 5+byteCount {
+			return nil, &exceptionError{exceptionIllegalDataValue}
+		}
+
+		values := make([]uint16, quantity)
+		for index := range values {
+			values[index] = binary.BigEndian.Uint16(data[5+index*2:])
+		}
+
+		if err := f.writeHoldingRegisters(unitID, address, values); err != nil {
+			return nil, err
+		}
+
+		return data[:4], nil
+	}
+
+	return nil, &exceptionError{exceptionIllegalFunction}
+}
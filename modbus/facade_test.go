@@ -0,0 +1,156 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// send a read request and return the register values, or the exception code
+func (s *FacadeSuite) read(unitID, function byte, address, quantity uint16) ([]uint16, byte) {
+	request := []byte{function}
+	request = binary.BigEndian.AppendUint16(request, address)
+	request = binary.BigEndian.AppendUint16(request, quantity)
+
+	response := s.sut.handleRequest(unitID, request)
+	if response[0] == function|0x80 {
+		return nil, response[1]
+	}
+
+	assert.Equal(s.T(), function, response[0])
+	assert.Equal(s.T(), int(quantity*2), int(response[1]))
+
+	var result []uint16
+	for index := 0; index < int(quantity); index++ {
+		result = append(result, binary.BigEndian.Uint16(response[2+index*2:]))
+	}
+
+	return result, 0
+}
+
+// send a write multiple registers request and return the exception code
+func (s *FacadeSuite) write(address uint16, values ...uint16) byte {
+	request := []byte{functionWriteMultipleRegisters}
+	request = binary.BigEndian.AppendUint16(request, address)
+	request = binary.BigEndian.AppendUint16(request, uint16(len(values)))
+	request = append(request, byte(len(values)*2))
+	for _, value := range values {
+		request = binary.BigEndian.AppendUint16(request, value)
+	}
+
+	response := s.sut.handleRequest(1, request)
+	if response[0] == functionWriteMultipleRegisters|0x80 {
+		return response[1]
+	}
+
+	assert.Equal(s.T(), request[:5], response)
+	return 0
+}
+
+func int32Value(registers []uint16) int32 {
+	return int32(uint32(registers[0])<<16 | uint32(registers[1]))
+}
+
+func (s *FacadeSuite) Test_InputRegisters() {
+	registers, exception := s.read(1, functionReadInputRegisters, registerConnected, 1)
+	assert.Equal(s.T(), byte(0), exception)
+	assert.Equal(s.T(), []uint16{1}, registers)
+
+	registers, exception = s.read(1, functionReadInputRegisters, registerGrid, 22)
+	assert.Equal(s.T(), byte(0), exception)
+	assert.Equal(s.T(), int32(-1500), int32Value(registers[0:]))
+	assert.Equal(s.T(), notAvailable32, uint32(int32Value(registers[2:])))
+	assert.Equal(s.T(), int32(2147483647), int32Value(registers[4:]))
+	assert.Equal(s.T(), int32(10500), int32Value(registers[6:]))
+	assert.Equal(s.T(), int32(11000), int32Value(registers[8:]))
+	assert.Equal(s.T(), notAvailable32, uint32(int32Value(registers[10:])))
+	assert.Equal(s.T(), int32(2301), int32Value(registers[14:]))
+	assert.Equal(s.T(), int32(50010), int32Value(registers[18:]))
+	assert.Equal(s.T(), int32(700), int32Value(registers[20:]))
+
+	// the EV use cases besides OPEV are not added
+	registers, exception = s.read(1, functionReadInputRegisters, registerEV, 24)
+	assert.Equal(s.T(), byte(0), exception)
+	assert.Equal(s.T(), notAvailable16, registers[0])
+	assert.Equal(s.T(), notAvailable16, registers[1])
+	assert.Equal(s.T(), notAvailable32, uint32(int32Value(registers[2:])))
+	assert.Equal(s.T(), int32(32000), int32Value(registers[18:]))
+
+	registers, exception = s.read(1, functionReadInputRegisters, registerBattery, 8)
+	assert.Equal(s.T(), byte(0), exception)
+	assert.Equal(s.T(), notAvailable32, uint32(int32Value(registers[6:])))
+
+	s.sut.HandleEvent(remoteSki, nil, nil, cem.DeviceDisconnected)
+
+	registers, exception = s.read(1, functionReadInputRegisters, registerConnected, 1)
+	assert.Equal(s.T(), byte(0), exception)
+	assert.Equal(s.T(), []uint16{0}, registers)
+
+	registers, exception = s.read(1, functionReadInputRegisters, registerGrid, 2)
+	assert.Equal(s.T(), byte(0), exception)
+	assert.Equal(s.T(), notAvailable32, uint32(int32Value(registers)))
+}
+
+func (s *FacadeSuite) Test_Exceptions() {
+	_, exception := s.read(2, functionReadInputRegisters, 0, 1)
+	assert.Equal(s.T(), exceptionGatewayTargetMissing, exception)
+
+	_, exception = s.read(1, functionReadInputRegisters, inputRegisterCount-1, 2)
+	assert.Equal(s.T(), exceptionIllegalDataAddress, exception)
+
+	_, exception = s.read(1, functionReadInputRegisters, 0, maxReadRegisters+1)
+	assert.Equal(s.T(), exceptionIllegalDataValue, exception)
+
+	_, exception = s.read(1, 0x01, 0, 1)
+	assert.Equal(s.T(), exceptionIllegalFunction, exception)
+
+	exception = s.write(registerDuration, 1, 1)
+	assert.Equal(s.T(), exceptionIllegalDataAddress, exception)
+
+	exception = s.write(registerActive, 2)
+	assert.Equal(s.T(), exceptionIllegalDataValue, exception)
+
+	s.opev.writeErr = errors.New("write failed")
+	exception = s.write(registerLimits, 100)
+	assert.Equal(s.T(), exceptionServerDeviceFailure, exception)
+
+	assert.ErrorIs(s.T(), s.sut.AddUnit(0, remoteSki), ErrInvalidUnit)
+	assert.ErrorIs(s.T(), s.sut.AddUnit(248, remoteSki), ErrInvalidUnit)
+}
+
+func (s *FacadeSuite) Test_HoldingRegisters() {
+	registers, exception := s.read(1, functionReadHoldingRegisters, registerLimits, holdingRegisterCount)
+	assert.Equal(s.T(), byte(0), exception)
+	assert.Equal(s.T(), []uint16{320, 320, 320, 0, 0}, registers)
+
+	exception = s.write(registerLimits, 160, 161, 162, 1, 60)
+	assert.Equal(s.T(), byte(0), exception)
+
+	assert.Equal(s.T(), 3, len(s.opev.limits))
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeA, s.opev.limits[0].Phase)
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeC, s.opev.limits[2].Phase)
+	assert.Equal(s.T(), 16.1, s.opev.limits[1].Value)
+	assert.Equal(s.T(), true, s.opev.limits[1].IsActive)
+	assert.Equal(s.T(), time.Minute, s.opev.limits[1].Duration)
+
+	registers, exception = s.read(1, functionReadHoldingRegisters, registerLimits, holdingRegisterCount)
+	assert.Equal(s.T(), byte(0), exception)
+	assert.Equal(s.T(), []uint16{160, 161, 162, 1, 60}, registers)
+
+	// deactivating applies to all phases with their current values
+	response := s.sut.handleRequest(1, []byte{functionWriteSingleRegister, 0, registerActive, 0, 0})
+	assert.Equal(s.T(), []byte{functionWriteSingleRegister, 0, registerActive, 0, 0}, response)
+
+	assert.Equal(s.T(), 3, len(s.opev.limits))
+	assert.Equal(s.T(), 16.2, s.opev.limits[2].Value)
+	assert.Equal(s.T(), false, s.opev.limits[2].IsActive)
+
+	// writing only the duration does not send limits
+	s.opev.writeErr = errors.New("write failed")
+	exception = s.write(registerDuration, 0)
+	assert.Equal(s.T(), byte(0), exception)
+}
@@ -0,0 +1,339 @@
+package modbus
+
+import (
+	"math"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// the register values of the EV charge states
+var chargeStates = map[api.EVChargeStateType]uint16{
+	api.EVChargeStateTypeUnknown:   0,
+	api.EVChargeStateTypeUnplugged: 1,
+	api.EVChargeStateTypeError:     2,
+	api.EVChargeStateTypePaused:    3,
+	api.EVChargeStateTypeActive:    4,
+	api.EVChargeStateTypeFinished:  5,
+}
+
+// the phases of the per phase registers
+var phases = []model.ElectricalConnectionPhaseNameType{
+	model.ElectricalConnectionPhaseNameTypeA,
+	model.ElectricalConnectionPhaseNameTypeB,
+	model.ElectricalConnectionPhaseNameTypeC,
+}
+
+// return the unit state and the device state of a unit
+func (f *Facade) unit(unitID byte) (*unitState, *deviceState, error) {
+	unit, ok := f.units[unitID]
+	if !ok {
+		return nil, nil, &exceptionError{exceptionGatewayTargetMissing}
+	}
+
+	return unit, f.devices[unit.ski], nil
+}
+
+// return all input registers of a unit
+func (f *Facade) inputRegisters(unitID byte) ([]uint16, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	_, state, err := f.unit(unitID)
+	if err != nil {
+		return nil, err
+	}
+
+	registers := make([]uint16, inputRegisterCount)
+	if state != nil && state.connected {
+		registers[registerConnected] = 1
+	}
+
+	if entity := f.entity(state, f.mgcp); entity != nil {
+		set32(registers, registerGrid, 1, f.mgcp.Power, entity)
+		set32(registers, registerGrid+2, 1, f.mgcp.EnergyFeedIn, entity)
+		set32(registers, registerGrid+4, 1, f.mgcp.EnergyConsumed, entity)
+		setPhases(registers, registerGrid+6, 1000, f.mgcp.CurrentPerPhase, entity)
+		setPhases(registers, registerGrid+12, 10, f.mgcp.VoltagePerPhase, entity)
+		set32(registers, registerGrid+18, 1000, f.mgcp.Frequency, entity)
+		set32(registers, registerGrid+20, 10, f.mgcp.PowerLimitationFactor, entity)
+	} else {
+		setNotAvailable32(registers, registerGrid, 11)
+	}
+
+	f.evRegisters(registers, state)
+
+	if entity := f.entity(state, f.vapd); entity != nil {
+		set32(registers, registerPV, 1, f.vapd.Power, entity)
+		set32(registers, registerPV+2, 1, f.vapd.PowerNominalPeak, entity)
+		set32(registers, registerPV+4, 1, f.vapd.PVYieldTotal, entity)
+	} else {
+		setNotAvailable32(registers, registerPV, 3)
+	}
+
+	if entity := f.entity(state, f.vabd); entity != nil {
+		set32(registers, registerBattery, 1, f.vabd.Power, entity)
+		set32(registers, registerBattery+2, 1, f.vabd.EnergyCharged, entity)
+		set32(registers, registerBattery+4, 1, f.vabd.EnergyDischarged, entity)
+		set32(registers, registerBattery+6, 10, f.vabd.StateOfCharge, entity)
+	} else {
+		setNotAvailable32(registers, registerBattery, 4)
+	}
+
+	return registers, nil
+}
+
+// set the EV charging input registers
+func (f *Facade) evRegisters(registers []uint16, state *deviceState) {
+	registers[registerEV] = notAvailable16
+	if entity := f.entity(state, f.evcc); entity != nil {
+		if value, err := f.evcc.ChargeState(entity); err == nil {
+			registers[registerEV] = chargeStates[value]
+		}
+	}
+
+	registers[registerEV+1] = notAvailable16
+	if entity := f.entity(state, f.evcem); entity != nil {
+		if value, err := f.evcem.PhasesConnected(entity); err == nil {
+			registers[registerEV+1] = uint16(value)
+		}
+
+		// the details variant does not send a read request for stale values on every poll
+		setPhases(registers, registerEV+2, 1000, func(entity spineapi.EntityRemoteInterface) ([]float64, error) {
+			data, err := f.evcem.CurrentPerPhaseDetails(entity)
+			if err != nil {
+				return nil, err
+			}
+
+			var result []float64
+			for _, item := range data {
+				result = append(result, item.Value)
+			}
+
+			return result, nil
+		}, entity)
+		setPhases(registers, registerEV+8, 1, f.evcem.PowerPerPhase, entity)
+		set32(registers, registerEV+14, 1, f.evcem.EnergyCharged, entity)
+	} else {
+		setNotAvailable32(registers, registerEV+2, 7)
+	}
+
+	if entity := f.entity(state, f.evsoc); entity != nil {
+		set32(registers, registerEV+16, 10, f.evsoc.StateOfCharge, entity)
+	} else {
+		setNotAvailable32(registers, registerEV+16, 1)
+	}
+
+	if entity := f.entity(state, f.opev); entity != nil {
+		setPhases(registers, registerEV+18, 1000, f.opev.LoadControlLimits, entity)
+	} else {
+		setNotAvailable32(registers, registerEV+18, 3)
+	}
+}
+
+// return all holding registers of a unit
+func (f *Facade) holdingRegisters(unitID byte) ([]uint16, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	unit, state, err := f.unit(unitID)
+	if err != nil {
+		return nil, err
+	}
+
+	registers := []uint16{notAvailable16, notAvailable16, notAvailable16, notAvailable16, unit.duration}
+
+	entity := f.entity(state, f.opev)
+	if entity == nil {
+		return registers, nil
+	}
+
+	if limits, err := f.opev.LoadControlLimits(entity); err == nil {
+		for index, value := range limits {
+			if index < len(phases) {
+				registers[registerLimits+index] = toUint16(value * 10)
+			}
+		}
+	}
+
+	if details, err := f.opev.LoadControlLimitDetails(entity); err == nil {
+		registers[registerActive] = 0
+		for _, item := range details {
+			if item.IsActive {
+				registers[registerActive] = 1
+			}
+		}
+	}
+
+	return registers, nil
+}
+
+// write holding registers and send the limits to the EV
+func (f *Facade) writeHoldingRegisters(unitID byte, address int, values []uint16) error {
+	if address+len(values) > holdingRegisterCount {
+		return &exceptionError{exceptionIllegalDataAddress}
+	}
+
+	written := make(map[int]uint16)
+	for index, value := range values {
+		written[address+index] = value
+	}
+
+	if value, ok := written[registerActive]; ok && value > 1 {
+		return &exceptionError{exceptionIllegalDataValue}
+	}
+
+	f.mux.Lock()
+
+	unit, state, err := f.unit(unitID)
+	if err != nil {
+		f.mux.Unlock()
+		return err
+	}
+
+	if value, ok := written[registerDuration]; ok {
+		unit.duration = value
+	}
+
+	limits, err := f.limits(unit, state, written)
+
+	opev := f.opev
+	entity := f.entity(state, f.opev)
+
+	f.mux.Unlock()
+
+	if err != nil || len(limits) == 0 {
+		return err
+	}
+
+	// the write can trigger events, so the lock must not be held
+	if _, err := opev.WriteLoadControlLimits(entity, limits); err != nil {
+		return &exceptionError{exceptionServerDeviceFailure}
+	}
+
+	return nil
+}
+
+// return the limits to be written for the written holding registers, nil if no limit register was written
+func (f *Facade) limits(unit *unitState, state *deviceState, written map[int]uint16) ([]api.LoadLimitsPhase, error) {
+	active, activeWritten := written[registerActive]
+
+	var indexes []int
+	for index := range phases {
+		if _, ok := written[registerLimits+index]; ok {
+			indexes = append(indexes, index)
+		}
+	}
+	if len(indexes) == 0 && !activeWritten {
+		return nil, nil
+	}
+
+	entity := f.entity(state, f.opev)
+	if entity == nil {
+		return nil, &exceptionError{exceptionServerDeviceFailure}
+	}
+
+	current, err := f.opev.LoadControlLimits(entity)
+	if err != nil {
+		return nil, &exceptionError{exceptionServerDeviceFailure}
+	}
+
+	// only changing the active state applies to all phases with their current values
+	if len(indexes) == 0 {
+		for index := range current {
+			if index < len(phases) {
+				indexes = append(indexes, index)
+			}
+		}
+	}
+
+	var result []api.LoadLimitsPhase
+	for _, index := range indexes {
+		limit := api.LoadLimitsPhase{
+			Phase:    phases[index],
+			IsActive: !activeWritten || active == 1,
+			Duration: seconds(unit.duration),
+		}
+
+		if value, ok := written[registerLimits+index]; ok {
+			limit.Value = float64(value) / 10
+		} else if index < len(current) {
+			limit.Value = current[index]
+		}
+
+		result = append(result, limit)
+	}
+
+	return result, nil
+}
+
+// set a 32 bit register pair to a scaled value, or to not available if the value can't be read
+func set32(
+	registers []uint16,
+	address int,
+	scale float64,
+	read func(spineapi.EntityRemoteInterface) (float64, error),
+	entity spineapi.EntityRemoteInterface) {
+	value, err := read(entity)
+	if err != nil {
+		setNotAvailable32(registers, address, 1)
+		return
+	}
+
+	setInt32(registers, address, value*scale)
+}
+
+// set three 32 bit register pairs to scaled values per phase
+func setPhases(
+	registers []uint16,
+	address int,
+	scale float64,
+	read func(spineapi.EntityRemoteInterface) ([]float64, error),
+	entity spineapi.EntityRemoteInterface) {
+	setNotAvailable32(registers, address, len(phases))
+
+	values, err := read(entity)
+	if err != nil {
+		return
+	}
+
+	for index, value := range values {
+		if index < len(phases) {
+			setInt32(registers, address+index*2, value*scale)
+		}
+	}
+}
+
+// set a 32 bit register pair to a rounded value, limited to the int32 range
+func setInt32(registers []uint16, address int, value float64) {
+	value = math.Round(value)
+	value = math.Max(value, math.MinInt32+1)
+	value = math.Min(value, math.MaxInt32)
+
+	data := uint32(int32(value))
+	registers[address] = uint16(data >> 16)
+	registers[address+1] = uint16(data)
+}
+
+// set a number of 32 bit register pairs to not available
+func setNotAvailable32(registers []uint16, address, count int) {
+	for index := 0; index < count; index++ {
+		registers[address+index*2] = uint16(notAvailable32 >> 16)
+		registers[address+index*2+1] = uint16(notAvailable32 & 0xffff)
+	}
+}
+
+// return a rounded value limited to the uint16 range
+func toUint16(value float64) uint16 {
+	value = math.Round(value)
+	value = math.Max(value, 0)
+	value = math.Min(value, math.MaxUint16-1)
+
+	return uint16(value)
+}
+
+func seconds(value uint16) time.Duration {
+	return time.Duration(value) * time.Second
+}
@@ -0,0 +1,125 @@
+package modbus
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	"github.com/enbility/ship-go/logging"
+)
+
+// the size of the Modbus application protocol header
+const mbapHeaderSize = 7
+
+// the maximum size of a PDU
+const maxPDUSize = 253
+
+// connections without requests are closed after this duration
+const idleTimeout = 5 * time.Minute
+
+// listen on a TCP address and serve Modbus requests until Close is called
+func (f *Facade) ListenAndServe(address string) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	return f.Serve(listener)
+}
+
+// serve Modbus requests on a listener until Close is called
+func (f *Facade) Serve(listener net.Listener) error {
+	f.mux.Lock()
+	if f.closed {
+		f.mux.Unlock()
+		_ = listener.Close()
+		return net.ErrClosed
+	}
+	f.listener = listener
+	f.mux.Unlock()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		f.mux.Lock()
+		f.connections[conn] = struct{}{}
+		f.mux.Unlock()
+
+		go f.serveConnection(conn)
+	}
+}
+
+// close the listener and all connections
+func (f *Facade) Close() error {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	f.closed = true
+
+	for conn := range f.connections {
+		_ = conn.Close()
+	}
+
+	if f.listener == nil {
+		return nil
+	}
+
+	return f.listener.Close()
+}
+
+// handle the requests of a connection
+func (f *Facade) serveConnection(conn net.Conn) {
+	defer func() {
+		f.mux.Lock()
+		delete(f.connections, conn)
+		f.mux.Unlock()
+
+		_ = conn.Close()
+	}()
+
+	reader := bufio.NewReader(conn)
+	header := make([]byte, mbapHeaderSize)
+
+	for {
+		_ = conn.SetReadDeadline(time.Now().Add(idleTimeout))
+
+		if _, err := io.ReadFull(reader, header); err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				logging.Log().Debug("modbus:", err)
+			}
+			return
+		}
+
+		protocol := binary.BigEndian.Uint16(header[2:])
+		length := int(binary.BigEndian.Uint16(header[4:]))
+		if protocol != 0 || length < 2 || length-1 > maxPDUSize {
+			return
+		}
+
+		pdu := make([]byte, length-1)
+		if _, err := io.ReadFull(reader, pdu); err != nil {
+			return
+		}
+
+		response := f.handleRequest(header[6], pdu)
+		if response == nil {
+			continue
+		}
+
+		data := make([]byte, mbapHeaderSize, mbapHeaderSize+len(response))
+		copy(data, header[:4])
+		binary.BigEndian.PutUint16(data[4:], uint16(len(response)+1))
+		data[6] = header[6]
+		data = append(data, response...)
+
+		if _, err := conn.Write(data); err != nil {
+			return
+		}
+	}
+}
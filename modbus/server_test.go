@@ -0,0 +1,60 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *FacadeSuite) Test_Serve() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(s.T(), err)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.sut.Serve(listener)
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	assert.Nil(s.T(), err)
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(time.Second))
+
+	// transaction 0x0102, unit 1, read input registers 100-101
+	request := []byte{0x01, 0x02, 0, 0, 0, 6, 1, functionReadInputRegisters, 0, registerGrid, 0, 2}
+	_, err = conn.Write(request)
+	assert.Nil(s.T(), err)
+
+	response := make([]byte, 13)
+	_, err = io.ReadFull(conn, response)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []byte{0x01, 0x02, 0, 0, 0, 7, 1, functionReadInputRegisters, 4}, response[:9])
+	assert.Equal(s.T(), int32(-1500), int32(binary.BigEndian.Uint32(response[9:])))
+
+	// unknown unit
+	request = []byte{0x01, 0x03, 0, 0, 0, 6, 9, functionReadInputRegisters, 0, 0, 0, 1}
+	_, err = conn.Write(request)
+	assert.Nil(s.T(), err)
+
+	response = make([]byte, 9)
+	_, err = io.ReadFull(conn, response)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []byte{0x01, 0x03, 0, 0, 0, 3, 9, functionReadInputRegisters | 0x80, exceptionGatewayTargetMissing}, response)
+
+	err = s.sut.Close()
+	assert.Nil(s.T(), err)
+
+	select {
+	case err := <-done:
+		assert.NotNil(s.T(), err)
+	case <-time.After(time.Second):
+		s.T().Fatal("server not closed")
+	}
+
+	// the connection is closed
+	_, err = conn.Read(response)
+	assert.NotNil(s.T(), err)
+}
@@ -0,0 +1,158 @@
+package modbus
+
+import (
+	"testing"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucopev"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestFacadeSuite(t *testing.T) {
+	suite.Run(t, new(FacadeSuite))
+}
+
+type FacadeSuite struct {
+	suite.Suite
+
+	sut *Facade
+
+	mgcp *fakeMGCP
+	opev *fakeOPEV
+
+	gridEntity *mocks.EntityRemoteInterface
+	evEntity   *mocks.EntityRemoteInterface
+}
+
+const remoteSki string = "testremoteski"
+
+func (s *FacadeSuite) BeforeTest(suiteName, testName string) {
+	s.gridEntity = newEntity(s.T(), 1)
+	s.evEntity = newEntity(s.T(), 2)
+
+	s.mgcp = &fakeMGCP{entity: s.gridEntity}
+	s.opev = &fakeOPEV{entity: s.evEntity}
+
+	s.sut = NewFacade()
+	s.sut.AddUseCase(s.mgcp)
+	s.sut.AddUseCase(s.opev)
+	_ = s.sut.AddUnit(1, remoteSki)
+
+	s.sut.HandleEvent(remoteSki, nil, s.gridEntity, ucmgcp.DataUpdatePower)
+	s.sut.HandleEvent(remoteSki, nil, s.evEntity, ucopev.DataUpdateLimit)
+}
+
+func newEntity(t *testing.T, address model.AddressEntityType) *mocks.EntityRemoteInterface {
+	entity := mocks.NewEntityRemoteInterface(t)
+	entity.EXPECT().Address().Return(&model.EntityAddressType{
+		Entity: []model.AddressEntityType{address},
+	}).Maybe()
+
+	return entity
+}
+
+// a MGCP use case with fixed values
+type fakeMGCP struct {
+	ucmgcp.UCMGCPInterface
+
+	entity spineapi.EntityRemoteInterface
+}
+
+func (f *fakeMGCP) UseCaseName() model.UseCaseNameType {
+	return model.UseCaseNameTypeMonitoringOfGridConnectionPoint
+}
+
+func (f *fakeMGCP) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool, error) {
+	if entity != f.entity {
+		return false, api.ErrNoCompatibleEntity
+	}
+
+	return true, nil
+}
+
+func (f *fakeMGCP) Power(entity spineapi.EntityRemoteInterface) (float64, error) {
+	return -1500.4, nil
+}
+
+func (f *fakeMGCP) EnergyFeedIn(entity spineapi.EntityRemoteInterface) (float64, error) {
+	return 0, eebusapi.ErrDataNotAvailable
+}
+
+func (f *fakeMGCP) EnergyConsumed(entity spineapi.EntityRemoteInterface) (float64, error) {
+	return 1e12, nil
+}
+
+func (f *fakeMGCP) CurrentPerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error) {
+	return []float64{10.5, 11}, nil
+}
+
+func (f *fakeMGCP) VoltagePerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error) {
+	return []float64{230, 230.1, 229.9}, nil
+}
+
+func (f *fakeMGCP) Frequency(entity spineapi.EntityRemoteInterface) (float64, error) {
+	return 50.01, nil
+}
+
+func (f *fakeMGCP) PowerLimitationFactor(entity spineapi.EntityRemoteInterface) (float64, error) {
+	return 70, nil
+}
+
+// a OPEV use case recording the written limits
+type fakeOPEV struct {
+	ucopev.UCOPEVInterface
+
+	entity spineapi.EntityRemoteInterface
+
+	limits   []api.LoadLimitsPhase
+	writeErr error
+}
+
+func (f *fakeOPEV) UseCaseName() model.UseCaseNameType {
+	return model.UseCaseNameTypeOverloadProtectionByEVChargingCurrentCurtailment
+}
+
+func (f *fakeOPEV) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool, error) {
+	if entity != f.entity {
+		return false, api.ErrNoCompatibleEntity
+	}
+
+	return true, nil
+}
+
+func (f *fakeOPEV) LoadControlLimits(entity spineapi.EntityRemoteInterface) ([]float64, error) {
+	if f.limits == nil {
+		return []float64{32, 32, 32}, nil
+	}
+
+	var result []float64
+	for _, limit := range f.limits {
+		result = append(result, limit.Value)
+	}
+
+	return result, nil
+}
+
+func (f *fakeOPEV) LoadControlLimitDetails(entity spineapi.EntityRemoteInterface) ([]api.LoadLimitsPhaseDetails, error) {
+	var result []api.LoadLimitsPhaseDetails
+	for _, limit := range f.limits {
+		result = append(result, api.LoadLimitsPhaseDetails{LoadLimitsPhase: limit})
+	}
+
+	return result, nil
+}
+
+func (f *fakeOPEV) WriteLoadControlLimits(entity spineapi.EntityRemoteInterface, limits []api.LoadLimitsPhase) (*model.MsgCounterType, error) {
+	if f.writeErr != nil {
+		return nil, f.writeErr
+	}
+
+	f.limits = limits
+
+	return nil, nil
+}
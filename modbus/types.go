@@ -0,0 +1,68 @@
+package modbus
+
+import (
+	"errors"
+	"fmt"
+)
+
+// the supported function codes
+const (
+	functionReadHoldingRegisters   byte = 0x03
+	functionReadInputRegisters     byte = 0x04
+	functionWriteSingleRegister    byte = 0x06
+	functionWriteMultipleRegisters byte = 0x10
+)
+
+// the exception codes
+const (
+	exceptionIllegalFunction      byte = 0x01
+	exceptionIllegalDataAddress   byte = 0x02
+	exceptionIllegalDataValue     byte = 0x03
+	exceptionServerDeviceFailure  byte = 0x04
+	exceptionGatewayTargetMissing byte = 0x0b
+)
+
+// the maximum number of registers per read and write request
+const (
+	maxReadRegisters  = 125
+	maxWriteRegisters = 123
+)
+
+// the number of input and holding registers
+const (
+	inputRegisterCount   = 500
+	holdingRegisterCount = 5
+)
+
+// the start addresses of the input register blocks
+const (
+	registerConnected = 0
+	registerGrid      = 100
+	registerEV        = 200
+	registerPV        = 300
+	registerBattery   = 400
+)
+
+// the holding register addresses
+const (
+	registerLimits   = 0
+	registerActive   = 3
+	registerDuration = 4
+)
+
+// the values of unavailable registers
+const (
+	notAvailable16 uint16 = 0xffff
+	notAvailable32 uint32 = 0x80000000
+)
+
+// An exception response of a request
+type exceptionError struct {
+	code byte
+}
+
+func (e *exceptionError) Error() string {
+	return fmt.Sprintf("modbus exception %d", e.code)
+}
+
+var ErrInvalidUnit = errors.New("unit identifier needs to be between 1 and 247")
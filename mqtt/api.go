@@ -0,0 +1,26 @@
+package mqtt
+
+// handles a message received for a subscribed topic filter
+type MessageHandler func(message Message)
+
+// Interface of an MQTT client connection, implemented by Client and by the
+// clients of the in-process Broker
+//
+// Messages are published and received with QoS 0 or 1.
+type ClientInterface interface {
+	// publish a message
+	Publish(message Message) error
+
+	// subscribe to a topic filter, which may contain the wildcards + and #
+	//
+	// the handler is called for every message matching the filter,
+	// including retained messages. qos is the maximum quality of service
+	// the broker uses to deliver the messages, 0 or 1.
+	Subscribe(filter string, qos byte, handler MessageHandler) error
+
+	// remove the subscription of a topic filter
+	Unsubscribe(filter string) error
+
+	// close the connection
+	Close() error
+}
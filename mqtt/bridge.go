@@ -0,0 +1,399 @@
+package mqtt
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// MQTT bridge publishing the values and events of the use cases and
+// accepting commands
+//
+// The bridge needs to receive all CEM and use case events, and it needs
+// to know the use cases whose values should be published:
+//
+//	options := mqtt.BridgeOptions{Discovery: true}
+//	client, err := mqtt.Connect(mqtt.ClientOptions{Address: "localhost:1883", Will: mqtt.WillMessage(options)})
+//	bridge := mqtt.NewBridge(client, options)
+//	cem.AddUseCase(evcem)
+//	bridge.AddUseCase(evcem)
+//	err = bridge.Start()
+//
+// Topics, with entity being the address of the remote entity, e.g. "1.1":
+//   - <prefix>/bridge/availability: "online" or "offline", retained
+//   - <prefix>/<ski>/availability: "online" or "offline", retained
+//   - <prefix>/<ski>/<entity>/event: the name of each event of the entity
+//   - <prefix>/<ski>/<entity>/<usecase>/<value>: the current value, retained
+//   - <prefix>/<ski>/<entity>/<usecase>/<command>/set: the command topics, subscribed with QoS 1
+//   - <prefix>/<ski>/<entity>/<usecase>/<command>/result: the results of the commands, QoS 1
+//
+// Values are published whenever an event for the entity is received and the value changed.
+// The retained values of an entity are cleared if the entity is removed or its device
+// is disconnected.
+type Bridge struct {
+	client  ClientInterface
+	options BridgeOptions
+
+	usecases []*bridgeUseCase
+
+	deviceNameProvider func(ski string) string
+
+	devices map[string]*deviceState
+
+	// the last published payload of each value topic
+	published map[string]string
+	// the value topics discovery messages have been published for
+	discovered map[string]bool
+
+	mux sync.Mutex
+}
+
+// the known entities of a remote device
+type deviceState struct {
+	device    spineapi.DeviceRemoteInterface
+	connected bool
+	entities  map[string]spineapi.EntityRemoteInterface
+}
+
+// create a new bridge
+//
+// parameters:
+//   - client: the MQTT client connection
+//   - options: the topic settings
+func NewBridge(client ClientInterface, options BridgeOptions) *Bridge {
+	options = options.withDefaults()
+
+	return &Bridge{
+		client:     client,
+		options:    options,
+		devices:    make(map[string]*deviceState),
+		published:  make(map[string]string),
+		discovered: make(map[string]bool),
+	}
+}
+
+func (o BridgeOptions) withDefaults() BridgeOptions {
+	if o.TopicPrefix == "" {
+		o.TopicPrefix = DefaultTopicPrefix
+	}
+	if o.DiscoveryPrefix == "" {
+		o.DiscoveryPrefix = DefaultDiscoveryPrefix
+	}
+
+	return o
+}
+
+// return the will message for the client connection, which marks the bridge as offline
+func WillMessage(options BridgeOptions) *Message {
+	return &Message{
+		Topic:    bridgeAvailabilityTopic(options.withDefaults()),
+		Payload:  []byte(PayloadOffline),
+		Retained: true,
+	}
+}
+
+func bridgeAvailabilityTopic(options BridgeOptions) string {
+	return topic(options.TopicPrefix, "bridge", "availability")
+}
+
+// publish and accept commands for a use case
+//
+// supported are all use cases of this module, others are ignored
+func (b *Bridge) AddUseCase(usecase api.UseCaseInterface) {
+	bridgeUseCase := newBridgeUseCase(usecase)
+	if bridgeUseCase == nil {
+		return
+	}
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	b.usecases = append(b.usecases, bridgeUseCase)
+}
+
+// set a function which provides the name of a device, e.g. from the device registry
+//
+// the name is used in the discovery messages, if not set or if it returns an
+// empty string, the SKI is used
+func (b *Bridge) SetDeviceNameProvider(provider func(ski string) string) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	b.deviceNameProvider = provider
+}
+
+// mark the bridge as online, subscribe to the command topics and publish
+// the availability and values of all known devices
+//
+// this should also be called after the client reconnected, e.g. from
+// ClientOptions.OnReconnect, as the broker published the will message and
+// may have lost the retained messages
+func (b *Bridge) Start() error {
+	if err := b.client.Subscribe(b.commandFilter(), 1, b.handleCommand); err != nil {
+		return err
+	}
+
+	err := b.client.Publish(Message{
+		Topic:    bridgeAvailabilityTopic(b.options),
+		Payload:  []byte(PayloadOnline),
+		Retained: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	b.mux.Lock()
+	messages := b.stateMessages()
+	b.mux.Unlock()
+
+	b.publish(messages)
+
+	return nil
+}
+
+// return the availability and all values of the known devices
+//
+// the lock needs to be held
+func (b *Bridge) stateMessages() []Message {
+	// all values and discovery messages are published again
+	b.published = make(map[string]string)
+	b.discovered = make(map[string]bool)
+
+	skis := make([]string, 0, len(b.devices))
+	for ski := range b.devices {
+		skis = append(skis, ski)
+	}
+	sort.Strings(skis)
+
+	var result []Message
+	for _, ski := range skis {
+		state := b.devices[ski]
+		if !state.connected {
+			continue
+		}
+		result = append(result, b.availabilityMessage(ski, PayloadOnline))
+
+		addresses := make([]string, 0, len(state.entities))
+		for address := range state.entities {
+			addresses = append(addresses, address)
+		}
+		sort.Strings(addresses)
+
+		for _, address := range addresses {
+			result = append(result, b.valueMessages(ski, address, state.entities[address])...)
+		}
+	}
+
+	return result
+}
+
+// mark the bridge as offline and unsubscribe from the command topics
+//
+// the client connection is not closed
+func (b *Bridge) Stop() error {
+	err := b.client.Unsubscribe(b.commandFilter())
+
+	return errors.Join(err, b.client.Publish(*WillMessage(b.options)))
+}
+
+func (b *Bridge) commandFilter() string {
+	return topic(b.options.TopicPrefix, "+", "+", "+", "+", "set")
+}
+
+// handle CEM and use case events
+//
+// this needs to be called with all events the application receives
+func (b *Bridge) HandleEvent(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	b.mux.Lock()
+
+	state, ok := b.devices[ski]
+	if !ok {
+		state = &deviceState{
+			entities: make(map[string]spineapi.EntityRemoteInterface),
+		}
+		b.devices[ski] = state
+	}
+	if device != nil {
+		state.device = device
+	}
+
+	var messages []Message
+
+	switch {
+	case event == cem.DeviceConnected:
+		state.connected = true
+		messages = append(messages, b.availabilityMessage(ski, PayloadOnline))
+	case event == cem.DeviceDisconnected:
+		state.connected = false
+		state.entities = make(map[string]spineapi.EntityRemoteInterface)
+		messages = append(messages, b.availabilityMessage(ski, PayloadOffline))
+		messages = append(messages, b.clearValueMessages(topic(b.options.TopicPrefix, ski))...)
+	case entity == nil:
+		// other events without an entity are not published
+	case strings.HasSuffix(string(event), entityRemovedSuffix):
+		address := util.EntityAddressString(entity)
+		delete(state.entities, address)
+
+		messages = append(messages, Message{
+			Topic:   topic(b.options.TopicPrefix, ski, address, "event"),
+			Payload: []byte(event),
+		})
+		messages = append(messages, b.clearValueMessages(topic(b.options.TopicPrefix, ski, address))...)
+	default:
+		address := util.EntityAddressString(entity)
+		state.entities[address] = entity
+
+		messages = append(messages, Message{
+			Topic:   topic(b.options.TopicPrefix, ski, address, "event"),
+			Payload: []byte(event),
+		})
+		messages = append(messages, b.valueMessages(ski, address, entity)...)
+	}
+
+	b.mux.Unlock()
+
+	b.publish(messages)
+}
+
+func (b *Bridge) availabilityMessage(ski, payload string) Message {
+	return Message{
+		Topic:    topic(b.options.TopicPrefix, ski, "availability"),
+		Payload:  []byte(payload),
+		Retained: true,
+	}
+}
+
+// return the messages for all changed values of an entity
+func (b *Bridge) valueMessages(ski, address string, entity spineapi.EntityRemoteInterface) []Message {
+	var result []Message
+
+	for _, usecase := range b.usecases {
+		if _, err := usecase.usecase.IsUseCaseSupported(entity); errors.Is(err, api.ErrNoCompatibleEntity) {
+			continue
+		}
+
+		for _, value := range usecase.values {
+			payload, phases, err := value.read(entity)
+			if err != nil {
+				continue
+			}
+
+			valueTopic := topic(b.options.TopicPrefix, ski, address, usecase.name, value.name)
+			if last, ok := b.published[valueTopic]; ok && last == string(payload) {
+				continue
+			}
+			b.published[valueTopic] = string(payload)
+
+			if b.options.Discovery && !b.discovered[valueTopic] {
+				b.discovered[valueTopic] = true
+				result = append(result, b.discoveryMessages(ski, address, usecase, value, phases)...)
+			}
+
+			result = append(result, Message{
+				Topic:    valueTopic,
+				Payload:  payload,
+				Retained: true,
+			})
+		}
+	}
+
+	return result
+}
+
+// return the messages clearing the retained values below a topic
+//
+// the discovery messages are kept, so the values are shown as unavailable
+func (b *Bridge) clearValueMessages(prefix string) []Message {
+	var topics []string
+	for valueTopic := range b.published {
+		if strings.HasPrefix(valueTopic, prefix+"/") {
+			topics = append(topics, valueTopic)
+		}
+	}
+	sort.Strings(topics)
+
+	result := make([]Message, 0, len(topics))
+	for _, valueTopic := range topics {
+		delete(b.published, valueTopic)
+
+		// an empty retained message removes the retained message of the topic
+		result = append(result, Message{
+			Topic:    valueTopic,
+			Retained: true,
+		})
+	}
+
+	return result
+}
+
+// handle a message sent to a command topic
+func (b *Bridge) handleCommand(message Message) {
+	levels := strings.Split(strings.TrimPrefix(message.Topic, b.options.TopicPrefix+"/"), "/")
+	if len(levels) != 5 {
+		return
+	}
+	ski, address, usecaseName, command := levels[0], levels[1], levels[2], levels[3]
+
+	err := b.executeCommand(ski, address, usecaseName, command, message.Payload)
+	if err != nil {
+		logging.Log().Debug("mqtt: command", message.Topic, "failed:", err)
+	}
+
+	result := CommandResult{Success: err == nil}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	payload, _ := json.Marshal(result)
+
+	b.publish([]Message{{
+		Topic:   topic(b.options.TopicPrefix, ski, address, usecaseName, command, "result"),
+		Payload: payload,
+		QoS:     1,
+	}})
+}
+
+func (b *Bridge) executeCommand(ski, address, usecaseName, command string, payload []byte) error {
+	b.mux.Lock()
+
+	var handler commandHandler
+	for _, usecase := range b.usecases {
+		if usecase.name == usecaseName {
+			handler = usecase.commands[command]
+			break
+		}
+	}
+
+	var entity spineapi.EntityRemoteInterface
+	if state, ok := b.devices[ski]; ok {
+		entity = state.entities[address]
+	}
+
+	b.mux.Unlock()
+
+	if handler == nil {
+		return ErrUnknownCommand
+	}
+	if entity == nil {
+		return ErrUnknownEntity
+	}
+
+	// the writes can trigger events, so the lock must not be held
+	return handler(entity, payload)
+}
+
+// publish messages, errors are logged
+func (b *Bridge) publish(messages []Message) {
+	for _, message := range messages {
+		if err := b.client.Publish(message); err != nil {
+			logging.Log().Debug("mqtt: publishing", message.Topic, "failed:", err)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package mqtt
+
+import (
+	"encoding/json"
+
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/ucmgcp"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *BridgeSuite) setPowerData() {
+	descData := &model.MeasurementDescriptionListDataType{
+		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(0)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypePower),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACPowerTotal),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.smgwEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	fErr := rFeature.UpdateData(model.FunctionTypeMeasurementDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	measData := &model.MeasurementListDataType{
+		MeasurementData: []model.MeasurementDataType{
+			{
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+				Value:         model.NewScaledNumberType(1500),
+			},
+		},
+	}
+
+	fErr = rFeature.UpdateData(model.FunctionTypeMeasurementListData, measData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	elDescData := &model.ElectricalConnectionDescriptionListDataType{
+		ElectricalConnectionDescriptionData: []model.ElectricalConnectionDescriptionDataType{
+			{
+				ElectricalConnectionId:  eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				PositiveEnergyDirection: eebusutil.Ptr(model.EnergyDirectionTypeConsume),
+			},
+		},
+	}
+
+	rElFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.smgwEntity, model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionDescriptionListData, elDescData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	elParamData := &model.ElectricalConnectionParameterDescriptionListDataType{
+		ElectricalConnectionParameterDescriptionData: []model.ElectricalConnectionParameterDescriptionDataType{
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				MeasurementId:          eebusutil.Ptr(model.MeasurementIdType(0)),
+			},
+		},
+	}
+
+	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, elParamData, nil, nil)
+	assert.Nil(s.T(), fErr)
+}
+
+func (s *BridgeSuite) Test_StartStop() {
+	err := s.sut.Start()
+	assert.Nil(s.T(), err)
+
+	message, ok := s.broker.Retained("cemd/bridge/availability")
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), PayloadOnline, string(message.Payload))
+
+	err = s.sut.Stop()
+	assert.Nil(s.T(), err)
+
+	message, ok = s.broker.Retained("cemd/bridge/availability")
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), PayloadOffline, string(message.Payload))
+}
+
+func (s *BridgeSuite) Test_HandleEvent() {
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, nil, cem.DeviceConnected)
+
+	message, ok := s.broker.Retained("cemd/testremoteski/availability")
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), PayloadOnline, string(message.Payload))
+
+	// no data available yet
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.DataUpdatePower)
+	assert.Equal(s.T(), 1, len(s.messages("cemd/testremoteski/1/event")))
+	assert.Equal(s.T(), 0, len(s.messages("cemd/testremoteski/1/mgcp/power")))
+
+	s.setPowerData()
+
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.DataUpdatePower)
+	events := s.messages("cemd/testremoteski/1/event")
+	assert.Equal(s.T(), 2, len(events))
+	assert.Equal(s.T(), string(ucmgcp.DataUpdatePower), string(events[1].Payload))
+	assert.False(s.T(), events[1].Retained)
+
+	message, ok = s.broker.Retained("cemd/testremoteski/1/mgcp/power")
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "1500", string(message.Payload))
+
+	message, ok = s.broker.Retained("homeassistant/sensor/cemd_testremoteski_1_mgcp_power/config")
+	assert.True(s.T(), ok)
+	var config discoveryConfig
+	err := json.Unmarshal(message.Payload, &config)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "cemd/testremoteski/1/mgcp/power", config.StateTopic)
+	assert.Equal(s.T(), "W", config.UnitOfMeasurement)
+	assert.Equal(s.T(), "power", config.DeviceClass)
+	assert.Equal(s.T(), "testremoteski", config.Device.Name)
+	assert.Equal(s.T(), 2, len(config.Availability))
+
+	// unchanged values are not published again
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.DataUpdatePower)
+	assert.Equal(s.T(), 1, len(s.messages("cemd/testremoteski/1/mgcp/power")))
+	assert.Equal(s.T(), 1, len(s.messages("homeassistant/sensor/cemd_testremoteski_1_mgcp_power/config")))
+
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, nil, cem.DeviceDisconnected)
+
+	message, ok = s.broker.Retained("cemd/testremoteski/availability")
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), PayloadOffline, string(message.Payload))
+
+	// the values are cleared, the discovery messages are kept
+	_, ok = s.broker.Retained("cemd/testremoteski/1/mgcp/power")
+	assert.False(s.T(), ok)
+	_, ok = s.broker.Retained("homeassistant/sensor/cemd_testremoteski_1_mgcp_power/config")
+	assert.True(s.T(), ok)
+}
+
+func (s *BridgeSuite) Test_EntityRemoved() {
+	s.setPowerData()
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, nil, cem.DeviceConnected)
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.DataUpdatePower)
+
+	_, ok := s.broker.Retained("cemd/testremoteski/1/mgcp/power")
+	assert.True(s.T(), ok)
+
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.EntityRemoved)
+
+	events := s.messages("cemd/testremoteski/1/event")
+	assert.Equal(s.T(), string(ucmgcp.EntityRemoved), string(events[len(events)-1].Payload))
+	_, ok = s.broker.Retained("cemd/testremoteski/1/mgcp/power")
+	assert.False(s.T(), ok)
+
+	// the entity is unknown for commands
+	err := s.sut.executeCommand(remoteSki, "1", "opev", "load_control_limits", []byte(`[]`))
+	assert.Equal(s.T(), ErrUnknownEntity, err)
+
+	// a new value is published again
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.DataUpdatePower)
+	message, ok := s.broker.Retained("cemd/testremoteski/1/mgcp/power")
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "1500", string(message.Payload))
+}
+
+func (s *BridgeSuite) Test_Restart() {
+	s.setPowerData()
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, nil, cem.DeviceConnected)
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.DataUpdatePower)
+	assert.Equal(s.T(), 1, len(s.messages("cemd/testremoteski/1/mgcp/power")))
+
+	// after a reconnect all values are published again
+	err := s.sut.Start()
+	assert.Nil(s.T(), err)
+
+	assert.Equal(s.T(), 2, len(s.messages("cemd/testremoteski/availability")))
+	assert.Equal(s.T(), 2, len(s.messages("cemd/testremoteski/1/mgcp/power")))
+	assert.Equal(s.T(), 2, len(s.messages("homeassistant/sensor/cemd_testremoteski_1_mgcp_power/config")))
+
+	message, ok := s.broker.Retained("cemd/bridge/availability")
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), PayloadOnline, string(message.Payload))
+}
+
+func (s *BridgeSuite) Test_DeviceName() {
+	s.sut.SetDeviceNameProvider(func(ski string) string {
+		return "Grid Meter"
+	})
+
+	s.setPowerData()
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.DataUpdatePower)
+
+	message, ok := s.broker.Retained("homeassistant/sensor/cemd_testremoteski_1_mgcp_power/config")
+	assert.True(s.T(), ok)
+	var config discoveryConfig
+	err := json.Unmarshal(message.Payload, &config)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "Grid Meter", config.Device.Name)
+}
+
+func (s *BridgeSuite) Test_Commands() {
+	err := s.sut.Start()
+	assert.Nil(s.T(), err)
+
+	// the entity is not yet known
+	err = s.client.Publish(Message{
+		Topic:   "cemd/testremoteski/1/opev/load_control_limits/set",
+		Payload: []byte(`[{"phase":"a","value":16}]`),
+	})
+	assert.Nil(s.T(), err)
+	results := s.messages("cemd/testremoteski/1/opev/load_control_limits/result")
+	assert.Equal(s.T(), 1, len(results))
+	assert.Equal(s.T(), `{"success":false,"error":"unknown entity"}`, string(results[0].Payload))
+	assert.Equal(s.T(), byte(1), results[0].QoS)
+
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.DataUpdatePower)
+
+	err = s.client.Publish(Message{
+		Topic:   "cemd/testremoteski/1/opev/load_control_limits/set",
+		Payload: []byte(`[{"phase":"a","value":16,"duration":60},{"phase":"b","active":false,"value":10}]`),
+	})
+	assert.Nil(s.T(), err)
+	results = s.messages("cemd/testremoteski/1/opev/load_control_limits/result")
+	assert.Equal(s.T(), 2, len(results))
+	assert.Equal(s.T(), `{"success":true}`, string(results[1].Payload))
+
+	assert.Equal(s.T(), 2, len(s.opev.limits))
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeA, s.opev.limits[0].Phase)
+	assert.True(s.T(), s.opev.limits[0].IsActive)
+	assert.Equal(s.T(), 16.0, s.opev.limits[0].Value)
+	assert.Equal(s.T(), 60.0, s.opev.limits[0].Duration.Seconds())
+	assert.False(s.T(), s.opev.limits[1].IsActive)
+
+	// the new limits are published with the next event
+	s.sut.HandleEvent(remoteSki, s.remoteDevice, s.smgwEntity, ucmgcp.DataUpdatePower)
+	message, ok := s.broker.Retained("cemd/testremoteski/1/opev/load_control_limits")
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "[16,10]", string(message.Payload))
+	_, ok = s.broker.Retained("homeassistant/sensor/cemd_testremoteski_1_opev_load_control_limits_l2/config")
+	assert.True(s.T(), ok)
+
+	err = s.client.Publish(Message{
+		Topic:   "cemd/testremoteski/1/opev/load_control_limits/set",
+		Payload: []byte(`invalid`),
+	})
+	assert.Nil(s.T(), err)
+	results = s.messages("cemd/testremoteski/1/opev/load_control_limits/result")
+	assert.Equal(s.T(), 3, len(results))
+	assert.Contains(s.T(), string(results[2].Payload), `"success":false`)
+
+	err = s.client.Publish(Message{
+		Topic:   "cemd/testremoteski/1/mgcp/power/set",
+		Payload: []byte(`1`),
+	})
+	assert.Nil(s.T(), err)
+	results = s.messages("cemd/testremoteski/1/mgcp/power/result")
+	assert.Equal(s.T(), 1, len(results))
+	assert.Equal(s.T(), `{"success":false,"error":"unknown command"}`, string(results[0].Payload))
+}
@@ -0,0 +1,213 @@
+package mqtt
+
+import (
+	"sync"
+)
+
+// An in-process MQTT broker, e.g. to embed the bridge into an application
+// without a network broker or for testing
+//
+//	broker := mqtt.NewBroker()
+//	bridge := mqtt.NewBridge(broker.NewClient(nil), mqtt.BridgeOptions{})
+//
+// Messages are delivered synchronously to the subscribers, so the quality of
+// service is ignored.
+type Broker struct {
+	clients  []*brokerClient
+	retained map[string]Message
+
+	mux sync.Mutex
+}
+
+// create a new in-process broker
+func NewBroker() *Broker {
+	return &Broker{
+		retained: make(map[string]Message),
+	}
+}
+
+// create a new client connected to the broker
+//
+// parameters:
+//   - will: the message published if the client is dropped, optional
+func (b *Broker) NewClient(will *Message) ClientInterface {
+	client := &brokerClient{
+		broker:        b,
+		will:          will,
+		subscriptions: make(map[string]MessageHandler),
+	}
+
+	b.mux.Lock()
+	b.clients = append(b.clients, client)
+	b.mux.Unlock()
+
+	return client
+}
+
+// return the retained message of a topic
+func (b *Broker) Retained(topic string) (Message, bool) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	message, ok := b.retained[topic]
+	return message, ok
+}
+
+// close a client as if its connection was lost and publish its will message
+func (b *Broker) Drop(client ClientInterface) {
+	item, ok := client.(*brokerClient)
+	if !ok || item.broker != b || !item.close() {
+		return
+	}
+
+	if item.will != nil {
+		b.publish(*item.will)
+	}
+}
+
+// publish a message to all subscribers
+func (b *Broker) publish(message Message) {
+	b.mux.Lock()
+
+	if message.Retained {
+		if len(message.Payload) == 0 {
+			delete(b.retained, message.Topic)
+		} else {
+			b.retained[message.Topic] = message
+		}
+	}
+
+	var handlers []MessageHandler
+	for _, client := range b.clients {
+		handlers = append(handlers, client.matchingHandlers(message.Topic)...)
+	}
+
+	b.mux.Unlock()
+
+	for _, handler := range handlers {
+		handler(message)
+	}
+}
+
+// return the retained messages matching a topic filter
+func (b *Broker) retainedMessages(filter string) []Message {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	var result []Message
+	for topic, message := range b.retained {
+		if topicMatches(filter, topic) {
+			result = append(result, message)
+		}
+	}
+
+	return result
+}
+
+// remove a client
+func (b *Broker) remove(client *brokerClient) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	for index, item := range b.clients {
+		if item == client {
+			b.clients = append(b.clients[:index], b.clients[index+1:]...)
+			return
+		}
+	}
+}
+
+// A client of the in-process broker
+type brokerClient struct {
+	broker *Broker
+	will   *Message
+
+	subscriptions map[string]MessageHandler
+	closed        bool
+
+	mux sync.Mutex
+}
+
+var _ ClientInterface = (*brokerClient)(nil)
+
+func (c *brokerClient) matchingHandlers(topic string) []MessageHandler {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	var result []MessageHandler
+	for filter, handler := range c.subscriptions {
+		if topicMatches(filter, topic) {
+			result = append(result, handler)
+		}
+	}
+
+	return result
+}
+
+func (c *brokerClient) isClosed() bool {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.closed
+}
+
+func (c *brokerClient) Publish(message Message) error {
+	if c.isClosed() {
+		return ErrNotConnected
+	}
+
+	c.broker.publish(message)
+
+	return nil
+}
+
+func (c *brokerClient) Subscribe(filter string, qos byte, handler MessageHandler) error {
+	c.mux.Lock()
+	if c.closed {
+		c.mux.Unlock()
+		return ErrNotConnected
+	}
+	c.subscriptions[filter] = handler
+	c.mux.Unlock()
+
+	for _, message := range c.broker.retainedMessages(filter) {
+		handler(message)
+	}
+
+	return nil
+}
+
+func (c *brokerClient) Unsubscribe(filter string) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if c.closed {
+		return ErrNotConnected
+	}
+
+	delete(c.subscriptions, filter)
+
+	return nil
+}
+
+// close the client, like a disconnect the will message is not published
+func (c *brokerClient) Close() error {
+	c.close()
+
+	return nil
+}
+
+// close the client and return if it was open
+func (c *brokerClient) close() bool {
+	c.mux.Lock()
+	if c.closed {
+		c.mux.Unlock()
+		return false
+	}
+	c.closed = true
+	c.mux.Unlock()
+
+	c.broker.remove(c)
+
+	return true
+}
@@ -0,0 +1,85 @@
+package mqtt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopicMatches(t *testing.T) {
+	tests := []struct {
+		filter string
+		topic  string
+		match  bool
+	}{
+		{"cemd/ski/1.1/evcem/power_per_phase", "cemd/ski/1.1/evcem/power_per_phase", true},
+		{"cemd/+/+/+/+/set", "cemd/ski/1.1/opev/load_control_limits/set", true},
+		{"cemd/+/+/+/+/set", "cemd/ski/1.1/opev/load_control_limits", false},
+		{"cemd/+/+/+/+/set", "cemd/ski/1.1/opev/load_control_limits/set/x", false},
+		{"cemd/#", "cemd/ski/availability", true},
+		{"cemd/#", "cemd", true},
+		{"#", "$SYS/broker", false},
+		{"cemd/+", "cemd/ski/availability", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.match, topicMatches(test.filter, test.topic), test.filter+" "+test.topic)
+	}
+}
+
+func TestBroker(t *testing.T) {
+	broker := NewBroker()
+	will := &Message{Topic: "test/availability", Payload: []byte("offline"), Retained: true}
+	publisher := broker.NewClient(will)
+	subscriber := broker.NewClient(nil)
+
+	var received []Message
+	err := subscriber.Subscribe("test/+", 0, func(message Message) {
+		received = append(received, message)
+	})
+	assert.Nil(t, err)
+
+	err = publisher.Publish(Message{Topic: "test/value", Payload: []byte("1"), Retained: true})
+	assert.Nil(t, err)
+	err = publisher.Publish(Message{Topic: "other/value", Payload: []byte("2")})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(received))
+
+	message, ok := broker.Retained("test/value")
+	assert.True(t, ok)
+	assert.Equal(t, "1", string(message.Payload))
+
+	// retained messages are delivered on subscribe
+	late := broker.NewClient(nil)
+	var lateReceived []Message
+	err = late.Subscribe("test/#", 0, func(message Message) {
+		lateReceived = append(lateReceived, message)
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(lateReceived))
+
+	// an empty retained message deletes the retained message
+	err = publisher.Publish(Message{Topic: "test/value", Retained: true})
+	assert.Nil(t, err)
+	_, ok = broker.Retained("test/value")
+	assert.False(t, ok)
+
+	err = subscriber.Unsubscribe("test/+")
+	assert.Nil(t, err)
+
+	broker.Drop(publisher)
+	assert.Equal(t, 2, len(received))
+	message, ok = broker.Retained("test/availability")
+	assert.True(t, ok)
+	assert.Equal(t, "offline", string(message.Payload))
+
+	err = publisher.Publish(Message{Topic: "test/value", Payload: []byte("1")})
+	assert.Equal(t, ErrNotConnected, err)
+
+	// closing does not publish the will
+	closing := broker.NewClient(&Message{Topic: "test/closed", Payload: []byte("offline"), Retained: true})
+	err = closing.Close()
+	assert.Nil(t, err)
+	_, ok = broker.Retained("test/closed")
+	assert.False(t, ok)
+}
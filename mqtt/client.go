@@ -0,0 +1,479 @@
+package mqtt
+
+import (
+	"bufio"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/enbility/ship-go/logging"
+)
+
+// A minimal MQTT 3.1.1 client supporting QoS 0 and 1
+//
+// With AutoReconnect the client reconnects after the connection was lost,
+// renews all subscriptions and sends unacknowledged QoS 1 messages again.
+// Sessions are always clean, so messages published to subscribed topics
+// while the client is disconnected are not delivered.
+//
+// Received messages are acknowledged before they are passed to the
+// subscription handlers, which are called in order in a separate goroutine.
+type Client struct {
+	options ClientOptions
+
+	// the current connection, nil while disconnected
+	conn net.Conn
+
+	subscriptions map[string]subscription
+
+	// the QoS 1 messages which were not yet acknowledged, the key is the packet identifier
+	inflight map[uint16]Message
+	packetID uint16
+
+	// the received messages which are passed to the subscription handlers
+	received chan Message
+
+	closed bool
+	done   chan struct{}
+
+	writeMux sync.Mutex
+	mux      sync.Mutex
+}
+
+// a topic filter subscription
+type subscription struct {
+	qos     byte
+	handler MessageHandler
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// the wait time before the first reconnect attempt, doubled with each failed attempt
+var reconnectInterval = time.Second
+
+// the maximum number of unacknowledged QoS 1 messages
+const maxInflight = 1000
+
+// the maximum number of received messages waiting for their handlers
+const maxReceived = 1000
+
+// connect to an MQTT broker
+//
+// an error is returned if the first connection attempt fails
+func Connect(options ClientOptions) (*Client, error) {
+	if options.KeepAlive <= 0 {
+		options.KeepAlive = DefaultKeepAlive
+	}
+	if options.PingTimeout <= 0 {
+		options.PingTimeout = DefaultPingTimeout
+	}
+	if options.MaxReconnectInterval <= 0 {
+		options.MaxReconnectInterval = DefaultMaxReconnectInterval
+	}
+
+	client := &Client{
+		options:       options,
+		subscriptions: make(map[string]subscription),
+		inflight:      make(map[uint16]Message),
+		received:      make(chan Message, maxReceived),
+		done:          make(chan struct{}),
+	}
+
+	conn, reader, err := client.dial()
+	if err != nil {
+		return nil, err
+	}
+
+	client.conn = conn
+	go client.run(conn, reader)
+	go client.dispatch()
+
+	return client, nil
+}
+
+// open a connection, send the CONNECT packet and wait for the CONNACK
+func (c *Client) dial() (net.Conn, *bufio.Reader, error) {
+	dialer := &net.Dialer{Timeout: DefaultConnectTimeout}
+
+	var conn net.Conn
+	var err error
+	if c.options.TLSConfig != nil {
+		conn, err = tls.DialWithDialer(dialer, "tcp", c.options.Address, c.options.TLSConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", c.options.Address)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+	if err := c.connect(conn, reader); err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+
+	return conn, reader, nil
+}
+
+// send the CONNECT packet and wait for the CONNACK
+func (c *Client) connect(conn net.Conn, reader *bufio.Reader) error {
+	_ = conn.SetDeadline(time.Now().Add(DefaultConnectTimeout))
+	defer func() { _ = conn.SetDeadline(time.Time{}) }()
+
+	if _, err := conn.Write(encodeConnect(c.options)); err != nil {
+		return err
+	}
+
+	p, err := readPacket(reader)
+	if err != nil {
+		return err
+	}
+
+	if p.packetType != packetConnAck || len(p.body) != 2 {
+		return errMalformedPacket
+	}
+
+	if p.body[1] != 0 {
+		return fmt.Errorf("%w: return code %d", ErrConnectRefused, p.body[1])
+	}
+
+	return nil
+}
+
+// serve connections until the client is closed, or until the connection
+// is lost if AutoReconnect is disabled
+func (c *Client) run(conn net.Conn, reader *bufio.Reader) {
+	for {
+		err := c.serve(conn, reader)
+
+		c.mux.Lock()
+		c.conn = nil
+		closed := c.closed
+		if !closed && !c.options.AutoReconnect {
+			c.closed = true
+			close(c.done)
+		}
+		c.mux.Unlock()
+
+		_ = conn.Close()
+
+		if closed {
+			return
+		}
+
+		if c.options.OnConnectionLost != nil {
+			c.options.OnConnectionLost(err)
+		}
+
+		if !c.options.AutoReconnect {
+			return
+		}
+
+		if conn, reader = c.reconnect(); conn == nil {
+			return
+		}
+
+		if c.options.OnReconnect != nil {
+			c.options.OnReconnect()
+		}
+	}
+}
+
+// read and handle packets until the connection fails
+func (c *Client) serve(conn net.Conn, reader *bufio.Reader) error {
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go c.keepAlive(stop)
+
+	// pings are sent in the keep alive interval, so if nothing is received
+	// in this time plus the ping timeout, the broker did not answer a ping
+	timeout := c.options.KeepAlive + c.options.PingTimeout
+
+	for {
+		_ = conn.SetReadDeadline(time.Now().Add(timeout))
+
+		p, err := readPacket(reader)
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return ErrPingTimeout
+		}
+		if err != nil {
+			return err
+		}
+
+		c.handlePacket(p)
+	}
+}
+
+// handle a received packet
+func (c *Client) handlePacket(p *packet) {
+	switch p.packetType {
+	case packetPublish:
+		message, packetID, err := decodePublish(p)
+		if err != nil {
+			logging.Log().Debug("mqtt:", err)
+			return
+		}
+
+		// QoS 2 is never requested in the subscriptions
+		if message.QoS == 1 {
+			if err := c.write(encodePubAck(packetID)); err != nil {
+				logging.Log().Debug("mqtt:", err)
+			}
+		}
+
+		// the handlers run in their own goroutine, so a slow handler, e.g. one
+		// writing to a remote device, does not stop reading the ping responses
+		select {
+		case c.received <- message:
+		default:
+			logging.Log().Error("mqtt: too many unhandled messages, dropping message on", message.Topic)
+		}
+
+	case packetPubAck:
+		if packetID, err := decodePacketID(p); err == nil {
+			c.mux.Lock()
+			delete(c.inflight, packetID)
+			c.mux.Unlock()
+		}
+
+	case packetSubAck:
+		// the return code 0x80 reports a rejected subscription
+		if len(p.body) > 2 && p.body[2] == 0x80 {
+			logging.Log().Error("mqtt: subscription rejected by broker")
+		}
+	}
+}
+
+// pass the received messages to the matching subscription handlers in order
+// until the client is closed
+func (c *Client) dispatch() {
+	for {
+		select {
+		case <-c.done:
+			return
+		case message := <-c.received:
+			for _, handler := range c.matchingHandlers(message.Topic) {
+				handler(message)
+			}
+		}
+	}
+}
+
+// connect again until it succeeds or the client is closed, and renew the
+// subscriptions and unacknowledged messages
+//
+// returns nil if the client was closed
+func (c *Client) reconnect() (net.Conn, *bufio.Reader) {
+	interval := reconnectInterval
+
+	for {
+		select {
+		case <-c.done:
+			return nil, nil
+		case <-time.After(interval):
+		}
+
+		conn, reader, err := c.dial()
+		if err != nil {
+			logging.Log().Debug("mqtt: reconnect failed:", err)
+
+			interval = min(interval*2, c.options.MaxReconnectInterval)
+			continue
+		}
+
+		c.mux.Lock()
+		if c.closed {
+			c.mux.Unlock()
+			_ = conn.Close()
+			return nil, nil
+		}
+		c.conn = conn
+
+		var packets [][]byte
+		for filter, item := range c.subscriptions {
+			c.packetID = c.nextPacketIDLocked()
+			packets = append(packets, encodeSubscribe(c.packetID, filter, item.qos))
+		}
+		for packetID, message := range c.inflight {
+			packets = append(packets, encodePublish(message, packetID, true))
+		}
+		c.mux.Unlock()
+
+		for _, data := range packets {
+			if err := c.write(data); err != nil {
+				logging.Log().Debug("mqtt:", err)
+			}
+		}
+
+		return conn, reader
+	}
+}
+
+// send pings in the keep alive interval until stop is closed
+func (c *Client) keepAlive(stop chan struct{}) {
+	ticker := time.NewTicker(c.options.KeepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := c.write(encodePacket(packetPingReq, 0, nil)); err != nil {
+				logging.Log().Debug("mqtt:", err)
+			}
+		}
+	}
+}
+
+// return the handlers of all subscriptions matching a topic
+func (c *Client) matchingHandlers(topic string) []MessageHandler {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	var result []MessageHandler
+	for filter, item := range c.subscriptions {
+		if topicMatches(filter, topic) {
+			result = append(result, item.handler)
+		}
+	}
+
+	return result
+}
+
+// write a packet to the connection
+func (c *Client) write(data []byte) error {
+	c.mux.Lock()
+	conn := c.conn
+	c.mux.Unlock()
+
+	if conn == nil {
+		return ErrNotConnected
+	}
+
+	c.writeMux.Lock()
+	defer c.writeMux.Unlock()
+
+	_, err := conn.Write(data)
+	return err
+}
+
+// return the next packet identifier which is not used by an unacknowledged message
+//
+// the lock needs to be held
+func (c *Client) nextPacketIDLocked() uint16 {
+	id := c.packetID
+	for {
+		id++
+		if id == 0 {
+			id = 1
+		}
+		if _, ok := c.inflight[id]; !ok {
+			return id
+		}
+	}
+}
+
+// return the next packet identifier
+func (c *Client) nextPacketID() uint16 {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.packetID = c.nextPacketIDLocked()
+
+	return c.packetID
+}
+
+// publish a message
+//
+// QoS 1 messages are kept until the broker acknowledged them. With
+// AutoReconnect they are also accepted while the client is disconnected,
+// and sent after the next reconnect.
+func (c *Client) Publish(message Message) error {
+	if message.QoS == 0 {
+		return c.write(encodePublish(message, 0, false))
+	}
+	message.QoS = 1
+
+	c.mux.Lock()
+	if c.closed || (c.conn == nil && !c.options.AutoReconnect) {
+		c.mux.Unlock()
+		return ErrNotConnected
+	}
+	if len(c.inflight) >= maxInflight {
+		c.mux.Unlock()
+		return ErrInflightLimit
+	}
+	c.packetID = c.nextPacketIDLocked()
+	packetID := c.packetID
+	c.inflight[packetID] = message
+	c.mux.Unlock()
+
+	if err := c.write(encodePublish(message, packetID, false)); err != nil && !c.options.AutoReconnect {
+		c.mux.Lock()
+		delete(c.inflight, packetID)
+		c.mux.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+// subscribe to a topic filter
+//
+// the subscription is renewed after each reconnect
+func (c *Client) Subscribe(filter string, qos byte, handler MessageHandler) error {
+	qos = min(qos, 1)
+
+	c.mux.Lock()
+	if c.closed {
+		c.mux.Unlock()
+		return ErrNotConnected
+	}
+	c.subscriptions[filter] = subscription{qos: qos, handler: handler}
+	c.mux.Unlock()
+
+	err := c.write(encodeSubscribe(c.nextPacketID(), filter, qos))
+	if err != nil && c.options.AutoReconnect {
+		// the subscription is sent with the next reconnect
+		return nil
+	}
+
+	return err
+}
+
+func (c *Client) Unsubscribe(filter string) error {
+	c.mux.Lock()
+	delete(c.subscriptions, filter)
+	c.mux.Unlock()
+
+	return c.write(encodeUnsubscribe(c.nextPacketID(), filter))
+}
+
+func (c *Client) Close() error {
+	// a DISCONNECT prevents the broker from publishing the will message
+	_ = c.write(encodePacket(packetDisconnect, 0, nil))
+
+	c.mux.Lock()
+	if c.closed {
+		c.mux.Unlock()
+		return nil
+	}
+	c.closed = true
+	close(c.done)
+	conn := c.conn
+	c.conn = nil
+	c.mux.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+
+	return conn.Close()
+}
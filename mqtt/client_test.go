@@ -0,0 +1,500 @@
+package mqtt
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/enbility/ship-go/cert"
+	"github.com/stretchr/testify/assert"
+)
+
+// a fake broker accepting connections, it only answers CONNECT packets
+type fakeServer struct {
+	listener net.Listener
+
+	packets chan *packet
+	conn    chan net.Conn
+}
+
+func newFakeServer(t *testing.T, returnCode byte) *fakeServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	return startFakeServer(listener, returnCode)
+}
+
+func startFakeServer(listener net.Listener, returnCode byte) *fakeServer {
+	server := &fakeServer{
+		listener: listener,
+		packets:  make(chan *packet, 100),
+		conn:     make(chan net.Conn, 10),
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go server.serve(conn, returnCode)
+		}
+	}()
+
+	return server
+}
+
+func (f *fakeServer) serve(conn net.Conn, returnCode byte) {
+	reader := bufio.NewReader(conn)
+	for {
+		p, err := readPacket(reader)
+		if err != nil {
+			return
+		}
+
+		if p.packetType == packetConnect {
+			_, _ = conn.Write(encodePacket(packetConnAck, 0, []byte{0, returnCode}))
+			f.conn <- conn
+		}
+
+		f.packets <- p
+	}
+}
+
+func (f *fakeServer) next(t *testing.T) *packet {
+	select {
+	case p := <-f.packets:
+		return p
+	case <-time.After(time.Second):
+		t.Fatal("no packet received")
+		return nil
+	}
+}
+
+func (f *fakeServer) nextConn(t *testing.T) net.Conn {
+	select {
+	case conn := <-f.conn:
+		return conn
+	case <-time.After(time.Second):
+		t.Fatal("no connection received")
+		return nil
+	}
+}
+
+func TestClient(t *testing.T) {
+	server := newFakeServer(t, 0)
+	defer server.listener.Close()
+
+	will := WillMessage(BridgeOptions{})
+	client, err := Connect(ClientOptions{
+		Address:  server.listener.Addr().String(),
+		ClientID: "cemd",
+		Username: "user",
+		Password: "secret",
+		Will:     will,
+	})
+	assert.Nil(t, err)
+
+	p := server.next(t)
+	assert.Equal(t, packetConnect, p.packetType)
+	protocol, rest, err := readString(p.body)
+	assert.Nil(t, err)
+	assert.Equal(t, "MQTT", protocol)
+	// clean session, will, will retain, username and password
+	assert.Equal(t, byte(0xe6), rest[1])
+	clientID, rest, _ := readString(rest[4:])
+	assert.Equal(t, "cemd", clientID)
+	willTopic, _, _ := readString(rest)
+	assert.Equal(t, "cemd/bridge/availability", willTopic)
+
+	err = client.Publish(Message{Topic: "cemd/value", Payload: []byte("1"), Retained: true})
+	assert.Nil(t, err)
+
+	p = server.next(t)
+	assert.Equal(t, packetPublish, p.packetType)
+	message, _, err := decodePublish(p)
+	assert.Nil(t, err)
+	assert.Equal(t, Message{Topic: "cemd/value", Payload: []byte("1"), Retained: true}, message)
+
+	received := make(chan Message, 1)
+	err = client.Subscribe("cemd/+/set", 0, func(message Message) {
+		received <- message
+	})
+	assert.Nil(t, err)
+
+	p = server.next(t)
+	assert.Equal(t, packetSubscribe, p.packetType)
+	filter, rest, _ := readString(p.body[2:])
+	assert.Equal(t, "cemd/+/set", filter)
+	assert.Equal(t, []byte{0}, rest)
+
+	conn := server.nextConn(t)
+	_, err = conn.Write(encodePublish(Message{Topic: "cemd/limit/set", Payload: []byte("16")}, 0, false))
+	assert.Nil(t, err)
+
+	select {
+	case message := <-received:
+		assert.Equal(t, "16", string(message.Payload))
+	case <-time.After(time.Second):
+		t.Fatal("no message received")
+	}
+
+	err = client.Unsubscribe("cemd/+/set")
+	assert.Nil(t, err)
+	p = server.next(t)
+	assert.Equal(t, packetUnsubscribe, p.packetType)
+
+	err = client.Close()
+	assert.Nil(t, err)
+	p = server.next(t)
+	assert.Equal(t, packetDisconnect, p.packetType)
+
+	err = client.Publish(Message{Topic: "cemd/value"})
+	assert.Equal(t, ErrNotConnected, err)
+}
+
+func TestClientRefused(t *testing.T) {
+	server := newFakeServer(t, 5)
+	defer server.listener.Close()
+
+	_, err := Connect(ClientOptions{Address: server.listener.Addr().String()})
+	assert.ErrorIs(t, err, ErrConnectRefused)
+}
+
+func TestClientConnectionLost(t *testing.T) {
+	server := newFakeServer(t, 0)
+	defer server.listener.Close()
+
+	lost := make(chan error, 1)
+	client, err := Connect(ClientOptions{
+		Address: server.listener.Addr().String(),
+		OnConnectionLost: func(err error) {
+			lost <- err
+		},
+	})
+	assert.Nil(t, err)
+
+	conn := server.nextConn(t)
+	_ = conn.Close()
+
+	select {
+	case err := <-lost:
+		assert.NotNil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("connection loss not reported")
+	}
+
+	err = client.Publish(Message{Topic: "cemd/value"})
+	assert.Equal(t, ErrNotConnected, err)
+	assert.Nil(t, client.Close())
+}
+
+func TestClientQoS1(t *testing.T) {
+	server := newFakeServer(t, 0)
+	defer server.listener.Close()
+
+	client, err := Connect(ClientOptions{Address: server.listener.Addr().String()})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	assert.Equal(t, packetConnect, server.next(t).packetType)
+	conn := server.nextConn(t)
+
+	err = client.Publish(Message{Topic: "cemd/result", Payload: []byte("{}"), QoS: 1})
+	assert.Nil(t, err)
+
+	p := server.next(t)
+	assert.Equal(t, packetPublish, p.packetType)
+	message, packetID, err := decodePublish(p)
+	assert.Nil(t, err)
+	assert.Equal(t, byte(1), message.QoS)
+	assert.NotEqual(t, uint16(0), packetID)
+	client.mux.Lock()
+	assert.Equal(t, 1, len(client.inflight))
+	client.mux.Unlock()
+
+	// the message is kept until it is acknowledged
+	_, err = conn.Write(encodePubAck(packetID))
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool {
+		client.mux.Lock()
+		defer client.mux.Unlock()
+		return len(client.inflight) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	received := make(chan Message, 1)
+	err = client.Subscribe("cemd/+/set", 1, func(message Message) {
+		received <- message
+	})
+	assert.Nil(t, err)
+
+	p = server.next(t)
+	assert.Equal(t, packetSubscribe, p.packetType)
+	_, rest, _ := readString(p.body[2:])
+	assert.Equal(t, []byte{1}, rest)
+
+	// received QoS 1 messages are acknowledged
+	_, err = conn.Write(encodePublish(Message{Topic: "cemd/limit/set", Payload: []byte("16"), QoS: 1}, 7, false))
+	assert.Nil(t, err)
+
+	select {
+	case message := <-received:
+		assert.Equal(t, "16", string(message.Payload))
+	case <-time.After(time.Second):
+		t.Fatal("no message received")
+	}
+
+	p = server.next(t)
+	assert.Equal(t, packetPubAck, p.packetType)
+	packetID, err = decodePacketID(p)
+	assert.Nil(t, err)
+	assert.Equal(t, uint16(7), packetID)
+}
+
+func TestClientReconnect(t *testing.T) {
+	interval := reconnectInterval
+	reconnectInterval = 10 * time.Millisecond
+	defer func() { reconnectInterval = interval }()
+
+	server := newFakeServer(t, 0)
+	defer server.listener.Close()
+
+	lost := make(chan error, 1)
+	reconnected := make(chan bool, 1)
+	client, err := Connect(ClientOptions{
+		Address:       server.listener.Addr().String(),
+		AutoReconnect: true,
+		OnConnectionLost: func(err error) {
+			lost <- err
+		},
+		OnReconnect: func() {
+			reconnected <- true
+		},
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	assert.Equal(t, packetConnect, server.next(t).packetType)
+	conn := server.nextConn(t)
+
+	err = client.Subscribe("cemd/+/set", 1, func(message Message) {})
+	assert.Nil(t, err)
+	assert.Equal(t, packetSubscribe, server.next(t).packetType)
+
+	err = client.Publish(Message{Topic: "cemd/result", Payload: []byte("{}"), QoS: 1})
+	assert.Nil(t, err)
+	p := server.next(t)
+	_, packetID, _ := decodePublish(p)
+
+	_ = conn.Close()
+
+	select {
+	case err := <-lost:
+		assert.NotNil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("connection loss not reported")
+	}
+
+	// the subscriptions are renewed and the unacknowledged message is sent again
+	assert.Equal(t, packetConnect, server.next(t).packetType)
+
+	p = server.next(t)
+	assert.Equal(t, packetSubscribe, p.packetType)
+	filter, rest, _ := readString(p.body[2:])
+	assert.Equal(t, "cemd/+/set", filter)
+	assert.Equal(t, []byte{1}, rest)
+
+	p = server.next(t)
+	assert.Equal(t, packetPublish, p.packetType)
+	assert.Equal(t, byte(0x08), p.flags&0x08)
+	message, resentID, _ := decodePublish(p)
+	assert.Equal(t, packetID, resentID)
+	assert.Equal(t, "cemd/result", message.Topic)
+
+	select {
+	case <-reconnected:
+	case <-time.After(time.Second):
+		t.Fatal("reconnect not reported")
+	}
+
+	err = client.Publish(Message{Topic: "cemd/value"})
+	assert.Nil(t, err)
+	assert.Equal(t, packetPublish, server.next(t).packetType)
+}
+
+func TestClientPingTimeout(t *testing.T) {
+	server := newFakeServer(t, 0)
+	defer server.listener.Close()
+
+	lost := make(chan error, 1)
+	client, err := Connect(ClientOptions{
+		Address:     server.listener.Addr().String(),
+		KeepAlive:   20 * time.Millisecond,
+		PingTimeout: 20 * time.Millisecond,
+		OnConnectionLost: func(err error) {
+			lost <- err
+		},
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	assert.Equal(t, packetConnect, server.next(t).packetType)
+
+	// the fake server does not answer the ping
+	assert.Equal(t, packetPingReq, server.next(t).packetType)
+
+	select {
+	case err := <-lost:
+		assert.ErrorIs(t, err, ErrPingTimeout)
+	case <-time.After(time.Second):
+		t.Fatal("ping timeout not detected")
+	}
+}
+
+func TestClientBlockingHandler(t *testing.T) {
+	server := newFakeServer(t, 0)
+	defer server.listener.Close()
+
+	lost := make(chan error, 1)
+	client, err := Connect(ClientOptions{
+		Address:     server.listener.Addr().String(),
+		KeepAlive:   20 * time.Millisecond,
+		PingTimeout: 50 * time.Millisecond,
+		OnConnectionLost: func(err error) {
+			lost <- err
+		},
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	assert.Equal(t, packetConnect, server.next(t).packetType)
+	conn := server.nextConn(t)
+
+	// the handler blocks until the test ends
+	release := make(chan struct{})
+	defer close(release)
+	received := make(chan Message, 2)
+	err = client.Subscribe("cemd/+/set", 1, func(message Message) {
+		received <- message
+		<-release
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, packetSubscribe, server.next(t).packetType)
+
+	_, err = conn.Write(encodePublish(Message{Topic: "cemd/limit/set", Payload: []byte("16"), QoS: 1}, 7, false))
+	assert.Nil(t, err)
+	_, err = conn.Write(encodePublish(Message{Topic: "cemd/limit/set", Payload: []byte("10"), QoS: 1}, 8, false))
+	assert.Nil(t, err)
+
+	select {
+	case message := <-received:
+		assert.Equal(t, "16", string(message.Payload))
+	case <-time.After(time.Second):
+		t.Fatal("no message received")
+	}
+
+	// both messages are acknowledged while the handler blocks, and the pings are answered
+	var acknowledged []uint16
+	deadline := time.After(500 * time.Millisecond)
+	for done := false; !done; {
+		select {
+		case p := <-server.packets:
+			switch p.packetType {
+			case packetPubAck:
+				packetID, err := decodePacketID(p)
+				assert.Nil(t, err)
+				acknowledged = append(acknowledged, packetID)
+			case packetPingReq:
+				_, err = conn.Write(encodePacket(packetPingResp, 0, nil))
+				assert.Nil(t, err)
+			}
+		case <-deadline:
+			done = true
+		}
+	}
+	assert.Equal(t, []uint16{7, 8}, acknowledged)
+
+	select {
+	case err := <-lost:
+		t.Fatal("connection lost:", err)
+	default:
+	}
+}
+
+func TestClientPacketTooLarge(t *testing.T) {
+	server := newFakeServer(t, 0)
+	defer server.listener.Close()
+
+	lost := make(chan error, 1)
+	client, err := Connect(ClientOptions{
+		Address: server.listener.Addr().String(),
+		OnConnectionLost: func(err error) {
+			lost <- err
+		},
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	assert.Equal(t, packetConnect, server.next(t).packetType)
+	conn := server.nextConn(t)
+
+	// only the fixed header announcing a 256 MiB packet is sent
+	_, err = conn.Write([]byte{packetPublish << 4, 0xff, 0xff, 0xff, 0x7f})
+	assert.Nil(t, err)
+
+	select {
+	case err := <-lost:
+		assert.ErrorIs(t, err, ErrPacketTooLarge)
+	case <-time.After(time.Second):
+		t.Fatal("connection not dropped")
+	}
+}
+
+func TestClientTLS(t *testing.T) {
+	certificate, err := cert.CreateCertificate("test", "test", "DE", "test")
+	assert.Nil(t, err)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{certificate}})
+	assert.Nil(t, err)
+	server := startFakeServer(listener, 0)
+	defer server.listener.Close()
+
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	assert.Nil(t, err)
+	roots := x509.NewCertPool()
+	roots.AddCert(leaf)
+
+	// the self signed test certificate has no host name
+	client, err := Connect(ClientOptions{
+		Address: server.listener.Addr().String(),
+		TLSConfig: &tls.Config{
+			RootCAs:            roots,
+			InsecureSkipVerify: true, //nolint:gosec
+		},
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	assert.Equal(t, packetConnect, server.next(t).packetType)
+
+	_, err = Connect(ClientOptions{
+		Address:   server.listener.Addr().String(),
+		TLSConfig: &tls.Config{RootCAs: x509.NewCertPool()},
+	})
+	assert.NotNil(t, err)
+}
+
+func TestPacketRemainingLength(t *testing.T) {
+	body := make([]byte, 321)
+	data := encodePacket(packetPublish, 0, body)
+	assert.Equal(t, []byte{0x30, 0xc1, 0x02}, data[:3])
+
+	p, err := readPacket(bufio.NewReader(bytes.NewReader(data)))
+	assert.Nil(t, err)
+	assert.Equal(t, 321, len(p.body))
+}
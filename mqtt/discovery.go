@@ -0,0 +1,133 @@
+package mqtt
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// the configuration of a Home Assistant MQTT entity
+type discoveryConfig struct {
+	Name     string `json:"name"`
+	UniqueID string `json:"unique_id"`
+
+	StateTopic    string `json:"state_topic"`
+	ValueTemplate string `json:"value_template,omitempty"`
+
+	UnitOfMeasurement string `json:"unit_of_measurement,omitempty"`
+	DeviceClass       string `json:"device_class,omitempty"`
+	StateClass        string `json:"state_class,omitempty"`
+
+	PayloadOn  string `json:"payload_on,omitempty"`
+	PayloadOff string `json:"payload_off,omitempty"`
+
+	Availability     []discoveryAvailability `json:"availability"`
+	AvailabilityMode string                  `json:"availability_mode"`
+
+	Device discoveryDevice `json:"device"`
+}
+
+type discoveryAvailability struct {
+	Topic string `json:"topic"`
+}
+
+type discoveryDevice struct {
+	Identifiers  []string `json:"identifiers"`
+	Name         string   `json:"name"`
+	Manufacturer string   `json:"manufacturer,omitempty"`
+}
+
+// return the Home Assistant discovery messages for a value
+//
+// values with one number per phase get one entity per phase,
+// structured values are not announced
+func (b *Bridge) discoveryMessages(ski, address string, usecase *bridgeUseCase, value bridgeValue, phases int) []Message {
+	component := "sensor"
+	switch value.kind {
+	case valueKindJSON:
+		return nil
+	case valueKindBool:
+		component = "binary_sensor"
+	}
+
+	stateTopic := topic(b.options.TopicPrefix, ski, address, usecase.name, value.name)
+	objectID := objectID(b.options.TopicPrefix, ski, address, usecase.name, value.name)
+	name := usecase.name + " " + strings.ReplaceAll(value.name, "_", " ")
+
+	config := discoveryConfig{
+		Name:              name,
+		UniqueID:          objectID,
+		StateTopic:        stateTopic,
+		UnitOfMeasurement: value.unit,
+		DeviceClass:       value.deviceClass,
+		Availability: []discoveryAvailability{
+			{Topic: bridgeAvailabilityTopic(b.options)},
+			{Topic: topic(b.options.TopicPrefix, ski, "availability")},
+		},
+		AvailabilityMode: "all",
+		Device: discoveryDevice{
+			Identifiers: []string{b.options.TopicPrefix + "_" + ski},
+			Name:        b.deviceName(ski),
+		},
+	}
+
+	switch value.kind {
+	case valueKindNumber, valueKindPhases:
+		config.StateClass = "measurement"
+		if value.deviceClass == "energy" {
+			config.StateClass = "total_increasing"
+		}
+	case valueKindBool:
+		config.PayloadOn = "true"
+		config.PayloadOff = "false"
+	}
+
+	if value.kind != valueKindPhases {
+		return []Message{b.discoveryMessage(component, objectID, config)}
+	}
+
+	var result []Message
+	for index := 0; index < phases; index++ {
+		phaseConfig := config
+		phaseConfig.Name = fmt.Sprintf("%s L%d", name, index+1)
+		phaseConfig.UniqueID = fmt.Sprintf("%s_l%d", objectID, index+1)
+		phaseConfig.ValueTemplate = fmt.Sprintf("{{ value_json[%d] }}", index)
+
+		result = append(result, b.discoveryMessage(component, phaseConfig.UniqueID, phaseConfig))
+	}
+
+	return result
+}
+
+func (b *Bridge) discoveryMessage(component, objectID string, config discoveryConfig) Message {
+	payload, _ := json.Marshal(config)
+
+	return Message{
+		Topic:    topic(b.options.DiscoveryPrefix, component, objectID, "config"),
+		Payload:  payload,
+		Retained: true,
+	}
+}
+
+// return the name of a device for the discovery messages
+func (b *Bridge) deviceName(ski string) string {
+	if b.deviceNameProvider != nil {
+		if name := b.deviceNameProvider(ski); name != "" {
+			return name
+		}
+	}
+
+	return ski
+}
+
+// return an identifier only containing characters allowed by Home Assistant
+func objectID(parts ...string) string {
+	id := strings.Join(parts, "_")
+
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == '-' {
+			return r
+		}
+		return '_'
+	}, id)
+}
@@ -0,0 +1,235 @@
+package mqtt
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// the MQTT 3.1.1 control packet types used by the client
+const (
+	packetConnect     byte = 1
+	packetConnAck     byte = 2
+	packetPublish     byte = 3
+	packetPubAck      byte = 4
+	packetSubscribe   byte = 8
+	packetSubAck      byte = 9
+	packetUnsubscribe byte = 10
+	packetUnsubAck    byte = 11
+	packetPingReq     byte = 12
+	packetPingResp    byte = 13
+	packetDisconnect  byte = 14
+)
+
+// the maximum remaining length of a packet, as defined by the MQTT specification
+const maxRemainingLength = 268435455
+
+// the maximum remaining length of a received packet, the payloads of the
+// command topics are small, so larger packets are not read into memory
+const maxPacketLength = 1 << 20
+
+var errMalformedPacket = errors.New("malformed MQTT packet")
+
+// a decoded control packet
+type packet struct {
+	packetType byte
+	flags      byte
+	body       []byte
+}
+
+// encode a control packet with its fixed header
+func encodePacket(packetType, flags byte, body []byte) []byte {
+	result := []byte{packetType<<4 | flags&0x0f}
+
+	length := len(body)
+	for {
+		digit := byte(length % 128)
+		length /= 128
+		if length > 0 {
+			digit |= 0x80
+		}
+		result = append(result, digit)
+		if length == 0 {
+			break
+		}
+	}
+
+	return append(result, body...)
+}
+
+// read a control packet
+func readPacket(reader *bufio.Reader) (*packet, error) {
+	header, err := reader.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	length, multiplier := 0, 1
+	for {
+		digit, err := reader.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+
+		length += int(digit&0x7f) * multiplier
+		if length > maxRemainingLength {
+			return nil, errMalformedPacket
+		}
+		if digit&0x80 == 0 {
+			break
+		}
+		multiplier *= 128
+	}
+
+	if length > maxPacketLength {
+		return nil, ErrPacketTooLarge
+	}
+
+	body := make([]byte, length)
+	if _, err := io.ReadFull(reader, body); err != nil {
+		return nil, err
+	}
+
+	return &packet{
+		packetType: header >> 4,
+		flags:      header & 0x0f,
+		body:       body,
+	}, nil
+}
+
+// append a length prefixed string
+func appendString(data []byte, value string) []byte {
+	data = binary.BigEndian.AppendUint16(data, uint16(len(value)))
+	return append(data, value...)
+}
+
+// read a length prefixed string, returning the string and the remaining data
+func readString(data []byte) (string, []byte, error) {
+	if len(data) < 2 {
+		return "", nil, errMalformedPacket
+	}
+
+	length := int(binary.BigEndian.Uint16(data))
+	if len(data) < 2+length {
+		return "", nil, errMalformedPacket
+	}
+
+	return string(data[2 : 2+length]), data[2+length:], nil
+}
+
+// encode a CONNECT packet
+func encodeConnect(options ClientOptions) []byte {
+	var flags byte = 0x02 // clean session
+
+	if options.Will != nil {
+		flags |= 0x04
+		if options.Will.Retained {
+			flags |= 0x20
+		}
+	}
+	if options.Username != "" {
+		flags |= 0x80
+		if options.Password != "" {
+			flags |= 0x40
+		}
+	}
+
+	body := appendString(nil, "MQTT")
+	body = append(body, 4, flags)
+	body = binary.BigEndian.AppendUint16(body, uint16(options.KeepAlive.Seconds()))
+	body = appendString(body, options.ClientID)
+
+	if options.Will != nil {
+		body = appendString(body, options.Will.Topic)
+		body = binary.BigEndian.AppendUint16(body, uint16(len(options.Will.Payload)))
+		body = append(body, options.Will.Payload...)
+	}
+	if options.Username != "" {
+		body = appendString(body, options.Username)
+		if options.Password != "" {
+			body = appendString(body, options.Password)
+		}
+	}
+
+	return encodePacket(packetConnect, 0, body)
+}
+
+// encode a PUBLISH packet
+//
+// the packet identifier and the duplicate flag are only used for QoS 1
+func encodePublish(message Message, packetID uint16, duplicate bool) []byte {
+	var flags byte
+	if message.Retained {
+		flags |= 0x01
+	}
+
+	body := appendString(nil, message.Topic)
+	if message.QoS > 0 {
+		flags |= message.QoS << 1
+		if duplicate {
+			flags |= 0x08
+		}
+		body = binary.BigEndian.AppendUint16(body, packetID)
+	}
+	body = append(body, message.Payload...)
+
+	return encodePacket(packetPublish, flags, body)
+}
+
+// decode a PUBLISH packet, returning the message and its packet identifier
+func decodePublish(p *packet) (Message, uint16, error) {
+	topic, rest, err := readString(p.body)
+	if err != nil {
+		return Message{}, 0, err
+	}
+
+	// QoS 1 and 2 messages contain a packet identifier
+	var packetID uint16
+	qos := (p.flags >> 1) & 0x03
+	if qos > 0 {
+		if len(rest) < 2 {
+			return Message{}, 0, errMalformedPacket
+		}
+		packetID = binary.BigEndian.Uint16(rest)
+		rest = rest[2:]
+	}
+
+	return Message{
+		Topic:    topic,
+		Payload:  rest,
+		QoS:      qos,
+		Retained: p.flags&0x01 != 0,
+	}, packetID, nil
+}
+
+// decode the packet identifier of a PUBACK, SUBACK or UNSUBACK packet
+func decodePacketID(p *packet) (uint16, error) {
+	if len(p.body) < 2 {
+		return 0, errMalformedPacket
+	}
+
+	return binary.BigEndian.Uint16(p.body), nil
+}
+
+// encode a PUBACK packet
+func encodePubAck(packetID uint16) []byte {
+	return encodePacket(packetPubAck, 0, binary.BigEndian.AppendUint16(nil, packetID))
+}
+
+// encode a SUBSCRIBE packet for a single topic filter
+func encodeSubscribe(packetID uint16, filter string, qos byte) []byte {
+	body := binary.BigEndian.AppendUint16(nil, packetID)
+	body = appendString(body, filter)
+	body = append(body, qos)
+
+	return encodePacket(packetSubscribe, 0x02, body)
+}
+
+// encode an UNSUBSCRIBE packet for a single topic filter
+func encodeUnsubscribe(packetID uint16, filter string) []byte {
+	body := binary.BigEndian.AppendUint16(nil, packetID)
+	body = appendString(body, filter)
+
+	return encodePacket(packetUnsubscribe, 0x02, body)
+}
@@ -0,0 +1,240 @@
+package mqtt
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucopev"
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusmocks "github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/ship-go/cert"
+	shipmocks "github.com/enbility/ship-go/mocks"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBridgeSuite(t *testing.T) {
+	suite.Run(t, new(BridgeSuite))
+}
+
+type BridgeSuite struct {
+	suite.Suite
+
+	sut *Bridge
+
+	broker   *Broker
+	client   ClientInterface
+	received []Message
+
+	mgcp *ucmgcp.UCMGCP
+	opev *fakeOPEV
+
+	service eebusapi.ServiceInterface
+
+	remoteDevice     spineapi.DeviceRemoteInterface
+	mockRemoteEntity *mocks.EntityRemoteInterface
+	smgwEntity       spineapi.EntityRemoteInterface
+}
+
+func (s *BridgeSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+}
+
+func (s *BridgeSuite) BeforeTest(suiteName, testName string) {
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := eebusapi.NewConfiguration(
+		"test", "test", "test", "test",
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		9999, cert, 230.0, time.Second*4)
+
+	serviceHandler := eebusmocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+
+	mockRemoteDevice := mocks.NewDeviceRemoteInterface(s.T())
+	s.mockRemoteEntity = mocks.NewEntityRemoteInterface(s.T())
+	mockRemoteFeature := mocks.NewFeatureRemoteInterface(s.T())
+	mockRemoteDevice.EXPECT().FeatureByEntityTypeAndRole(mock.Anything, mock.Anything, mock.Anything).Return(mockRemoteFeature).Maybe()
+	mockRemoteDevice.EXPECT().Ski().Return(remoteSki).Maybe()
+	s.mockRemoteEntity.EXPECT().Device().Return(mockRemoteDevice).Maybe()
+	s.mockRemoteEntity.EXPECT().EntityType().Return(mock.Anything).Maybe()
+	entityAddress := &model.EntityAddressType{}
+	s.mockRemoteEntity.EXPECT().Address().Return(entityAddress).Maybe()
+	mockRemoteFeature.EXPECT().DataCopy(mock.Anything).Return(mock.Anything).Maybe()
+
+	s.mgcp = ucmgcp.NewUCMGCP(s.service, s.Event)
+	s.mgcp.AddFeatures()
+	s.mgcp.AddUseCase()
+
+	s.opev = &fakeOPEV{}
+
+	s.broker = NewBroker()
+	s.client = s.broker.NewClient(nil)
+	s.received = nil
+	_ = s.client.Subscribe("#", 0, func(message Message) {
+		s.received = append(s.received, message)
+	})
+
+	s.sut = NewBridge(s.broker.NewClient(WillMessage(BridgeOptions{})), BridgeOptions{Discovery: true})
+	s.sut.AddUseCase(s.mgcp)
+	s.sut.AddUseCase(s.opev)
+
+	s.remoteDevice, s.smgwEntity = setupDevices(s.service, s.T())
+}
+
+// return the received messages of a topic
+func (s *BridgeSuite) messages(topic string) []Message {
+	var result []Message
+	for _, message := range s.received {
+		if message.Topic == topic {
+			result = append(result, message)
+		}
+	}
+
+	return result
+}
+
+// a OPEV use case recording the written limits
+type fakeOPEV struct {
+	ucopev.UCOPEVInterface
+
+	limits []api.LoadLimitsPhase
+}
+
+func (f *fakeOPEV) UseCaseName() model.UseCaseNameType {
+	return model.UseCaseNameTypeOverloadProtectionByEVChargingCurrentCurtailment
+}
+
+func (f *fakeOPEV) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool, error) {
+	return true, nil
+}
+
+func (f *fakeOPEV) LoadControlLimits(entity spineapi.EntityRemoteInterface) ([]float64, error) {
+	if f.limits == nil {
+		return nil, eebusapi.ErrDataNotAvailable
+	}
+
+	var result []float64
+	for _, limit := range f.limits {
+		result = append(result, limit.Value)
+	}
+
+	return result, nil
+}
+
+func (f *fakeOPEV) WriteLoadControlLimits(entity spineapi.EntityRemoteInterface, limits []api.LoadLimitsPhase) (*model.MsgCounterType, error) {
+	f.limits = limits
+
+	return nil, nil
+}
+
+const remoteSki string = "testremoteski"
+
+func setupDevices(
+	eebusService eebusapi.ServiceInterface, t *testing.T) (
+	spineapi.DeviceRemoteInterface,
+	spineapi.EntityRemoteInterface) {
+	localDevice := eebusService.LocalDevice()
+
+	writeHandler := shipmocks.NewShipConnectionDataWriterInterface(t)
+	writeHandler.EXPECT().WriteShipMessageWithPayload(mock.Anything).Return().Maybe()
+	sender := spine.NewSender(writeHandler)
+	remoteDevice := spine.NewDeviceRemote(localDevice, remoteSki, sender)
+
+	remoteDeviceName := "remote"
+
+	var remoteFeatures = []struct {
+		featureType   model.FeatureTypeType
+		supportedFcts []model.FunctionType
+	}{
+		{model.FeatureTypeTypeMeasurement,
+			[]model.FunctionType{
+				model.FunctionTypeMeasurementDescriptionListData,
+				model.FunctionTypeMeasurementConstraintsListData,
+				model.FunctionTypeMeasurementListData,
+			},
+		},
+		{model.FeatureTypeTypeElectricalConnection,
+			[]model.FunctionType{
+				model.FunctionTypeElectricalConnectionParameterDescriptionListData,
+				model.FunctionTypeElectricalConnectionDescriptionListData,
+			},
+		},
+		{model.FeatureTypeTypeDeviceConfiguration,
+			[]model.FunctionType{
+				model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData,
+				model.FunctionTypeDeviceConfigurationKeyValueListData,
+			},
+		},
+	}
+	var featureInformations []model.NodeManagementDetailedDiscoveryFeatureInformationType
+	for index, feature := range remoteFeatures {
+		supportedFcts := []model.FunctionPropertyType{}
+		for _, fct := range feature.supportedFcts {
+			supportedFct := model.FunctionPropertyType{
+				Function: eebusutil.Ptr(fct),
+				PossibleOperations: &model.PossibleOperationsType{
+					Read: &model.PossibleOperationsReadType{},
+				},
+			}
+			supportedFcts = append(supportedFcts, supportedFct)
+		}
+
+		featureInformation := model.NodeManagementDetailedDiscoveryFeatureInformationType{
+			Description: &model.NetworkManagementFeatureDescriptionDataType{
+				FeatureAddress: &model.FeatureAddressType{
+					Device:  eebusutil.Ptr(model.AddressDeviceType(remoteDeviceName)),
+					Entity:  []model.AddressEntityType{1},
+					Feature: eebusutil.Ptr(model.AddressFeatureType(index)),
+				},
+				FeatureType:       eebusutil.Ptr(feature.featureType),
+				Role:              eebusutil.Ptr(model.RoleTypeServer),
+				SupportedFunction: supportedFcts,
+			},
+		}
+		featureInformations = append(featureInformations, featureInformation)
+	}
+
+	detailedData := &model.NodeManagementDetailedDiscoveryDataType{
+		DeviceInformation: &model.NodeManagementDetailedDiscoveryDeviceInformationType{
+			Description: &model.NetworkManagementDeviceDescriptionDataType{
+				DeviceAddress: &model.DeviceAddressType{
+					Device: eebusutil.Ptr(model.AddressDeviceType(remoteDeviceName)),
+				},
+			},
+		},
+		EntityInformation: []model.NodeManagementDetailedDiscoveryEntityInformationType{
+			{
+				Description: &model.NetworkManagementEntityDescriptionDataType{
+					EntityAddress: &model.EntityAddressType{
+						Device: eebusutil.Ptr(model.AddressDeviceType(remoteDeviceName)),
+						Entity: []model.AddressEntityType{1},
+					},
+					EntityType: eebusutil.Ptr(model.EntityTypeTypeGridConnectionPointOfPremises),
+				},
+			},
+		},
+		FeatureInformation: featureInformations,
+	}
+
+	entities, err := remoteDevice.AddEntityAndFeatures(true, detailedData)
+	if err != nil {
+		fmt.Println(err)
+	}
+	remoteDevice.UpdateDevice(detailedData.DeviceInformation.Description)
+
+	localDevice.AddRemoteDeviceForSki(remoteSki, remoteDevice)
+
+	return remoteDevice, entities[0]
+}
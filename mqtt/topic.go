@@ -0,0 +1,35 @@
+package mqtt
+
+import "strings"
+
+// return if a topic matches a topic filter with the wildcards + and #
+func topicMatches(filter, topic string) bool {
+	filterLevels := strings.Split(filter, "/")
+	topicLevels := strings.Split(topic, "/")
+
+	// topics starting with $ are not matched by wildcards at the first level
+	if strings.HasPrefix(topic, "$") && (filterLevels[0] == "+" || filterLevels[0] == "#") {
+		return false
+	}
+
+	for index, level := range filterLevels {
+		if level == "#" {
+			return true
+		}
+
+		if index >= len(topicLevels) {
+			return false
+		}
+
+		if level != "+" && level != topicLevels[index] {
+			return false
+		}
+	}
+
+	return len(filterLevels) == len(topicLevels)
+}
+
+// return a topic built from levels
+func topic(levels ...string) string {
+	return strings.Join(levels, "/")
+}
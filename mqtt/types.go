@@ -0,0 +1,145 @@
+package mqtt
+
+import (
+	"crypto/tls"
+	"errors"
+	"time"
+)
+
+// An MQTT message
+type Message struct {
+	Topic   string
+	Payload []byte
+
+	// the quality of service, 0 (at most once) or 1 (at least once)
+	QoS byte
+
+	// if the broker should keep the message and send it to new subscribers
+	Retained bool
+}
+
+// Contains the settings of an MQTT client connection
+type ClientOptions struct {
+	// the broker address, e.g. "localhost:1883"
+	Address string
+
+	// the TLS settings, the connection is not encrypted if nil
+	TLSConfig *tls.Config
+
+	// the client identifier, the broker assigns one if empty
+	ClientID string
+
+	Username string
+	Password string
+
+	// the interval of keep alive pings, 0 uses DefaultKeepAlive
+	KeepAlive time.Duration
+
+	// the time the broker has to answer a ping, 0 uses DefaultPingTimeout
+	//
+	// the connection is considered lost if nothing is received within the
+	// keep alive interval plus this timeout
+	PingTimeout time.Duration
+
+	// the message the broker publishes if the connection is lost, optional
+	Will *Message
+
+	// if the client should reconnect after the connection was lost
+	//
+	// the subscriptions are renewed and unacknowledged QoS 1 messages are
+	// sent again after each reconnect
+	AutoReconnect bool
+
+	// the maximum wait time between reconnect attempts, 0 uses DefaultMaxReconnectInterval
+	MaxReconnectInterval time.Duration
+
+	// called if the connection is lost, with AutoReconnect the client reconnects afterwards
+	OnConnectionLost func(err error)
+
+	// called after the client reconnected and renewed its subscriptions, optional
+	OnReconnect func()
+}
+
+// the default interval of keep alive pings
+const DefaultKeepAlive = 30 * time.Second
+
+// the default time the broker has to answer a ping
+const DefaultPingTimeout = 10 * time.Second
+
+// the default timeout for establishing a connection
+const DefaultConnectTimeout = 10 * time.Second
+
+// the default maximum wait time between reconnect attempts
+const DefaultMaxReconnectInterval = time.Minute
+
+// Contains the settings of a bridge
+type BridgeOptions struct {
+	// the prefix of all topics, "cemd" if empty
+	TopicPrefix string
+
+	// if Home Assistant discovery messages should be published
+	Discovery bool
+
+	// the prefix of the discovery topics, "homeassistant" if empty
+	DiscoveryPrefix string
+}
+
+// the suffix of the EntityRemoved events of all use cases
+const entityRemovedSuffix = ".EntityRemoved"
+
+// the default topic prefixes
+const (
+	DefaultTopicPrefix     = "cemd"
+	DefaultDiscoveryPrefix = "homeassistant"
+)
+
+// the payloads of the availability topics
+const (
+	PayloadOnline  = "online"
+	PayloadOffline = "offline"
+)
+
+// A phase specific limit as sent to a load_control_limits command topic
+//
+// Example: [{"phase":"a","value":16},{"phase":"b","value":16},{"phase":"c","value":16}]
+type LoadLimitCommand struct {
+	// the phase name, e.g. "a"
+	Phase string `json:"phase"`
+
+	// if the limit is active, defaults to true
+	Active *bool `json:"active,omitempty"`
+
+	// the limit in A
+	Value float64 `json:"value"`
+
+	// the duration in seconds after which the limit expires, 0 if the limit does not expire
+	Duration float64 `json:"duration,omitempty"`
+}
+
+// A time slot as sent to the power_limits and incentives command topics
+//
+// Example: [{"duration":3600,"value":11000},{"duration":7200,"value":4200}]
+type SlotCommand struct {
+	// the duration of the slot in seconds
+	Duration float64 `json:"duration"`
+
+	// the power limit in W or the incentive value
+	Value float64 `json:"value"`
+}
+
+// The payload published on the result topic of a command
+type CommandResult struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+var (
+	ErrNotConnected   = errors.New("not connected")
+	ErrConnectRefused = errors.New("connection refused by broker")
+	ErrPingTimeout    = errors.New("no response from broker within the ping timeout")
+	ErrInflightLimit  = errors.New("too many unacknowledged messages")
+	ErrPacketTooLarge = errors.New("packet exceeds the maximum packet size")
+
+	ErrUnknownEntity  = errors.New("unknown entity")
+	ErrUnknownCommand = errors.New("unknown command")
+)
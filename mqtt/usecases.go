@@ -0,0 +1,308 @@
+package mqtt
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/uccevc"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevcem"
+	"github.com/enbility/cemd/ucevsecc"
+	"github.com/enbility/cemd/ucevsoc"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmpc"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/ucoscev"
+	"github.com/enbility/cemd/ucvabd"
+	"github.com/enbility/cemd/ucvapd"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// return the bridge definition of a use case, nil if the use case is not supported
+func newBridgeUseCase(usecase api.UseCaseInterface) *bridgeUseCase {
+	switch usecase.UseCaseName() {
+	case model.UseCaseNameTypeCoordinatedEVCharging:
+		if uc, ok := usecase.(uccevc.UCCEVCInterface); ok {
+			return cevcUseCase(uc)
+		}
+	case model.UseCaseNameTypeEVCommissioningAndConfiguration:
+		if uc, ok := usecase.(ucevcc.UCEVCCInterface); ok {
+			return evccUseCase(uc)
+		}
+	case model.UseCaseNameTypeMeasurementOfElectricityDuringEVCharging:
+		if uc, ok := usecase.(ucevcem.UCEVCEMInterface); ok {
+			return evcemUseCase(uc)
+		}
+	case model.UseCaseNameTypeEVSECommissioningAndConfiguration:
+		if uc, ok := usecase.(ucevsecc.UCEVSECCInterface); ok {
+			return evseccUseCase(uc)
+		}
+	case model.UseCaseNameTypeEVStateOfCharge:
+		if uc, ok := usecase.(ucevsoc.UCEVSOCInterface); ok {
+			return evsocUseCase(uc)
+		}
+	case model.UseCaseNameTypeMonitoringOfGridConnectionPoint:
+		if uc, ok := usecase.(ucmgcp.UCMGCPInterface); ok {
+			return mgcpUseCase(uc)
+		}
+	case model.UseCaseNameTypeMonitoringOfPowerConsumption:
+		if uc, ok := usecase.(ucmpc.UCMCPInterface); ok {
+			return mpcUseCase(uc)
+		}
+	case model.UseCaseNameTypeOverloadProtectionByEVChargingCurrentCurtailment:
+		if uc, ok := usecase.(ucopev.UCOPEVInterface); ok {
+			return loadControlUseCase("opev", uc, uc.LoadControlLimits, uc.WriteLoadControlLimits)
+		}
+	case model.UseCaseNameTypeOptimizationOfSelfConsumptionDuringEVCharging:
+		if uc, ok := usecase.(ucoscev.UCOSCEVInterface); ok {
+			return loadControlUseCase("oscev", uc, uc.LoadControlLimits, uc.WriteLoadControlLimits)
+		}
+	case model.UseCaseNameTypeVisualizationOfAggregatedBatteryData:
+		if uc, ok := usecase.(ucvabd.UCVABDInterface); ok {
+			return vabdUseCase(uc)
+		}
+	case model.UseCaseNameTypeVisualizationOfAggregatedPhotovoltaicData:
+		if uc, ok := usecase.(ucvapd.UCVAPDInterface); ok {
+			return vapdUseCase(uc)
+		}
+	}
+
+	return nil
+}
+
+func cevcUseCase(uc uccevc.UCCEVCInterface) *bridgeUseCase {
+	return &bridgeUseCase{
+		name:    "cevc",
+		usecase: uc,
+		values: []bridgeValue{
+			textValue("charge_strategy", func(entity spineapi.EntityRemoteInterface) (string, error) {
+				return string(uc.ChargeStrategy(entity)), nil
+			}),
+			jsonValue("energy_demand", uc.EnergyDemand),
+			jsonValue("time_slot_constraints", uc.TimeSlotConstraints),
+			jsonValue("incentive_constraints", uc.IncentiveConstraints),
+			jsonValue("charge_plan_constraints", uc.ChargePlanConstraints),
+			jsonValue("charge_plan", uc.ChargePlan),
+		},
+		commands: map[string]commandHandler{
+			"power_limits": slotCommand(uc.WritePowerLimits),
+			"incentives":   slotCommand(uc.WriteIncentives),
+		},
+	}
+}
+
+func evccUseCase(uc ucevcc.UCEVCCInterface) *bridgeUseCase {
+	return &bridgeUseCase{
+		name:    "evcc",
+		usecase: uc,
+		values: []bridgeValue{
+			boolValue("connected", func(entity spineapi.EntityRemoteInterface) (bool, error) {
+				return uc.EVConnected(entity), nil
+			}),
+			textValue("charge_state", func(entity spineapi.EntityRemoteInterface) (string, error) {
+				value, err := uc.ChargeState(entity)
+				return string(value), err
+			}),
+			textValue("communication_standard", func(entity spineapi.EntityRemoteInterface) (string, error) {
+				value, err := uc.CommunicationStandard(entity)
+				return string(value), err
+			}),
+			boolValue("asymmetric_charging_support", uc.AsymmetricChargingSupport),
+			jsonValue("identifications", uc.Identifications),
+			jsonValue("manufacturer_data", func(entity spineapi.EntityRemoteInterface) (manufacturerData, error) {
+				name, serial, err := uc.ManufacturerData(entity)
+				return manufacturerData{DeviceName: name, SerialNumber: serial}, err
+			}),
+			jsonValue("current_limits", func(entity spineapi.EntityRemoteInterface) (currentLimits, error) {
+				minimum, maximum, standard, err := uc.CurrentLimits(entity)
+				return currentLimits{Min: minimum, Max: maximum, Default: standard}, err
+			}),
+			boolValue("sleep_mode", uc.IsInSleepMode),
+		},
+	}
+}
+
+func evcemUseCase(uc ucevcem.UCEVCEMInterface) *bridgeUseCase {
+	return &bridgeUseCase{
+		name:    "evcem",
+		usecase: uc,
+		values: []bridgeValue{
+			numberValue("phases_connected", "", "", func(entity spineapi.EntityRemoteInterface) (float64, error) {
+				value, err := uc.PhasesConnected(entity)
+				return float64(value), err
+			}),
+			// the details variant does not send a read request for stale values on every event
+			phaseValue("current_per_phase", "A", "current", func(entity spineapi.EntityRemoteInterface) ([]float64, error) {
+				data, err := uc.CurrentPerPhaseDetails(entity)
+				if err != nil {
+					return nil, err
+				}
+
+				var result []float64
+				for _, item := range data {
+					result = append(result, item.Value)
+				}
+
+				return result, nil
+			}),
+			phaseValue("power_per_phase", "W", "power", uc.PowerPerPhase),
+			numberValue("energy_charged", "Wh", "energy", uc.EnergyCharged),
+		},
+	}
+}
+
+func evseccUseCase(uc ucevsecc.UCEVSECCInterface) *bridgeUseCase {
+	return &bridgeUseCase{
+		name:    "evsecc",
+		usecase: uc,
+		values: []bridgeValue{
+			jsonValue("manufacturer_data", func(entity spineapi.EntityRemoteInterface) (manufacturerData, error) {
+				name, serial, err := uc.ManufacturerData(entity)
+				return manufacturerData{DeviceName: name, SerialNumber: serial}, err
+			}),
+			textValue("operating_state", func(entity spineapi.EntityRemoteInterface) (string, error) {
+				state, _, err := uc.OperatingState(entity)
+				return string(state), err
+			}),
+			textValue("last_error_code", func(entity spineapi.EntityRemoteInterface) (string, error) {
+				_, code, err := uc.OperatingState(entity)
+				return code, err
+			}),
+		},
+	}
+}
+
+func evsocUseCase(uc ucevsoc.UCEVSOCInterface) *bridgeUseCase {
+	return &bridgeUseCase{
+		name:    "evsoc",
+		usecase: uc,
+		values: []bridgeValue{
+			numberValue("state_of_charge", "%", "battery", uc.StateOfCharge),
+		},
+	}
+}
+
+func mgcpUseCase(uc ucmgcp.UCMGCPInterface) *bridgeUseCase {
+	return &bridgeUseCase{
+		name:    "mgcp",
+		usecase: uc,
+		values: []bridgeValue{
+			numberValue("power_limitation_factor", "%", "", uc.PowerLimitationFactor),
+			numberValue("power", "W", "power", uc.Power),
+			numberValue("energy_feed_in", "Wh", "energy", uc.EnergyFeedIn),
+			numberValue("energy_consumed", "Wh", "energy", uc.EnergyConsumed),
+			phaseValue("current_per_phase", "A", "current", uc.CurrentPerPhase),
+			phaseValue("voltage_per_phase", "V", "voltage", uc.VoltagePerPhase),
+			numberValue("frequency", "Hz", "frequency", uc.Frequency),
+		},
+	}
+}
+
+func mpcUseCase(uc ucmpc.UCMCPInterface) *bridgeUseCase {
+	return &bridgeUseCase{
+		name:    "mpc",
+		usecase: uc,
+		values: []bridgeValue{
+			numberValue("power", "W", "power", uc.Power),
+			phaseValue("power_per_phase", "W", "power", uc.PowerPerPhase),
+			numberValue("energy_consumed", "Wh", "energy", uc.EnergyConsumed),
+			numberValue("energy_produced", "Wh", "energy", uc.EnergyProduced),
+			phaseValue("current_per_phase", "A", "current", uc.CurrentPerPhase),
+			phaseValue("voltage_per_phase", "V", "voltage", uc.VoltagePerPhase),
+			numberValue("frequency", "Hz", "frequency", uc.Frequency),
+		},
+	}
+}
+
+// OPEV and OSCEV provide the same values and commands
+func loadControlUseCase(
+	name string,
+	uc api.UseCaseInterface,
+	read func(spineapi.EntityRemoteInterface) ([]float64, error),
+	write func(spineapi.EntityRemoteInterface, []api.LoadLimitsPhase) (*model.MsgCounterType, error),
+) *bridgeUseCase {
+	return &bridgeUseCase{
+		name:    name,
+		usecase: uc,
+		values: []bridgeValue{
+			phaseValue("load_control_limits", "A", "current", read),
+		},
+		commands: map[string]commandHandler{
+			"load_control_limits": loadLimitCommand(write),
+		},
+	}
+}
+
+func vabdUseCase(uc ucvabd.UCVABDInterface) *bridgeUseCase {
+	return &bridgeUseCase{
+		name:    "vabd",
+		usecase: uc,
+		values: []bridgeValue{
+			numberValue("power", "W", "power", uc.Power),
+			numberValue("energy_charged", "Wh", "energy", uc.EnergyCharged),
+			numberValue("energy_discharged", "Wh", "energy", uc.EnergyDischarged),
+			numberValue("state_of_charge", "%", "battery", uc.StateOfCharge),
+		},
+	}
+}
+
+func vapdUseCase(uc ucvapd.UCVAPDInterface) *bridgeUseCase {
+	return &bridgeUseCase{
+		name:    "vapd",
+		usecase: uc,
+		values: []bridgeValue{
+			numberValue("power", "W", "power", uc.Power),
+			numberValue("power_nominal_peak", "W", "power", uc.PowerNominalPeak),
+			numberValue("yield_total", "Wh", "energy", uc.PVYieldTotal),
+		},
+	}
+}
+
+// return a command writing load control limits
+func loadLimitCommand(write func(spineapi.EntityRemoteInterface, []api.LoadLimitsPhase) (*model.MsgCounterType, error)) commandHandler {
+	return func(entity spineapi.EntityRemoteInterface, payload []byte) error {
+		var data []LoadLimitCommand
+		if err := json.Unmarshal(payload, &data); err != nil {
+			return err
+		}
+
+		limits := make([]api.LoadLimitsPhase, 0, len(data))
+		for _, item := range data {
+			limits = append(limits, api.LoadLimitsPhase{
+				Phase:    model.ElectricalConnectionPhaseNameType(item.Phase),
+				IsActive: item.Active == nil || *item.Active,
+				Value:    item.Value,
+				Duration: seconds(item.Duration),
+			})
+		}
+
+		_, err := write(entity, limits)
+		return err
+	}
+}
+
+// return a command writing time slot values
+func slotCommand(write func(spineapi.EntityRemoteInterface, []api.DurationSlotValue) error) commandHandler {
+	return func(entity spineapi.EntityRemoteInterface, payload []byte) error {
+		var data []SlotCommand
+		if err := json.Unmarshal(payload, &data); err != nil {
+			return err
+		}
+
+		slots := make([]api.DurationSlotValue, 0, len(data))
+		for _, item := range data {
+			slots = append(slots, api.DurationSlotValue{
+				Duration: seconds(item.Duration),
+				Value:    item.Value,
+			})
+		}
+
+		return write(entity, slots)
+	}
+}
+
+func seconds(value float64) time.Duration {
+	return time.Duration(value * float64(time.Second))
+}
@@ -0,0 +1,152 @@
+package mqtt
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/enbility/cemd/api"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// Describes the values and commands of a use case provided by the bridge
+type bridgeUseCase struct {
+	// the name used in the topics, e.g. "evcem"
+	name string
+
+	usecase api.UseCaseInterface
+
+	values []bridgeValue
+
+	// the command handlers, the key is the command name used in the topics
+	commands map[string]commandHandler
+}
+
+// handles the payload sent to a command topic
+type commandHandler func(entity spineapi.EntityRemoteInterface, payload []byte) error
+
+type valueKind int
+
+const (
+	valueKindNumber valueKind = iota
+	valueKindPhases
+	valueKindText
+	valueKindBool
+	valueKindJSON
+)
+
+// Describes a value published by the bridge
+type bridgeValue struct {
+	// the name used in the topic, e.g. "power_per_phase"
+	name string
+	kind valueKind
+
+	// the unit and Home Assistant device class, used for discovery
+	unit        string
+	deviceClass string
+
+	// read the value and return the payload to be published, and the number of phases for phase values
+	read func(entity spineapi.EntityRemoteInterface) ([]byte, int, error)
+}
+
+// the manufacturer data of an EV or EVSE
+type manufacturerData struct {
+	DeviceName   string `json:"deviceName"`
+	SerialNumber string `json:"serialNumber"`
+}
+
+// the current limits per phase of an EV
+type currentLimits struct {
+	Min     []float64 `json:"min"`
+	Max     []float64 `json:"max"`
+	Default []float64 `json:"default"`
+}
+
+// return a value with a single number
+func numberValue(name, unit, deviceClass string, read func(spineapi.EntityRemoteInterface) (float64, error)) bridgeValue {
+	return bridgeValue{
+		name:        name,
+		kind:        valueKindNumber,
+		unit:        unit,
+		deviceClass: deviceClass,
+		read: func(entity spineapi.EntityRemoteInterface) ([]byte, int, error) {
+			value, err := read(entity)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			return []byte(strconv.FormatFloat(value, 'f', -1, 64)), 0, nil
+		},
+	}
+}
+
+// return a value with one number per phase, published as JSON array
+func phaseValue(name, unit, deviceClass string, read func(spineapi.EntityRemoteInterface) ([]float64, error)) bridgeValue {
+	return bridgeValue{
+		name:        name,
+		kind:        valueKindPhases,
+		unit:        unit,
+		deviceClass: deviceClass,
+		read: func(entity spineapi.EntityRemoteInterface) ([]byte, int, error) {
+			values, err := read(entity)
+			if err != nil {
+				return nil, 0, err
+			}
+			if len(values) == 0 {
+				return nil, 0, eebusapi.ErrDataNotAvailable
+			}
+
+			data, err := json.Marshal(values)
+			return data, len(values), err
+		},
+	}
+}
+
+// return a value with a string
+func textValue(name string, read func(spineapi.EntityRemoteInterface) (string, error)) bridgeValue {
+	return bridgeValue{
+		name: name,
+		kind: valueKindText,
+		read: func(entity spineapi.EntityRemoteInterface) ([]byte, int, error) {
+			value, err := read(entity)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			return []byte(value), 0, nil
+		},
+	}
+}
+
+// return a value with a boolean, published as "true" or "false"
+func boolValue(name string, read func(spineapi.EntityRemoteInterface) (bool, error)) bridgeValue {
+	return bridgeValue{
+		name: name,
+		kind: valueKindBool,
+		read: func(entity spineapi.EntityRemoteInterface) ([]byte, int, error) {
+			value, err := read(entity)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			return []byte(strconv.FormatBool(value)), 0, nil
+		},
+	}
+}
+
+// return a value with structured data, published as JSON
+func jsonValue[T any](name string, read func(spineapi.EntityRemoteInterface) (T, error)) bridgeValue {
+	return bridgeValue{
+		name: name,
+		kind: valueKindJSON,
+		read: func(entity spineapi.EntityRemoteInterface) ([]byte, int, error) {
+			value, err := read(entity)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			data, err := json.Marshal(value)
+			return data, 0, err
+		},
+	}
+}
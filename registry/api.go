@@ -0,0 +1,71 @@
+package registry
+
+//go:generate mockery
+
+// Implemented by Registry
+type RegistryInterface interface {
+	// Register all trusted devices with the EEBUS service
+	//
+	// This needs to be called after the service setup and before it is started
+	Setup() error
+
+	// return all known devices
+	Devices() []Device
+
+	// return the device for a SKI
+	//
+	// possible errors:
+	//   - ErrDeviceNotFound if the SKI is not known
+	Device(ski string) (Device, error)
+
+	// set a user assigned name for a device
+	//
+	// possible errors:
+	//   - ErrDeviceNotFound if the SKI is not known
+	//   - and others if the registry could not be persisted
+	SetDeviceName(ski, name string) error
+
+	// start the pairing process with a remote SKI
+	//
+	// the device is added to the registry once the pairing established trust,
+	// failed or cancelled pairings don't add it.
+	// this can also be used to re-pair a previously unpaired device
+	Pair(ski string)
+
+	// remove the trust of a device, disconnect it and remove it from the registry
+	//
+	// possible errors:
+	//   - ErrDeviceNotFound if the SKI is not known
+	//   - and others if the registry could not be persisted
+	Unpair(ski string) error
+
+	// return the SKIs of all incoming pairing requests waiting for a manual decision
+	PendingPairingRequests() []string
+
+	// approve a pending incoming pairing request
+	//
+	// possible errors:
+	//   - ErrNoPendingRequest if there is no pending request for the SKI
+	ApprovePairingRequest(ski string) error
+
+	// deny a pending incoming pairing request
+	//
+	// possible errors:
+	//   - ErrNoPendingRequest if there is no pending request for the SKI
+	DenyPairingRequest(ski string) error
+}
+
+// Decides how incoming pairing requests of unknown devices are handled
+type ApprovalPolicyInterface interface {
+	// return the decision for an incoming pairing request of a SKI
+	Decide(ski string) ApprovalDecision
+}
+
+// Persists the registry devices
+type StoreInterface interface {
+	// return all stored devices
+	Load() ([]Device, error)
+
+	// replace all stored devices
+	Save(devices []Device) error
+}
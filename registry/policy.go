@@ -0,0 +1,54 @@
+package registry
+
+import (
+	"slices"
+
+	shiputil "github.com/enbility/ship-go/util"
+)
+
+// Accepts every incoming pairing request
+type AutoAcceptPolicy struct{}
+
+var _ ApprovalPolicyInterface = (*AutoAcceptPolicy)(nil)
+
+func (p *AutoAcceptPolicy) Decide(ski string) ApprovalDecision {
+	return ApprovalDecisionAccept
+}
+
+// Accepts incoming pairing requests of the provided SKIs and denies all others
+type AllowListPolicy struct {
+	skis []string
+}
+
+var _ ApprovalPolicyInterface = (*AllowListPolicy)(nil)
+
+func NewAllowListPolicy(skis ...string) *AllowListPolicy {
+	policy := &AllowListPolicy{}
+
+	for _, ski := range skis {
+		if ski == "" {
+			continue
+		}
+		policy.skis = append(policy.skis, shiputil.NormalizeSKI(ski))
+	}
+
+	return policy
+}
+
+func (p *AllowListPolicy) Decide(ski string) ApprovalDecision {
+	if slices.Contains(p.skis, shiputil.NormalizeSKI(ski)) {
+		return ApprovalDecisionAccept
+	}
+
+	return ApprovalDecisionDeny
+}
+
+// Keeps every incoming pairing request pending until it is
+// approved or denied via the registry API
+type ManualPolicy struct{}
+
+var _ ApprovalPolicyInterface = (*ManualPolicy)(nil)
+
+func (p *ManualPolicy) Decide(ski string) ApprovalDecision {
+	return ApprovalDecisionPending
+}
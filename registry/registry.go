@@ -0,0 +1,300 @@
+package registry
+
+import (
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	eebusapi "github.com/enbility/eebus-go/api"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/logging"
+	shiputil "github.com/enbility/ship-go/util"
+)
+
+// Persistent registry of remote devices
+//
+// The registry wraps the applications service reader, so it needs to be
+// passed to the CEM instead of the application reader:
+//
+//	reg, err := registry.NewRegistry(store, policy, app)
+//	cem := cem.NewCEM(configuration, reg, eventCB, log)
+//	reg.SetService(cem.Service)
+//
+// All ServiceReaderInterface calls are forwarded to the application reader
+// after the registry processed them.
+type Registry struct {
+	service eebusapi.ServiceInterface
+
+	store  StoreInterface
+	policy ApprovalPolicyInterface
+
+	serviceReader eebusapi.ServiceReaderInterface
+
+	devices map[string]*Device
+	pending []string
+
+	// the mDNS details of the visible services, used for devices which
+	// become trusted after they were reported
+	visible map[string]shipapi.RemoteService
+
+	mux sync.Mutex
+}
+
+var _ RegistryInterface = (*Registry)(nil)
+
+// create a new registry and load all stored devices
+//
+// parameters:
+//   - store: the store used for persisting the devices
+//   - policy: the policy used for deciding about incoming pairing requests of unknown devices
+//   - serviceReader: the application reader all service events are forwarded to, can be nil
+func NewRegistry(
+	store StoreInterface,
+	policy ApprovalPolicyInterface,
+	serviceReader eebusapi.ServiceReaderInterface) (*Registry, error) {
+	registry := &Registry{
+		store:         store,
+		policy:        policy,
+		serviceReader: serviceReader,
+		devices:       make(map[string]*Device),
+		visible:       make(map[string]shipapi.RemoteService),
+	}
+
+	devices, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, device := range devices {
+		item := device
+		item.SKI = shiputil.NormalizeSKI(item.SKI)
+		registry.devices[item.SKI] = &item
+	}
+
+	return registry, nil
+}
+
+// set the EEBUS service the registry manages the remote devices for
+func (r *Registry) SetService(service eebusapi.ServiceInterface) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.service = service
+}
+
+// Register all trusted devices with the EEBUS service
+func (r *Registry) Setup() error {
+	r.mux.Lock()
+	service := r.service
+	var trusted []Device
+	for _, device := range r.devices {
+		if device.Trusted {
+			trusted = append(trusted, *device)
+		}
+	}
+	r.mux.Unlock()
+
+	if service == nil {
+		return ErrServiceNotSet
+	}
+
+	// incoming pairing requests are decided by the registry
+	service.UserIsAbleToApproveOrCancelPairingRequests(true)
+
+	for _, device := range trusted {
+		if device.ShipID != "" {
+			service.RemoteServiceForSKI(device.SKI).SetShipID(device.ShipID)
+		}
+
+		service.RegisterRemoteSKI(device.SKI, true)
+	}
+
+	return nil
+}
+
+// return all known devices, sorted by SKI
+func (r *Registry) Devices() []Device {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.deviceList()
+}
+
+// return the device for a SKI
+func (r *Registry) Device(ski string) (Device, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	device, ok := r.devices[shiputil.NormalizeSKI(ski)]
+	if !ok {
+		return Device{}, ErrDeviceNotFound
+	}
+
+	return *device, nil
+}
+
+// set a user assigned name for a device
+func (r *Registry) SetDeviceName(ski, name string) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	device, ok := r.devices[shiputil.NormalizeSKI(ski)]
+	if !ok {
+		return ErrDeviceNotFound
+	}
+
+	device.Name = name
+
+	return r.store.Save(r.deviceList())
+}
+
+// start the pairing process with a remote SKI
+//
+// the device is added to the registry once the pairing established trust
+func (r *Registry) Pair(ski string) {
+	ski = shiputil.NormalizeSKI(ski)
+
+	r.mux.Lock()
+	service := r.service
+	r.mux.Unlock()
+
+	// the service reports the state change synchronously, so this
+	// must not be called with the mutex locked
+	if service != nil {
+		service.InitiateOrApprovePairingWithSKI(ski)
+	}
+}
+
+// remove the trust of a device, disconnect it and remove it from the registry
+func (r *Registry) Unpair(ski string) error {
+	ski = shiputil.NormalizeSKI(ski)
+
+	r.mux.Lock()
+	if _, ok := r.devices[ski]; !ok {
+		r.mux.Unlock()
+		return ErrDeviceNotFound
+	}
+
+	delete(r.devices, ski)
+	r.removePending(ski)
+	err := r.store.Save(r.deviceList())
+	service := r.service
+	r.mux.Unlock()
+
+	if service != nil {
+		// this also closes an existing connection
+		service.RegisterRemoteSKI(ski, false)
+	}
+
+	return err
+}
+
+// return the SKIs of all incoming pairing requests waiting for a manual decision
+func (r *Registry) PendingPairingRequests() []string {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return slices.Clone(r.pending)
+}
+
+// approve a pending incoming pairing request
+func (r *Registry) ApprovePairingRequest(ski string) error {
+	ski = shiputil.NormalizeSKI(ski)
+
+	r.mux.Lock()
+	found := r.removePending(ski)
+	r.mux.Unlock()
+
+	if !found {
+		return ErrNoPendingRequest
+	}
+
+	r.Pair(ski)
+
+	return nil
+}
+
+// deny a pending incoming pairing request
+func (r *Registry) DenyPairingRequest(ski string) error {
+	ski = shiputil.NormalizeSKI(ski)
+
+	r.mux.Lock()
+	found := r.removePending(ski)
+	service := r.service
+	r.mux.Unlock()
+
+	if !found {
+		return ErrNoPendingRequest
+	}
+
+	if service != nil {
+		service.CancelPairingWithSKI(ski)
+	}
+
+	return nil
+}
+
+// mark a device as trusted
+//
+// needs to be called with the mutex locked
+func (r *Registry) trust(ski string) {
+	device, ok := r.devices[ski]
+	if !ok {
+		device = &Device{SKI: ski}
+		if entry, ok := r.visible[ski]; ok {
+			device.Brand = entry.Brand
+			device.Model = entry.Model
+			device.DeviceType = entry.Type
+		}
+		r.devices[ski] = device
+	}
+
+	if device.Trusted {
+		return
+	}
+
+	device.Trusted = true
+	device.PairedAt = time.Now()
+
+	r.save()
+}
+
+// remove a SKI from the list of pending requests, returns false if it was not pending
+//
+// needs to be called with the mutex locked
+func (r *Registry) removePending(ski string) bool {
+	index := slices.Index(r.pending, ski)
+	if index < 0 {
+		return false
+	}
+
+	r.pending = slices.Delete(r.pending, index, index+1)
+
+	return true
+}
+
+// return a copy of all devices sorted by SKI
+//
+// needs to be called with the mutex locked
+func (r *Registry) deviceList() []Device {
+	result := make([]Device, 0, len(r.devices))
+	for _, device := range r.devices {
+		result = append(result, *device)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].SKI < result[j].SKI
+	})
+
+	return result
+}
+
+// persist all devices and log errors, used when processing service events
+//
+// needs to be called with the mutex locked
+func (r *Registry) save() {
+	if err := r.store.Save(r.deviceList()); err != nil {
+		logging.Log().Error("Error saving device registry:", err)
+	}
+}
@@ -0,0 +1,225 @@
+package registry
+
+import (
+	"path/filepath"
+	"testing"
+
+	eebusmocks "github.com/enbility/eebus-go/mocks"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRegistrySuite(t *testing.T) {
+	suite.Run(t, new(RegistrySuite))
+}
+
+type RegistrySuite struct {
+	suite.Suite
+
+	sut *Registry
+
+	store       *FileStore
+	mockService *eebusmocks.ServiceInterface
+	mockReader  *eebusmocks.ServiceReaderInterface
+}
+
+const remoteSki string = "testremoteski"
+
+func (s *RegistrySuite) BeforeTest(suiteName, testName string) {
+	s.store = NewFileStore(filepath.Join(s.T().TempDir(), "devices.json"))
+
+	s.mockService = eebusmocks.NewServiceInterface(s.T())
+	s.mockReader = eebusmocks.NewServiceReaderInterface(s.T())
+	s.mockReader.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+	s.mockReader.EXPECT().ServiceShipIDUpdate(mock.Anything, mock.Anything).Return().Maybe()
+	s.mockReader.EXPECT().VisibleRemoteServicesUpdated(mock.Anything, mock.Anything).Return().Maybe()
+	s.mockReader.EXPECT().RemoteSKIConnected(mock.Anything, mock.Anything).Return().Maybe()
+	s.mockReader.EXPECT().RemoteSKIDisconnected(mock.Anything, mock.Anything).Return().Maybe()
+
+	var err error
+	s.sut, err = NewRegistry(s.store, &ManualPolicy{}, s.mockReader)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), s.sut)
+}
+
+func (s *RegistrySuite) Test_Setup() {
+	err := s.sut.Setup()
+	assert.Equal(s.T(), ErrServiceNotSet, err)
+
+	err = s.store.Save([]Device{
+		{SKI: remoteSki, ShipID: "shipid", Trusted: true},
+		{SKI: "untrusted"},
+	})
+	assert.Nil(s.T(), err)
+
+	s.sut, err = NewRegistry(s.store, &ManualPolicy{}, s.mockReader)
+	assert.Nil(s.T(), err)
+	s.sut.SetService(s.mockService)
+
+	remoteService := shipapi.NewServiceDetails(remoteSki)
+	s.mockService.EXPECT().UserIsAbleToApproveOrCancelPairingRequests(true).Return().Once()
+	s.mockService.EXPECT().RemoteServiceForSKI(remoteSki).Return(remoteService).Once()
+	s.mockService.EXPECT().RegisterRemoteSKI(remoteSki, true).Return().Once()
+
+	err = s.sut.Setup()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "shipid", remoteService.ShipID())
+	assert.Equal(s.T(), 2, len(s.sut.Devices()))
+}
+
+func (s *RegistrySuite) Test_Devices() {
+	_, err := s.sut.Device(remoteSki)
+	assert.Equal(s.T(), ErrDeviceNotFound, err)
+
+	err = s.sut.SetDeviceName(remoteSki, "Wallbox")
+	assert.Equal(s.T(), ErrDeviceNotFound, err)
+
+	s.sut.Pair(remoteSki)
+
+	// the device is only added once it is trusted
+	_, err = s.sut.Device(remoteSki)
+	assert.Equal(s.T(), ErrDeviceNotFound, err)
+
+	s.sut.VisibleRemoteServicesUpdated(s.mockService, []shipapi.RemoteService{
+		{Ski: remoteSki, Brand: "Brand", Model: "Model", Type: "ChargingStation"},
+		{Ski: "unknown", Brand: "Other"},
+	})
+
+	detail := shipapi.NewConnectionStateDetail(shipapi.ConnectionStateTrusted, nil)
+	s.sut.ServicePairingDetailUpdate(remoteSki, detail)
+
+	err = s.sut.SetDeviceName(remoteSki, "Wallbox")
+	assert.Nil(s.T(), err)
+
+	s.sut.ServiceShipIDUpdate(remoteSki, "shipid")
+
+	device, err := s.sut.Device(remoteSki)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "Wallbox", device.Name)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "shipid", device.ShipID)
+	assert.Equal(s.T(), "Brand", device.Brand)
+	assert.Equal(s.T(), "Model", device.Model)
+	assert.Equal(s.T(), "ChargingStation", device.DeviceType)
+	assert.Equal(s.T(), true, device.Trusted)
+	assert.False(s.T(), device.PairedAt.IsZero())
+
+	// everything needs to be persisted
+	stored, err := s.store.Load()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(stored))
+	assert.True(s.T(), device.PairedAt.Equal(stored[0].PairedAt))
+	stored[0].PairedAt = device.PairedAt
+	assert.Equal(s.T(), device, stored[0])
+
+	s.sut.SetService(s.mockService)
+	s.mockService.EXPECT().RegisterRemoteSKI(remoteSki, false).Return().Once()
+
+	err = s.sut.Unpair(remoteSki)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(s.sut.Devices()))
+
+	err = s.sut.Unpair(remoteSki)
+	assert.Equal(s.T(), ErrDeviceNotFound, err)
+
+	stored, err = s.store.Load()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(stored))
+
+	// re-pair
+	s.mockService.EXPECT().InitiateOrApprovePairingWithSKI(remoteSki).Return().Once()
+	s.sut.Pair(remoteSki)
+	assert.Equal(s.T(), 0, len(s.sut.Devices()))
+
+	s.sut.ServicePairingDetailUpdate(remoteSki, detail)
+	assert.Equal(s.T(), 1, len(s.sut.Devices()))
+}
+
+func (s *RegistrySuite) Test_FailedPairing() {
+	s.sut.SetService(s.mockService)
+
+	states := []shipapi.ConnectionState{
+		shipapi.ConnectionStateRemoteDeniedTrust,
+		shipapi.ConnectionStateError,
+		shipapi.ConnectionStateNone,
+	}
+	for _, state := range states {
+		s.mockService.EXPECT().InitiateOrApprovePairingWithSKI(remoteSki).Return().Once()
+		s.sut.Pair(remoteSki)
+
+		detail := shipapi.NewConnectionStateDetail(state, nil)
+		s.sut.ServicePairingDetailUpdate(remoteSki, detail)
+
+		assert.Equal(s.T(), 0, len(s.sut.Devices()))
+		_, err := s.sut.Device(remoteSki)
+		assert.Equal(s.T(), ErrDeviceNotFound, err)
+	}
+
+	stored, err := s.store.Load()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(stored))
+}
+
+func (s *RegistrySuite) Test_ManualApproval() {
+	s.sut.SetService(s.mockService)
+
+	err := s.sut.ApprovePairingRequest(remoteSki)
+	assert.Equal(s.T(), ErrNoPendingRequest, err)
+
+	err = s.sut.DenyPairingRequest(remoteSki)
+	assert.Equal(s.T(), ErrNoPendingRequest, err)
+
+	request := shipapi.NewConnectionStateDetail(shipapi.ConnectionStateReceivedPairingRequest, nil)
+	s.sut.ServicePairingDetailUpdate(remoteSki, request)
+	s.sut.ServicePairingDetailUpdate(remoteSki, request)
+	assert.Equal(s.T(), []string{remoteSki}, s.sut.PendingPairingRequests())
+
+	s.mockService.EXPECT().CancelPairingWithSKI(remoteSki).Return().Once()
+	err = s.sut.DenyPairingRequest(remoteSki)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(s.sut.PendingPairingRequests()))
+
+	s.sut.ServicePairingDetailUpdate(remoteSki, request)
+	s.mockService.EXPECT().InitiateOrApprovePairingWithSKI(remoteSki).Return().Once()
+	err = s.sut.ApprovePairingRequest(remoteSki)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(s.sut.PendingPairingRequests()))
+
+	s.sut.ServicePairingDetailUpdate(remoteSki, request)
+	errDetail := shipapi.NewConnectionStateDetail(shipapi.ConnectionStateError, nil)
+	s.sut.ServicePairingDetailUpdate(remoteSki, errDetail)
+	assert.Equal(s.T(), 0, len(s.sut.PendingPairingRequests()))
+
+	s.sut.ServicePairingDetailUpdate(remoteSki, request)
+	cancelled := shipapi.NewConnectionStateDetail(shipapi.ConnectionStateNone, nil)
+	s.sut.ServicePairingDetailUpdate(remoteSki, cancelled)
+	assert.Equal(s.T(), 0, len(s.sut.PendingPairingRequests()))
+
+	// trusted devices are accepted without asking the policy
+	trusted := shipapi.NewConnectionStateDetail(shipapi.ConnectionStateCompleted, nil)
+	s.sut.ServicePairingDetailUpdate(remoteSki, trusted)
+
+	s.mockService.EXPECT().InitiateOrApprovePairingWithSKI(remoteSki).Return().Once()
+	s.sut.ServicePairingDetailUpdate(remoteSki, request)
+	assert.Equal(s.T(), 0, len(s.sut.PendingPairingRequests()))
+}
+
+func (s *RegistrySuite) Test_Policies() {
+	var err error
+	s.sut, err = NewRegistry(s.store, NewAllowListPolicy("Allowed-SKI"), nil)
+	assert.Nil(s.T(), err)
+	s.sut.SetService(s.mockService)
+
+	request := shipapi.NewConnectionStateDetail(shipapi.ConnectionStateReceivedPairingRequest, nil)
+
+	s.mockService.EXPECT().InitiateOrApprovePairingWithSKI("allowedski").Return().Once()
+	s.sut.ServicePairingDetailUpdate("allowedski", request)
+
+	s.mockService.EXPECT().CancelPairingWithSKI("deniedski").Return().Once()
+	s.sut.ServicePairingDetailUpdate("deniedski", request)
+
+	assert.Equal(s.T(), ApprovalDecisionAccept, (&AutoAcceptPolicy{}).Decide(remoteSki))
+	assert.Equal(s.T(), ApprovalDecisionPending, (&ManualPolicy{}).Decide(remoteSki))
+}
@@ -0,0 +1,139 @@
+package registry
+
+import (
+	"slices"
+
+	eebusapi "github.com/enbility/eebus-go/api"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/logging"
+	shiputil "github.com/enbility/ship-go/util"
+)
+
+var _ eebusapi.ServiceReaderInterface = (*Registry)(nil)
+
+// report a connection to a SKI
+func (r *Registry) RemoteSKIConnected(service eebusapi.ServiceInterface, ski string) {
+	if r.serviceReader != nil {
+		r.serviceReader.RemoteSKIConnected(service, ski)
+	}
+}
+
+// report a disconnection to a SKI
+func (r *Registry) RemoteSKIDisconnected(service eebusapi.ServiceInterface, ski string) {
+	if r.serviceReader != nil {
+		r.serviceReader.RemoteSKIDisconnected(service, ski)
+	}
+}
+
+// report all currently visible EEBUS services
+//
+// the mDNS details of known devices are updated
+func (r *Registry) VisibleRemoteServicesUpdated(service eebusapi.ServiceInterface, entries []shipapi.RemoteService) {
+	r.mux.Lock()
+
+	r.visible = make(map[string]shipapi.RemoteService, len(entries))
+
+	changed := false
+	for _, entry := range entries {
+		ski := shiputil.NormalizeSKI(entry.Ski)
+		r.visible[ski] = entry
+
+		device, ok := r.devices[ski]
+		if !ok {
+			continue
+		}
+
+		if device.Brand == entry.Brand && device.Model == entry.Model && device.DeviceType == entry.Type {
+			continue
+		}
+
+		device.Brand = entry.Brand
+		device.Model = entry.Model
+		device.DeviceType = entry.Type
+		changed = true
+	}
+
+	if changed {
+		r.save()
+	}
+
+	r.mux.Unlock()
+
+	if r.serviceReader != nil {
+		r.serviceReader.VisibleRemoteServicesUpdated(service, entries)
+	}
+}
+
+// Provides the SHIP ID the remote service reported during the handshake process
+//
+// the SHIP ID is stored for devices known to the registry
+func (r *Registry) ServiceShipIDUpdate(ski string, shipID string) {
+	r.mux.Lock()
+
+	if device, ok := r.devices[shiputil.NormalizeSKI(ski)]; ok && device.ShipID != shipID {
+		device.ShipID = shipID
+		r.save()
+	}
+
+	r.mux.Unlock()
+
+	if r.serviceReader != nil {
+		r.serviceReader.ServiceShipIDUpdate(ski, shipID)
+	}
+}
+
+// Provides the current pairing state for the remote service
+//
+// incoming pairing requests of trusted devices are accepted, all others
+// are decided by the approval policy
+func (r *Registry) ServicePairingDetailUpdate(ski string, detail *shipapi.ConnectionStateDetail) {
+	ski = shiputil.NormalizeSKI(ski)
+
+	decision := ApprovalDecisionPending
+	isRequest := false
+
+	r.mux.Lock()
+	switch detail.State() {
+	case shipapi.ConnectionStateReceivedPairingRequest:
+		isRequest = true
+
+		if device, ok := r.devices[ski]; ok && device.Trusted {
+			decision = ApprovalDecisionAccept
+			break
+		}
+
+		decision = r.policy.Decide(ski)
+		if decision == ApprovalDecisionPending && !slices.Contains(r.pending, ski) {
+			logging.Log().Info("Pairing request of SKI", ski, "is waiting for approval")
+			r.pending = append(r.pending, ski)
+		}
+
+	case shipapi.ConnectionStateTrusted, shipapi.ConnectionStateCompleted:
+		r.removePending(ski)
+		r.trust(ski)
+
+	case shipapi.ConnectionStateNone, shipapi.ConnectionStateRemoteDeniedTrust, shipapi.ConnectionStateError:
+		// the request may also be cancelled without the registry, e.g. via the CEM
+		r.removePending(ski)
+	}
+	r.mux.Unlock()
+
+	if isRequest {
+		switch decision {
+		case ApprovalDecisionAccept:
+			r.Pair(ski)
+		case ApprovalDecisionDeny:
+			r.mux.Lock()
+			service := r.service
+			r.mux.Unlock()
+
+			if service != nil {
+				service.CancelPairingWithSKI(ski)
+			}
+		}
+	}
+
+	if r.serviceReader != nil {
+		r.serviceReader.ServicePairingDetailUpdate(ski, detail)
+	}
+}
@@ -0,0 +1,75 @@
+package registry
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Stores the registry devices in a local JSON file
+type FileStore struct {
+	path string
+
+	mux sync.Mutex
+}
+
+var _ StoreInterface = (*FileStore)(nil)
+
+func NewFileStore(path string) *FileStore {
+	return &FileStore{
+		path: path,
+	}
+}
+
+// return all stored devices, an empty list if the file does not exist yet
+func (f *FileStore) Load() ([]Device, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []Device
+	if err := json.Unmarshal(data, &devices); err != nil {
+		return nil, err
+	}
+
+	return devices, nil
+}
+
+// replace all stored devices
+//
+// the file is written to a temporary file first and then renamed,
+// so an interrupted write does not corrupt the existing registry
+func (f *FileStore) Save(devices []Device) error {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	data, err := json.MarshalIndent(devices, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), f.path)
+}
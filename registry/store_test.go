@@ -0,0 +1,38 @@
+package registry
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "devices.json")
+	store := NewFileStore(path)
+
+	devices, err := store.Load()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(devices))
+
+	data := []Device{
+		{SKI: "ski1", ShipID: "ship1", Name: "Wallbox", Trusted: true},
+		{SKI: "ski2"},
+	}
+	err = store.Save(data)
+	assert.Nil(t, err)
+
+	devices, err = store.Load()
+	assert.Nil(t, err)
+	assert.Equal(t, data, devices)
+
+	err = os.WriteFile(path, []byte("invalid"), 0600)
+	assert.Nil(t, err)
+
+	_, err = store.Load()
+	assert.NotNil(t, err)
+
+	_, err = NewRegistry(store, &ManualPolicy{}, nil)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,49 @@
+package registry
+
+import (
+	"errors"
+	"time"
+)
+
+// Contains the persisted details of a remote device
+type Device struct {
+	// the SKI of the remote service
+	SKI string `json:"ski"`
+
+	// the SHIP ID the remote service reported during the handshake
+	ShipID string `json:"shipId,omitempty"`
+
+	// the user assigned name
+	Name string `json:"name,omitempty"`
+
+	// the mDNS reported brand, model and EEBUS device type
+	Brand      string `json:"brand,omitempty"`
+	Model      string `json:"model,omitempty"`
+	DeviceType string `json:"deviceType,omitempty"`
+
+	// if the device is trusted and should be reconnected to
+	Trusted bool `json:"trusted"`
+
+	// the time the device was trusted the last time
+	PairedAt time.Time `json:"pairedAt,omitempty"`
+}
+
+// the decision of an approval policy
+type ApprovalDecision uint
+
+const (
+	// wait for a manual decision via ApprovePairingRequest or DenyPairingRequest
+	ApprovalDecisionPending ApprovalDecision = iota
+
+	// accept the pairing request
+	ApprovalDecisionAccept
+
+	// deny the pairing request
+	ApprovalDecisionDeny
+)
+
+var ErrDeviceNotFound = errors.New("device is not known")
+
+var ErrNoPendingRequest = errors.New("no pending pairing request for device")
+
+var ErrServiceNotSet = errors.New("service is not set")
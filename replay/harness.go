@@ -0,0 +1,290 @@
+// Package replay feeds recorded SPINE traffic into a CEM with all use cases
+//
+// Recordings are written by cem.Recorder. Replaying one turns a trace of a
+// specific device combination into a permanent regression test:
+//
+//	records, _ := cem.ReadRecording(file)
+//	harness, _ := replay.NewHarness()
+//	defer harness.Shutdown()
+//	_ = harness.Replay(records)
+//	// assert on harness.Events() and the getters of the use cases
+package replay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/uccevc"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevcem"
+	"github.com/enbility/cemd/ucevsecc"
+	"github.com/enbility/cemd/ucevsoc"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmpc"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/ucoscev"
+	"github.com/enbility/cemd/ucvabd"
+	"github.com/enbility/cemd/ucvapd"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+var ErrUnknownEntity = errors.New("unknown entity")
+
+// an event callback of the CEM or a use case
+type Event struct {
+	// the SKI of the remote device
+	Ski string
+
+	// the address of the remote entity, e.g. "1.1", empty for events of a device
+	Entity string
+
+	Type api.EventType
+}
+
+func (e Event) String() string {
+	if e.Entity == "" {
+		return string(e.Type)
+	}
+
+	return fmt.Sprintf("%s %s", e.Entity, e.Type)
+}
+
+// Harness is a CEM with all use cases the recorded datagrams are fed into
+//
+// The CEM is set up but not started, so it does not open SHIP connections.
+// The staleness detection of the measurement use cases is disabled, as the
+// recorded values are older than the threshold.
+type Harness struct {
+	Cem *cem.Cem
+
+	CEVC   uccevc.UCCEVCInterface
+	EVCC   ucevcc.UCEVCCInterface
+	EVCEM  ucevcem.UCEVCEMInterface
+	EVSECC ucevsecc.UCEVSECCInterface
+	EVSOC  ucevsoc.UCEVSOCInterface
+	MGCP   ucmgcp.UCMGCPInterface
+	MPC    ucmpc.UCMCPInterface
+	OPEV   ucopev.UCOPEVInterface
+	OSCEV  ucoscev.UCOSCEVInterface
+	VABD   ucvabd.UCVABDInterface
+	VAPD   ucvapd.UCVAPDInterface
+
+	events []Event
+
+	mux sync.Mutex
+}
+
+// create a harness with a CEM using the demo service details and a transient certificate
+func NewHarness() (*Harness, error) {
+	certificate, err := cert.CreateCertificate("Demo", "Demo", "DE", "Demo-Unit-Replay")
+	if err != nil {
+		return nil, err
+	}
+
+	configuration, err := eebusapi.NewConfiguration(
+		"Demo", "Demo", "HEMS", "123456789",
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		4815, certificate, 230, time.Second*4)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Harness{}
+
+	h.Cem = cem.NewCEM(configuration, h, h.eventCB, &logging.NoLogging{})
+	if err := h.Cem.Setup(); err != nil {
+		return nil, err
+	}
+
+	service := h.Cem.Service
+	cevc := uccevc.NewUCCEVC(service, h.eventCB)
+	evcc := ucevcc.NewUCEVCC(service, h.eventCB)
+	evcem := ucevcem.NewUCEVCEM(service, h.eventCB)
+	evsecc := ucevsecc.NewUCEVSECC(service, h.eventCB)
+	evsoc := ucevsoc.NewUCEVSOC(service, h.eventCB)
+	mgcp := ucmgcp.NewUCMGCP(service, h.eventCB)
+	mpc := ucmpc.NewUCMPC(service, h.eventCB)
+	opev := ucopev.NewUCOPEV(service, h.eventCB)
+	oscev := ucoscev.NewUCOSCEV(service, h.eventCB)
+	vabd := ucvabd.NewUCVABD(service, h.eventCB)
+	vapd := ucvapd.NewUCVAPD(service, h.eventCB)
+
+	for _, usecase := range []api.UseCaseInterface{cevc, evcc, evcem, evsecc, evsoc, mgcp, mpc, opev, oscev, vabd, vapd} {
+		h.Cem.AddUseCase(usecase)
+	}
+
+	evcem.SetStaleThreshold(0)
+	evsoc.SetStaleThreshold(0)
+	mgcp.SetStaleThreshold(0)
+	mpc.SetStaleThreshold(0)
+	vabd.SetStaleThreshold(0)
+	vapd.SetStaleThreshold(0)
+
+	h.CEVC, h.EVCC, h.EVCEM, h.EVSECC = cevc, evcc, evcem, evsecc
+	h.EVSOC, h.MGCP, h.MPC, h.OPEV = evsoc, mgcp, mpc, opev
+	h.OSCEV, h.VABD, h.VAPD = oscev, vabd, vapd
+
+	return h, nil
+}
+
+// feed the incoming datagrams of a recording into the CEM
+//
+// The datagrams are processed in order, a remote device is connected with its
+// first datagram. The outgoing datagrams of the recording are skipped, the
+// messages the CEM sends are dropped.
+//
+// The destination feature of each datagram is resolved by the type of the
+// sending feature, so recordings of a CEM with other use cases can be replayed.
+func (h *Harness) Replay(records []cem.Record) error {
+	localDevice := h.Cem.Service.LocalDevice()
+
+	for index, record := range records {
+		if record.Direction != cem.RecordDirectionIncoming {
+			continue
+		}
+
+		remoteDevice := localDevice.RemoteDeviceForSki(record.Ski)
+		if remoteDevice == nil {
+			localDevice.SetupRemoteDevice(record.Ski, discardWriter{})
+			remoteDevice = localDevice.RemoteDeviceForSki(record.Ski)
+		}
+
+		datagram := model.Datagram{}
+		if err := json.Unmarshal(record.Datagram, &datagram); err != nil {
+			return fmt.Errorf("record %d: %w", index+1, err)
+		}
+
+		h.resolveDestination(&datagram.Datagram, remoteDevice)
+
+		// errors are reported to the remote device like for a live connection
+		_ = localDevice.ProcessCmd(datagram.Datagram, remoteDevice)
+	}
+
+	return nil
+}
+
+// set the local feature the datagram is meant for
+func (h *Harness) resolveDestination(datagram *model.DatagramType, remoteDevice spineapi.DeviceRemoteInterface) {
+	source, destination := datagram.Header.AddressSource, datagram.Header.AddressDestination
+	if source == nil || destination == nil || destination.Feature == nil {
+		return
+	}
+
+	sourceFeature := remoteDevice.FeatureByAddress(source)
+	localEntity := h.Cem.Service.LocalDevice().Entity(destination.Entity)
+	if sourceFeature == nil || localEntity == nil {
+		return
+	}
+
+	role := model.RoleTypeSpecial
+	switch sourceFeature.Role() {
+	case model.RoleTypeServer:
+		role = model.RoleTypeClient
+	case model.RoleTypeClient:
+		role = model.RoleTypeServer
+	}
+
+	if feature := localEntity.FeatureOfTypeAndRole(sourceFeature.Type(), role); feature != nil {
+		destination.Feature = feature.Address().Feature
+	}
+}
+
+// disconnect all remote devices, like closed SHIP connections
+func (h *Harness) Disconnect() {
+	localDevice := h.Cem.Service.LocalDevice()
+	for _, remoteDevice := range localDevice.RemoteDevices() {
+		localDevice.RemoveRemoteDeviceConnection(remoteDevice.Ski())
+	}
+}
+
+// return the remote entity of a device by its address, e.g. "1.1"
+func (h *Harness) Entity(ski, address string) (spineapi.EntityRemoteInterface, error) {
+	remoteDevice := h.Cem.Service.LocalDevice().RemoteDeviceForSki(ski)
+	if remoteDevice != nil {
+		for _, entity := range remoteDevice.Entities() {
+			if util.EntityAddressString(entity) == address {
+				return entity, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrUnknownEntity, ski, address)
+}
+
+// return the events of the CEM and the use cases in the order they were sent
+func (h *Harness) Events() []Event {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	return slices.Clone(h.events)
+}
+
+// return the event types of an entity in the order they were sent
+func (h *Harness) EntityEvents(ski, address string) []api.EventType {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	var result []api.EventType
+	for _, event := range h.events {
+		if event.Ski == ski && event.Entity == address {
+			result = append(result, event.Type)
+		}
+	}
+
+	return result
+}
+
+// forget the events sent so far
+func (h *Harness) ClearEvents() {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	h.events = nil
+}
+
+// shut down the CEM and remove its event subscriptions
+func (h *Harness) Shutdown() {
+	h.Cem.Shutdown()
+}
+
+func (h *Harness) eventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	h.events = append(h.events, Event{
+		Ski:    ski,
+		Entity: util.EntityAddressString(entity),
+		Type:   event,
+	})
+}
+
+// eebusapi.ServiceReaderInterface
+
+func (h *Harness) RemoteSKIConnected(service eebusapi.ServiceInterface, ski string) {}
+
+func (h *Harness) RemoteSKIDisconnected(service eebusapi.ServiceInterface, ski string) {}
+
+func (h *Harness) VisibleRemoteServicesUpdated(service eebusapi.ServiceInterface, entries []shipapi.RemoteService) {
+}
+
+func (h *Harness) ServiceShipIDUpdate(ski string, shipdID string) {}
+
+func (h *Harness) ServicePairingDetailUpdate(ski string, detail *shipapi.ConnectionStateDetail) {}
+
+// drops the datagrams the CEM sends
+type discardWriter struct{}
+
+func (d discardWriter) WriteShipMessageWithPayload([]byte) {}
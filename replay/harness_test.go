@@ -0,0 +1,177 @@
+package replay
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/simulator"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevsoc"
+	"github.com/enbility/cemd/ucmgcp"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/ship-go/cert"
+	"github.com/enbility/ship-go/logging"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestHarnessSuite(t *testing.T) {
+	suite.Run(t, new(HarnessSuite))
+}
+
+type HarnessSuite struct {
+	suite.Suite
+
+	// the CEM of the recording
+	live *Harness
+	sim  *simulator.Simulator
+
+	wallbox *simulator.Wallbox
+	grid    *simulator.GridMeter
+
+	recording bytes.Buffer
+}
+
+func (s *HarnessSuite) BeforeTest(suiteName, testName string) {
+	s.recording.Reset()
+
+	var err error
+	s.live, err = NewHarness()
+	assert.Nil(s.T(), err)
+
+	s.wallbox = simulator.NewWallbox(simulator.WallboxOptions{})
+	s.grid = simulator.NewGridMeter(simulator.GridMeterOptions{})
+	s.grid.AddSource(s.wallbox)
+	devices := []simulator.DeviceInterface{s.wallbox, s.grid}
+
+	certificate, err := cert.CreateCertificate("Demo", "Demo", "DE", "Demo-Unit-22")
+	assert.Nil(s.T(), err)
+	configuration, err := eebusapi.NewConfiguration(
+		"Demo", "Demo", "Simulator", "234567890",
+		model.DeviceTypeTypeGeneric,
+		simulator.EntityTypes(devices...),
+		7662, certificate, 230, time.Second*4)
+	assert.Nil(s.T(), err)
+
+	s.sim = simulator.NewSimulator(configuration, s.live, simulator.Options{Interval: time.Minute}, &logging.NoLogging{})
+	for _, device := range devices {
+		s.sim.AddDevice(device)
+	}
+	assert.Nil(s.T(), s.sim.Setup())
+}
+
+func (s *HarnessSuite) AfterTest(suiteName, testName string) {
+	s.sim.Shutdown()
+	s.live.Shutdown()
+}
+
+// wait until the live CEM received an event
+func (s *HarnessSuite) waitForEvent(address string, event api.EventType) {
+	ski := s.sim.Service.LocalService().SKI()
+	assert.Eventually(s.T(), func() bool {
+		for _, item := range s.live.EntityEvents(ski, address) {
+			if item == event {
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond, "missing event %s %s", address, event)
+}
+
+func (s *HarnessSuite) Test_Replay() {
+	ski := s.sim.Service.LocalService().SKI()
+	recorder := cem.NewRecorder(&s.recording)
+
+	connection := s.sim.ConnectLocalWithRecorder(s.live.Cem.Service, recorder)
+	s.waitForEvent("2", ucmgcp.EntityAdded)
+
+	// entities added before the CEM subscribed to the node management are missed
+	localDevice := s.sim.Service.LocalDevice()
+	nodeManagement := localDevice.NodeManagement().Address()
+	assert.Eventually(s.T(), func() bool {
+		return len(localDevice.SubscriptionManager().SubscriptionsOnFeature(*nodeManagement)) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	err := s.sim.Action("wallbox", "plugin", "40")
+	assert.Nil(s.T(), err)
+	s.waitForEvent("1.1", ucevcc.EntityAdded)
+
+	s.sim.Advance(10 * time.Minute)
+	s.waitForEvent("1.1", ucevsoc.DataUpdateStateOfCharge)
+
+	err = s.sim.Action("wallbox", "unplug")
+	assert.Nil(s.T(), err)
+	s.waitForEvent("1.1", ucevsoc.EntityRemoved)
+
+	// the EV is plugged in again, so the getters have data at the end of the replay
+	err = s.sim.Action("wallbox", "plugin", "60")
+	assert.Nil(s.T(), err)
+	s.sim.Advance(time.Minute)
+	assert.Eventually(s.T(), func() bool {
+		ev, err := s.live.Entity(ski, "1.1")
+		if err != nil {
+			return false
+		}
+		soc, err := s.live.EVSOC.StateOfCharge(ev)
+		return err == nil && soc > 60
+	}, 5*time.Second, 10*time.Millisecond)
+
+	connection.Close()
+	assert.Nil(s.T(), recorder.Err())
+
+	records, err := cem.ReadRecording(bytes.NewReader(s.recording.Bytes()))
+	assert.Nil(s.T(), err)
+	assert.NotEmpty(s.T(), records)
+	assert.Equal(s.T(), ski, records[0].Ski)
+	assert.Equal(s.T(), cem.RecordDirectionOutgoing, records[0].Direction)
+
+	sut, err := NewHarness()
+	assert.Nil(s.T(), err)
+	defer sut.Shutdown()
+
+	err = sut.Replay(records)
+	assert.Nil(s.T(), err)
+
+	// the replayed datagrams trigger the same events as the live connection
+	liveEvents := s.live.Events()
+	replayedEvents := sut.Events()
+	assert.Greater(s.T(), len(replayedEvents), 10)
+	assert.Equal(s.T(), liveEvents[:len(replayedEvents)], replayedEvents)
+	assert.Contains(s.T(), sut.EntityEvents(ski, "1.1"), ucevsoc.EntityRemoved)
+
+	ev, err := sut.Entity(ski, "1.1")
+	assert.Nil(s.T(), err)
+	soc, err := sut.EVSOC.StateOfCharge(ev)
+	assert.Nil(s.T(), err)
+	assert.InDelta(s.T(), s.wallbox.StateOfCharge(), soc, 0.01)
+
+	grid, err := sut.Entity(ski, "2")
+	assert.Nil(s.T(), err)
+	power, err := sut.MGCP.Power(grid)
+	assert.Nil(s.T(), err)
+	assert.InDelta(s.T(), s.grid.Power(), power, 0.01)
+
+	sut.ClearEvents()
+	sut.Disconnect()
+	assert.Contains(s.T(), sut.EntityEvents(ski, "1.1"), ucevsoc.EntityRemoved)
+
+	_, err = sut.Entity(ski, "1.1")
+	assert.ErrorIs(s.T(), err, ErrUnknownEntity)
+}
+
+func (s *HarnessSuite) Test_ReplayInvalid() {
+	sut, err := NewHarness()
+	assert.Nil(s.T(), err)
+	defer sut.Shutdown()
+
+	records, err := cem.ReadRecording(strings.NewReader(`{"time":"2024-06-01T12:00:00Z","ski":"1234","direction":"in","datagram":{"datagram":[]}}`))
+	assert.Nil(s.T(), err)
+
+	err = sut.Replay(records)
+	assert.NotNil(s.T(), err)
+}
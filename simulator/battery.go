@@ -0,0 +1,208 @@
+package simulator
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+type BatteryOptions struct {
+	// the name of the device in scripts, empty uses "battery"
+	Name string
+
+	// the usable capacity in Wh, 0 uses 10000
+	Capacity float64
+
+	// the maximum charging and discharging power in W, 0 uses 5000
+	MaxPower float64
+
+	// the state of charge at the start in %
+	StateOfCharge float64
+
+	// the meter at the grid connection point, the battery compensates its power to
+	// optimize the self consumption, the battery is idle if it is not set
+	Meter PowerSourceInterface
+}
+
+// the measurement ids of the battery
+const (
+	batteryPower = iota
+	batteryCharged
+	batteryDischarged
+	batteryStateOfCharge
+)
+
+// Battery simulates the inverter of a home battery
+//
+// The battery charges with the power fed into the grid and discharges to cover the
+// power consumed from the grid, within its maximum power and capacity.
+// The battery supports the VABD use case.
+//
+// Script actions:
+//   - power <W>: charge with a fixed power, negative values discharge
+//   - auto: compensate the power at the grid connection point again
+//   - soc <soc>: set the state of charge in %
+//   - fault: the battery stops and reports its values with the error state
+//   - clear: clear the fault
+type Battery struct {
+	options BatteryOptions
+
+	measurements *measurements
+
+	// the fixed power, only used if fixed is set
+	fixedPower float64
+	fixed      bool
+
+	power      float64
+	soc        float64
+	charged    float64
+	discharged float64
+	fault      bool
+}
+
+func NewBattery(options BatteryOptions) *Battery {
+	if options.Name == "" {
+		options.Name = "battery"
+	}
+	if options.Capacity == 0 {
+		options.Capacity = 10000
+	}
+	if options.MaxPower == 0 {
+		options.MaxPower = 5000
+	}
+
+	return &Battery{
+		options: options,
+		soc:     options.StateOfCharge,
+	}
+}
+
+var _ DeviceInterface = (*Battery)(nil)
+var _ PowerSourceInterface = (*Battery)(nil)
+
+func (b *Battery) Name() string {
+	return b.options.Name
+}
+
+func (b *Battery) EntityType() model.EntityTypeType {
+	return model.EntityTypeTypeElectricityStorageSystem
+}
+
+func (b *Battery) Setup(entity spineapi.EntityLocalInterface) {
+	addManufacturer(entity, "Demo", "Battery", "battery-0001")
+
+	b.measurements = newMeasurements(entity, 3, model.EnergyDirectionTypeConsume,
+		measurementPoint{
+			measurementType: model.MeasurementTypeTypePower,
+			scope:           model.ScopeTypeTypeACPowerTotal,
+			unit:            model.UnitOfMeasurementTypeW,
+			phase:           model.ElectricalConnectionPhaseNameTypeAbc,
+		},
+		measurementPoint{
+			measurementType: model.MeasurementTypeTypeEnergy,
+			scope:           model.ScopeTypeTypeCharge,
+			unit:            model.UnitOfMeasurementTypeWh,
+		},
+		measurementPoint{
+			measurementType: model.MeasurementTypeTypeEnergy,
+			scope:           model.ScopeTypeTypeDischarge,
+			unit:            model.UnitOfMeasurementTypeWh,
+		},
+		measurementPoint{
+			measurementType: model.MeasurementTypeTypePercentage,
+			scope:           model.ScopeTypeTypeStateOfCharge,
+			unit:            model.UnitOfMeasurementTypepct,
+		},
+	)
+
+	addUseCases(entity, model.UseCaseActorTypeBatterySystem,
+		useCase{model.UseCaseNameTypeVisualizationOfAggregatedBatteryData, []model.UseCaseScenarioSupportType{1, 2, 3, 4}},
+	)
+}
+
+// returns the state of charge in %
+func (b *Battery) StateOfCharge() float64 {
+	return b.soc
+}
+
+// returns the charging power in W, discharging is reported as a negative value
+func (b *Battery) Power() float64 {
+	return b.power
+}
+
+func (b *Battery) Step(now time.Time, delta time.Duration) {
+	b.power = b.targetPower()
+
+	energy := b.power * delta.Hours()
+	if energy > 0 {
+		b.charged += energy
+	} else {
+		b.discharged -= energy
+	}
+	b.soc = math.Max(0, math.Min(100, b.soc+energy/b.options.Capacity*100))
+}
+
+// returns the power the battery (dis)charges with
+func (b *Battery) targetPower() float64 {
+	if b.fault {
+		return 0
+	}
+
+	power := b.fixedPower
+	if !b.fixed {
+		power = 0
+		// the power at the grid connection point of the last step includes the battery itself
+		if b.options.Meter != nil {
+			power = b.power - b.options.Meter.Power()
+		}
+	}
+
+	power = math.Max(-b.options.MaxPower, math.Min(b.options.MaxPower, power))
+
+	if (power > 0 && b.soc >= 100) || (power < 0 && b.soc <= 0) {
+		return 0
+	}
+
+	return power
+}
+
+func (b *Battery) Publish() {
+	b.measurements.publish([]float64{
+		batteryPower:         b.power,
+		batteryCharged:       b.charged,
+		batteryDischarged:    b.discharged,
+		batteryStateOfCharge: b.soc,
+	}, measurementState(b.fault))
+}
+
+func (b *Battery) Action(name string, args []string) error {
+	switch name {
+	case "power":
+		power, err := floatArgument(args, 0, 0)
+		if err != nil {
+			return err
+		}
+		b.fixedPower = power
+		b.fixed = true
+	case "auto":
+		b.fixed = false
+	case "soc":
+		soc, err := floatArgument(args, 0, b.soc)
+		if err != nil {
+			return err
+		}
+		b.soc = math.Max(0, math.Min(100, soc))
+	case "fault":
+		b.fault = true
+		b.power = 0
+	case "clear":
+		b.fault = false
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownAction, name)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package simulator
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// A simulated device
+//
+// Each device is represented by an entity of the simulator service.
+// The methods are called by the simulator with its lock held, so they are
+// never called concurrently for the devices of one simulator.
+type DeviceInterface interface {
+	// the name of the device used in scripts, e.g. "wallbox"
+	Name() string
+
+	// the type of the entity representing the device
+	EntityType() model.EntityTypeType
+
+	// add the features and use cases of the device to its entity
+	Setup(entity spineapi.EntityLocalInterface)
+
+	// advance the simulated state of the device
+	//
+	// parameters:
+	//   - now: the simulated time after the step
+	//   - delta: the simulated duration of the step
+	Step(now time.Time, delta time.Duration)
+
+	// set the current state as feature data, this notifies the subscribed remote devices
+	Publish()
+
+	// run a script action, e.g. "plugin" or "fault"
+	//
+	// possible errors:
+	//   - ErrUnknownAction if the device does not support the action
+	//   - ErrInvalidArguments if the arguments can not be used for the action
+	Action(name string, args []string) error
+}
+
+// A device consuming or producing power, used to calculate the power at the grid connection point
+type PowerSourceInterface interface {
+	// the current active power in W
+	//
+	// return values:
+	//   - positive values are used for consumption
+	//   - negative values are used for production
+	Power() float64
+}
+
+// add a server feature to an entity, the functions are readable but not writable
+func addServerFeature(
+	entity spineapi.EntityLocalInterface,
+	featureType model.FeatureTypeType,
+	functions ...model.FunctionType) spineapi.FeatureLocalInterface {
+	feature := entity.GetOrAddFeature(featureType, model.RoleTypeServer)
+	for _, function := range functions {
+		feature.AddFunctionType(function, true, false)
+	}
+
+	return feature
+}
+
+// add the device classification feature with the manufacturer details
+func addManufacturer(entity spineapi.EntityLocalInterface, brand, deviceName, serialNumber string) {
+	feature := addServerFeature(entity, model.FeatureTypeTypeDeviceClassification,
+		model.FunctionTypeDeviceClassificationManufacturerData)
+
+	feature.SetData(model.FunctionTypeDeviceClassificationManufacturerData, &model.DeviceClassificationManufacturerDataType{
+		BrandName:    eebusutil.Ptr(model.DeviceClassificationStringType(brand)),
+		VendorName:   eebusutil.Ptr(model.DeviceClassificationStringType(brand)),
+		DeviceName:   eebusutil.Ptr(model.DeviceClassificationStringType(deviceName)),
+		SerialNumber: eebusutil.Ptr(model.DeviceClassificationStringType(serialNumber)),
+	})
+}
+
+// a value of the device configuration
+type configurationKey struct {
+	name      model.DeviceConfigurationKeyNameType
+	valueType model.DeviceConfigurationKeyValueTypeType
+	unit      model.UnitOfMeasurementType
+	value     model.DeviceConfigurationKeyValueValueType
+}
+
+// add the device configuration feature with key values, the key ids are the indices of the keys
+func addConfiguration(entity spineapi.EntityLocalInterface, keys ...configurationKey) spineapi.FeatureLocalInterface {
+	feature := addServerFeature(entity, model.FeatureTypeTypeDeviceConfiguration,
+		model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData,
+		model.FunctionTypeDeviceConfigurationKeyValueListData)
+
+	descriptions := &model.DeviceConfigurationKeyValueDescriptionListDataType{}
+	values := &model.DeviceConfigurationKeyValueListDataType{}
+
+	for index, key := range keys {
+		description := model.DeviceConfigurationKeyValueDescriptionDataType{
+			KeyId:     eebusutil.Ptr(model.DeviceConfigurationKeyIdType(index)),
+			KeyName:   eebusutil.Ptr(key.name),
+			ValueType: eebusutil.Ptr(key.valueType),
+		}
+		if key.unit != "" {
+			description.Unit = eebusutil.Ptr(key.unit)
+		}
+		descriptions.DeviceConfigurationKeyValueDescriptionData = append(descriptions.DeviceConfigurationKeyValueDescriptionData, description)
+
+		values.DeviceConfigurationKeyValueData = append(values.DeviceConfigurationKeyValueData, model.DeviceConfigurationKeyValueDataType{
+			KeyId:             eebusutil.Ptr(model.DeviceConfigurationKeyIdType(index)),
+			Value:             eebusutil.Ptr(key.value),
+			IsValueChangeable: eebusutil.Ptr(false),
+		})
+	}
+
+	feature.SetData(model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData, descriptions)
+	feature.SetData(model.FunctionTypeDeviceConfigurationKeyValueListData, values)
+
+	return feature
+}
+
+// add the device diagnosis feature with the operating state
+func addDiagnosis(entity spineapi.EntityLocalInterface) spineapi.FeatureLocalInterface {
+	return addServerFeature(entity, model.FeatureTypeTypeDeviceDiagnosis,
+		model.FunctionTypeDeviceDiagnosisStateData)
+}
+
+// set the operating state of a device diagnosis feature, the error code is only set if it is not empty
+func setDiagnosisState(feature spineapi.FeatureLocalInterface, state model.DeviceDiagnosisOperatingStateType, errorCode string) {
+	data := &model.DeviceDiagnosisStateDataType{
+		OperatingState: eebusutil.Ptr(state),
+	}
+	if errorCode != "" {
+		data.LastErrorCode = eebusutil.Ptr(model.LastErrorCodeType(errorCode))
+	}
+
+	feature.SetData(model.FunctionTypeDeviceDiagnosisStateData, data)
+}
+
+// a use case supported by a device
+type useCase struct {
+	name      model.UseCaseNameType
+	scenarios []model.UseCaseScenarioSupportType
+}
+
+// announce the support of use cases with an actor
+func addUseCases(entity spineapi.EntityLocalInterface, actor model.UseCaseActorType, usecases ...useCase) {
+	for _, usecase := range usecases {
+		entity.AddUseCaseSupport(actor, usecase.name, model.SpecificationVersionType("1.0.0"), "", true, usecase.scenarios)
+	}
+}
+
+// returns the optional numeric argument of an action
+//
+// possible errors:
+//   - ErrInvalidArguments if the argument is not a number
+func floatArgument(args []string, index int, defaultValue float64) (float64, error) {
+	if len(args) <= index {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.ParseFloat(args[index], 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidArguments, args[index])
+	}
+
+	return value, nil
+}
@@ -0,0 +1,219 @@
+package simulator
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+type GridMeterOptions struct {
+	// the name of the device in scripts, empty uses "grid"
+	Name string
+
+	// the consumption of the household without the sources in W, 0 uses 500
+	BaseLoad float64
+
+	// the voltage of each phase in V, 0 uses 230
+	Voltage float64
+
+	// the frequency in Hz, 0 uses 50
+	Frequency float64
+
+	// the PV curtailment limit factor in %, 0 uses 70
+	CurtailmentLimitFactor float64
+}
+
+// the measurement ids of the grid meter
+const (
+	gridPower = iota
+	gridFeedIn
+	gridConsumption
+	gridCurrentL1
+	gridCurrentL2
+	gridCurrentL3
+	gridVoltageL1
+	gridVoltageL2
+	gridVoltageL3
+	gridFrequency
+)
+
+// GridMeter simulates the smart meter gateway at the grid connection point
+//
+// The power at the grid connection point is the base load plus the power of the
+// sources, distributed evenly to the phases. The grid meter supports the MGCP use case.
+//
+// Script actions:
+//   - load <W>: set the base load
+//   - frequency <Hz>: set the grid frequency
+//   - fault: report all values with the error state
+//   - clear: clear the fault
+type GridMeter struct {
+	options GridMeterOptions
+
+	// the devices behind the grid connection point
+	sources []PowerSourceInterface
+
+	measurements *measurements
+
+	power       float64
+	feedIn      float64
+	consumption float64
+	fault       bool
+}
+
+func NewGridMeter(options GridMeterOptions) *GridMeter {
+	if options.Name == "" {
+		options.Name = "grid"
+	}
+	if options.BaseLoad == 0 {
+		options.BaseLoad = 500
+	}
+	if options.Voltage == 0 {
+		options.Voltage = 230
+	}
+	if options.Frequency == 0 {
+		options.Frequency = 50
+	}
+	if options.CurtailmentLimitFactor == 0 {
+		options.CurtailmentLimitFactor = 70
+	}
+
+	return &GridMeter{
+		options: options,
+	}
+}
+
+var _ DeviceInterface = (*GridMeter)(nil)
+var _ PowerSourceInterface = (*GridMeter)(nil)
+
+// add a device behind the grid connection point, this has to be called before the simulator is started
+func (g *GridMeter) AddSource(source PowerSourceInterface) {
+	g.sources = append(g.sources, source)
+}
+
+func (g *GridMeter) Name() string {
+	return g.options.Name
+}
+
+func (g *GridMeter) EntityType() model.EntityTypeType {
+	return model.EntityTypeTypeGridConnectionPointOfPremises
+}
+
+func (g *GridMeter) Setup(entity spineapi.EntityLocalInterface) {
+	addManufacturer(entity, "Demo", "Smart Meter Gateway", "smgw-0001")
+
+	addConfiguration(entity, configurationKey{
+		name:      model.DeviceConfigurationKeyNameTypePvCurtailmentLimitFactor,
+		valueType: model.DeviceConfigurationKeyValueTypeTypeScaledNumber,
+		unit:      model.UnitOfMeasurementTypepct,
+		value:     model.DeviceConfigurationKeyValueValueType{ScaledNumber: model.NewScaledNumberType(g.options.CurtailmentLimitFactor)},
+	})
+
+	points := []measurementPoint{
+		{
+			measurementType: model.MeasurementTypeTypePower,
+			scope:           model.ScopeTypeTypeACPowerTotal,
+			unit:            model.UnitOfMeasurementTypeW,
+			phase:           model.ElectricalConnectionPhaseNameTypeAbc,
+		},
+		{
+			measurementType: model.MeasurementTypeTypeEnergy,
+			scope:           model.ScopeTypeTypeGridFeedIn,
+			unit:            model.UnitOfMeasurementTypeWh,
+		},
+		{
+			measurementType: model.MeasurementTypeTypeEnergy,
+			scope:           model.ScopeTypeTypeGridConsumption,
+			unit:            model.UnitOfMeasurementTypeWh,
+		},
+	}
+	for _, phase := range util.PhaseNameMapping {
+		points = append(points, measurementPoint{
+			measurementType: model.MeasurementTypeTypeCurrent,
+			scope:           model.ScopeTypeTypeACCurrent,
+			unit:            model.UnitOfMeasurementTypeA,
+			phase:           phase,
+		})
+	}
+	for _, phase := range util.PhaseNameMapping {
+		points = append(points, measurementPoint{
+			measurementType: model.MeasurementTypeTypeVoltage,
+			scope:           model.ScopeTypeTypeACVoltage,
+			unit:            model.UnitOfMeasurementTypeV,
+			phase:           phase,
+		})
+	}
+	points = append(points, measurementPoint{
+		measurementType: model.MeasurementTypeTypeFrequency,
+		scope:           model.ScopeTypeTypeACFrequency,
+		unit:            model.UnitOfMeasurementTypeHz,
+	})
+
+	g.measurements = newMeasurements(entity, 3, model.EnergyDirectionTypeConsume, points...)
+
+	addUseCases(entity, model.UseCaseActorTypeGridConnectionPoint,
+		useCase{model.UseCaseNameTypeMonitoringOfGridConnectionPoint, []model.UseCaseScenarioSupportType{1, 2, 3, 4, 5, 6, 7}},
+	)
+}
+
+// returns the power at the grid connection point in W, feed in is reported as a negative value
+func (g *GridMeter) Power() float64 {
+	return g.power
+}
+
+func (g *GridMeter) Step(now time.Time, delta time.Duration) {
+	g.power = g.options.BaseLoad
+	for _, source := range g.sources {
+		g.power += source.Power()
+	}
+
+	energy := g.power * delta.Hours()
+	if energy > 0 {
+		g.consumption += energy
+	} else {
+		g.feedIn -= energy
+	}
+}
+
+func (g *GridMeter) Publish() {
+	values := make([]float64, gridFrequency+1)
+	values[gridPower] = g.power
+	values[gridFeedIn] = g.feedIn
+	values[gridConsumption] = g.consumption
+	for phase := range util.PhaseNameMapping {
+		values[gridCurrentL1+phase] = g.power / 3 / g.options.Voltage
+		values[gridVoltageL1+phase] = g.options.Voltage
+	}
+	values[gridFrequency] = g.options.Frequency
+
+	g.measurements.publish(values, measurementState(g.fault))
+}
+
+func (g *GridMeter) Action(name string, args []string) error {
+	switch name {
+	case "load":
+		load, err := floatArgument(args, 0, g.options.BaseLoad)
+		if err != nil {
+			return err
+		}
+		g.options.BaseLoad = math.Max(0, load)
+	case "frequency":
+		frequency, err := floatArgument(args, 0, g.options.Frequency)
+		if err != nil {
+			return err
+		}
+		g.options.Frequency = frequency
+	case "fault":
+		g.fault = true
+	case "clear":
+		g.fault = false
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownAction, name)
+	}
+
+	return nil
+}
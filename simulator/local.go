@@ -0,0 +1,160 @@
+package simulator
+
+import (
+	"sync"
+
+	"github.com/enbility/cemd/cem"
+	eebusapi "github.com/enbility/eebus-go/api"
+	shipapi "github.com/enbility/ship-go/api"
+)
+
+// passes the SPINE messages of one direction of a local connection in order
+//
+// the messages are delivered asynchronously, as the SPINE devices write
+// messages while processing received ones
+type localPipe struct {
+	reader shipapi.ShipConnectionDataReaderInterface
+
+	messages [][]byte
+	closed   bool
+
+	cond *sync.Cond
+	wg   sync.WaitGroup
+}
+
+func newLocalPipe() *localPipe {
+	return &localPipe{
+		cond: sync.NewCond(&sync.Mutex{}),
+	}
+}
+
+var _ shipapi.ShipConnectionDataWriterInterface = (*localPipe)(nil)
+
+// queue a message
+func (p *localPipe) WriteShipMessageWithPayload(message []byte) {
+	p.cond.L.Lock()
+	defer p.cond.L.Unlock()
+
+	if p.closed {
+		return
+	}
+
+	p.messages = append(p.messages, message)
+	p.cond.Signal()
+}
+
+// start delivering the queued messages to a reader
+func (p *localPipe) start(reader shipapi.ShipConnectionDataReaderInterface) {
+	p.reader = reader
+
+	p.wg.Add(1)
+	go p.run()
+}
+
+func (p *localPipe) run() {
+	defer p.wg.Done()
+
+	for {
+		p.cond.L.Lock()
+		for len(p.messages) == 0 && !p.closed {
+			p.cond.Wait()
+		}
+		if p.closed {
+			p.cond.L.Unlock()
+			return
+		}
+
+		message := p.messages[0]
+		p.messages = p.messages[1:]
+		p.cond.L.Unlock()
+
+		p.reader.HandleShipPayloadMessage(message)
+	}
+}
+
+// stop delivering messages, the queued messages are dropped
+func (p *localPipe) close() {
+	p.cond.L.Lock()
+	p.closed = true
+	p.cond.Broadcast()
+	p.cond.L.Unlock()
+
+	p.wg.Wait()
+}
+
+// A connection between the simulator and another service in the same process
+type LocalConnection struct {
+	simulator eebusapi.ServiceInterface
+	service   eebusapi.ServiceInterface
+
+	toSimulator *localPipe
+	toService   *localPipe
+}
+
+// connect the simulator with another service in the same process, without SHIP and mDNS
+//
+// This is used for integration tests of a CEM and the simulated devices in one
+// process. Both services have to be set up, they do not need to be started.
+func (s *Simulator) ConnectLocal(service eebusapi.ServiceInterface) *LocalConnection {
+	return s.ConnectLocalWithRecorder(service, nil)
+}
+
+// connect the simulator with another service in the same process and record the
+// datagrams of the service, like the recorder does for SHIP connections
+//
+// A nil recorder records nothing.
+func (s *Simulator) ConnectLocalWithRecorder(service eebusapi.ServiceInterface, recorder *cem.Recorder) *LocalConnection {
+	connection := &LocalConnection{
+		simulator:   s.Service,
+		service:     service,
+		toSimulator: newLocalPipe(),
+		toService:   newLocalPipe(),
+	}
+
+	simulatorSki := s.Service.LocalService().SKI()
+	serviceSki := service.LocalService().SKI()
+
+	// setting up a remote device sends the detailed discovery request, which is queued until both are set up
+	var toSimulator shipapi.ShipConnectionDataWriterInterface = connection.toSimulator
+	if recorder != nil {
+		toSimulator = recorder.WrapWriter(simulatorSki, toSimulator)
+	}
+
+	fromSimulator := service.LocalDevice().SetupRemoteDevice(simulatorSki, toSimulator)
+	fromService := s.Service.LocalDevice().SetupRemoteDevice(serviceSki, connection.toService)
+	if recorder != nil {
+		fromSimulator = recorder.WrapReader(simulatorSki, fromSimulator)
+	}
+
+	connection.toSimulator.start(fromService)
+	connection.toService.start(fromSimulator)
+
+	return connection
+}
+
+// returns if the service subscribed to the node management of the simulator
+//
+// Entities added or removed before are not reported to the service,
+// so devices should only be changed once this returns true.
+func (c *LocalConnection) Subscribed() bool {
+	localDevice := c.simulator.LocalDevice()
+	nodeManagement := localDevice.NodeManagement().Address()
+	serviceSki := c.service.LocalService().SKI()
+
+	for _, subscription := range localDevice.SubscriptionManager().SubscriptionsOnFeature(*nodeManagement) {
+		if subscription.ClientFeature != nil && subscription.ClientFeature.Device().Ski() == serviceSki {
+			return true
+		}
+	}
+
+	return false
+}
+
+// disconnect the services, both are notified like for a closed SHIP connection
+func (c *LocalConnection) Close() {
+	c.toSimulator.close()
+	c.toService.close()
+
+	c.service.LocalDevice().RemoveRemoteDeviceConnection(c.simulator.LocalService().SKI())
+	c.simulator.LocalDevice().RemoveRemoteDeviceConnection(c.service.LocalService().SKI())
+}
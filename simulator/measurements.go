@@ -0,0 +1,160 @@
+package simulator
+
+import (
+	"time"
+
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// a measured value of a device
+type measurementPoint struct {
+	measurementType model.MeasurementTypeType
+	scope           model.ScopeTypeType
+	unit            model.UnitOfMeasurementType
+
+	// the phase the value is measured on, empty for values not related to a phase
+	phase model.ElectricalConnectionPhaseNameType
+
+	// the permitted minimum and maximum of the value, only used if max is not 0
+	min, max float64
+}
+
+// the measurement and electrical connection features of an entity
+//
+// The measurement ids and the electrical connection parameter ids are the
+// indices of the measurement points. All points belong to the electrical
+// connection with the id 0.
+type measurements struct {
+	points []measurementPoint
+
+	measurement          spineapi.FeatureLocalInterface
+	electricalConnection spineapi.FeatureLocalInterface
+}
+
+// add the measurement and electrical connection features with the descriptions of the points
+//
+// parameters:
+//   - entity: the entity of the device
+//   - phases: the number of connected phases
+//   - direction: the energy direction of positive values
+//   - points: the measured values
+func newMeasurements(
+	entity spineapi.EntityLocalInterface,
+	phases uint,
+	direction model.EnergyDirectionType,
+	points ...measurementPoint) *measurements {
+	m := &measurements{
+		points: points,
+		measurement: addServerFeature(entity, model.FeatureTypeTypeMeasurement,
+			model.FunctionTypeMeasurementDescriptionListData,
+			model.FunctionTypeMeasurementListData),
+		electricalConnection: addServerFeature(entity, model.FeatureTypeTypeElectricalConnection,
+			model.FunctionTypeElectricalConnectionDescriptionListData,
+			model.FunctionTypeElectricalConnectionParameterDescriptionListData,
+			model.FunctionTypeElectricalConnectionPermittedValueSetListData),
+	}
+
+	measurementDescriptions := &model.MeasurementDescriptionListDataType{}
+	parameterDescriptions := &model.ElectricalConnectionParameterDescriptionListDataType{}
+	permittedValues := &model.ElectricalConnectionPermittedValueSetListDataType{}
+
+	for index, point := range points {
+		measurementId := eebusutil.Ptr(model.MeasurementIdType(index))
+		parameterId := eebusutil.Ptr(model.ElectricalConnectionParameterIdType(index))
+
+		measurementDescriptions.MeasurementDescriptionData = append(measurementDescriptions.MeasurementDescriptionData,
+			model.MeasurementDescriptionDataType{
+				MeasurementId:   measurementId,
+				MeasurementType: eebusutil.Ptr(point.measurementType),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				Unit:            eebusutil.Ptr(point.unit),
+				ScopeType:       eebusutil.Ptr(point.scope),
+			})
+
+		parameterDescription := model.ElectricalConnectionParameterDescriptionDataType{
+			ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+			ParameterId:            parameterId,
+			MeasurementId:          measurementId,
+			VoltageType:            eebusutil.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+		}
+		if point.phase != "" {
+			parameterDescription.AcMeasuredPhases = eebusutil.Ptr(point.phase)
+		}
+		parameterDescriptions.ElectricalConnectionParameterDescriptionData = append(
+			parameterDescriptions.ElectricalConnectionParameterDescriptionData, parameterDescription)
+
+		if point.max == 0 {
+			continue
+		}
+
+		permittedValues.ElectricalConnectionPermittedValueSetData = append(permittedValues.ElectricalConnectionPermittedValueSetData,
+			model.ElectricalConnectionPermittedValueSetDataType{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				ParameterId:            parameterId,
+				PermittedValueSet: []model.ScaledNumberSetType{
+					{
+						Range: []model.ScaledNumberRangeType{
+							{
+								Min: model.NewScaledNumberType(point.min),
+								Max: model.NewScaledNumberType(point.max),
+							},
+						},
+					},
+				},
+			})
+	}
+
+	m.measurement.SetData(model.FunctionTypeMeasurementDescriptionListData, measurementDescriptions)
+
+	m.electricalConnection.SetData(model.FunctionTypeElectricalConnectionDescriptionListData,
+		&model.ElectricalConnectionDescriptionListDataType{
+			ElectricalConnectionDescriptionData: []model.ElectricalConnectionDescriptionDataType{
+				{
+					ElectricalConnectionId:  eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+					PowerSupplyType:         eebusutil.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+					AcConnectedPhases:       eebusutil.Ptr(phases),
+					PositiveEnergyDirection: eebusutil.Ptr(direction),
+				},
+			},
+		})
+	m.electricalConnection.SetData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, parameterDescriptions)
+	m.electricalConnection.SetData(model.FunctionTypeElectricalConnectionPermittedValueSetListData, permittedValues)
+
+	return m
+}
+
+// set the measured values, values[i] is the value of the i-th measurement point
+//
+// parameters:
+//   - values: the measured values
+//   - state: the state of all values, e.g. error for an injected fault
+func (m *measurements) publish(values []float64, state model.MeasurementValueStateType) {
+	// the simulated time may run faster than the wall clock, but clients use the
+	// timestamps to detect stale values, so the wall clock time is reported
+	timestamp := model.NewAbsoluteOrRelativeTimeTypeFromTime(time.Now())
+
+	data := &model.MeasurementListDataType{}
+	for index, value := range values {
+		data.MeasurementData = append(data.MeasurementData, model.MeasurementDataType{
+			MeasurementId: eebusutil.Ptr(model.MeasurementIdType(index)),
+			ValueType:     eebusutil.Ptr(model.MeasurementValueTypeTypeValue),
+			Timestamp:     timestamp,
+			Value:         model.NewScaledNumberType(value),
+			ValueSource:   eebusutil.Ptr(model.MeasurementValueSourceTypeMeasuredValue),
+			ValueState:    eebusutil.Ptr(state),
+		})
+	}
+
+	m.measurement.SetData(model.FunctionTypeMeasurementListData, data)
+}
+
+// returns the state of the published values
+func measurementState(fault bool) model.MeasurementValueStateType {
+	if fault {
+		return model.MeasurementValueStateTypeError
+	}
+
+	return model.MeasurementValueStateTypeNormal
+}
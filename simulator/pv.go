@@ -0,0 +1,154 @@
+package simulator
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+type PVOptions struct {
+	// the name of the device in scripts, empty uses "pv"
+	Name string
+
+	// the peak power of the PV system in W, 0 uses 10000
+	PeakPower float64
+
+	// the hours of the day the production starts and ends, both 0 use 6 and 20
+	Sunrise float64
+	Sunset  float64
+}
+
+// the measurement ids of the PV system
+const (
+	pvPower = iota
+	pvYield
+)
+
+// PV simulates the inverter of a PV system
+//
+// The production follows a sine curve between sunrise and sunset with the peak
+// power halfway between them, reduced by the clouds. The PV system supports the VAPD use case.
+//
+// Script actions:
+//   - clouds <factor>: the share of the clear sky production between 0 and 1 blocked by clouds
+//   - fault: the inverter stops producing and reports its values with the error state
+//   - clear: clear the fault
+type PV struct {
+	options PVOptions
+
+	measurements *measurements
+
+	clouds float64
+	power  float64
+	yield  float64
+	fault  bool
+}
+
+func NewPV(options PVOptions) *PV {
+	if options.Name == "" {
+		options.Name = "pv"
+	}
+	if options.PeakPower == 0 {
+		options.PeakPower = 10000
+	}
+	if options.Sunrise == 0 && options.Sunset == 0 {
+		options.Sunrise = 6
+		options.Sunset = 20
+	}
+
+	return &PV{
+		options: options,
+	}
+}
+
+var _ DeviceInterface = (*PV)(nil)
+var _ PowerSourceInterface = (*PV)(nil)
+
+func (p *PV) Name() string {
+	return p.options.Name
+}
+
+func (p *PV) EntityType() model.EntityTypeType {
+	return model.EntityTypeTypePVSystem
+}
+
+func (p *PV) Setup(entity spineapi.EntityLocalInterface) {
+	addManufacturer(entity, "Demo", "Inverter", "pv-0001")
+
+	addConfiguration(entity, configurationKey{
+		name:      model.DeviceConfigurationKeyNameTypePeakPowerOfPVSystem,
+		valueType: model.DeviceConfigurationKeyValueTypeTypeScaledNumber,
+		unit:      model.UnitOfMeasurementTypeW,
+		value:     model.DeviceConfigurationKeyValueValueType{ScaledNumber: model.NewScaledNumberType(p.options.PeakPower)},
+	})
+
+	p.measurements = newMeasurements(entity, 3, model.EnergyDirectionTypeProduce,
+		measurementPoint{
+			measurementType: model.MeasurementTypeTypePower,
+			scope:           model.ScopeTypeTypeACPowerTotal,
+			unit:            model.UnitOfMeasurementTypeW,
+			phase:           model.ElectricalConnectionPhaseNameTypeAbc,
+		},
+		measurementPoint{
+			measurementType: model.MeasurementTypeTypeEnergy,
+			scope:           model.ScopeTypeTypeACYieldTotal,
+			unit:            model.UnitOfMeasurementTypeWh,
+		},
+	)
+
+	addUseCases(entity, model.UseCaseActorTypePVSystem,
+		useCase{model.UseCaseNameTypeVisualizationOfAggregatedPhotovoltaicData, []model.UseCaseScenarioSupportType{1, 2, 3}},
+	)
+}
+
+// returns the produced power in W as a negative value
+func (p *PV) Power() float64 {
+	return -p.power
+}
+
+// returns the production of a clear sky at a time of the day
+func (p *PV) clearSkyPower(now time.Time) float64 {
+	hour := float64(now.Hour()) + float64(now.Minute())/60 + float64(now.Second())/3600
+	if hour <= p.options.Sunrise || hour >= p.options.Sunset {
+		return 0
+	}
+
+	return p.options.PeakPower * math.Sin(math.Pi*(hour-p.options.Sunrise)/(p.options.Sunset-p.options.Sunrise))
+}
+
+func (p *PV) Step(now time.Time, delta time.Duration) {
+	if p.fault {
+		p.power = 0
+		return
+	}
+
+	p.power = p.clearSkyPower(now) * (1 - p.clouds)
+	p.yield += p.power * delta.Hours()
+}
+
+func (p *PV) Publish() {
+	p.measurements.publish([]float64{pvPower: p.power, pvYield: p.yield}, measurementState(p.fault))
+}
+
+func (p *PV) Action(name string, args []string) error {
+	switch name {
+	case "clouds":
+		clouds, err := floatArgument(args, 0, 0)
+		if err != nil {
+			return err
+		}
+		p.clouds = math.Max(0, math.Min(1, clouds))
+	case "fault":
+		p.fault = true
+		p.power = 0
+	case "clear":
+		p.fault = false
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownAction, name)
+	}
+
+	return nil
+}
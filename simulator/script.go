@@ -0,0 +1,98 @@
+package simulator
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// A scripted action of a device
+type Step struct {
+	// the simulated time since the start of the simulation
+	At time.Duration
+
+	// the name of the device
+	Device string
+
+	// the action and its arguments, e.g. "plugin" with the state of charge of the EV
+	Action string
+	Args   []string
+}
+
+func (s Step) String() string {
+	return strings.Join(append([]string{s.At.String(), s.Device, s.Action}, s.Args...), " ")
+}
+
+// parse a script
+//
+// Each line contains a step with the simulated time since the start, the name
+// of the device, the action and its arguments. Empty lines and lines starting
+// with # are ignored:
+//
+//	# the EV arrives at 8:00 with 20%
+//	2h   wallbox plugin 20
+//	2h5m wallbox fault E42
+//	2h6m wallbox clear
+//	4h   pv     clouds 0.3
+//	9h   wallbox unplug
+func ParseScript(reader io.Reader) ([]Step, error) {
+	var result []Step
+
+	scanner := bufio.NewScanner(reader)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("line %d: expected time, device and action", line)
+		}
+
+		at, err := time.ParseDuration(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		result = append(result, Step{
+			At:     at,
+			Device: fields[1],
+			Action: fields[2],
+			Args:   fields[3:],
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// run an action of a device immediately
+//
+// the state is published afterwards, so the connected remote devices are notified
+//
+// possible errors:
+//   - ErrUnknownDevice if there is no device with the name
+//   - the errors of the action
+func (s *Simulator) Action(deviceName, action string, args ...string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	device, err := s.device(deviceName)
+	if err != nil {
+		return err
+	}
+
+	if err := device.Action(action, args); err != nil {
+		return err
+	}
+
+	device.Publish()
+
+	return nil
+}
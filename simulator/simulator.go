@@ -0,0 +1,306 @@
+package simulator
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/service"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+var ErrUnknownDevice = errors.New("unknown device")
+var ErrUnknownAction = errors.New("unknown action")
+var ErrInvalidArguments = errors.New("invalid arguments")
+
+// the default simulated duration of a tick
+const DefaultInterval = time.Second
+
+type Options struct {
+	// the simulated duration each tick of a started simulator advances, 0 uses DefaultInterval
+	Interval time.Duration
+
+	// the factor the simulated time runs faster than the wall clock, 0 uses 1
+	Speed float64
+
+	// the simulated time at the start, the zero value uses 6:00 of the current day
+	StartTime time.Time
+}
+
+// Simulator runs an EEBUS service with simulated devices
+//
+// Each device is represented by an entity of the service, so a CEM connected to
+// the service sees them like the entities of a real installation. The simulated
+// time only advances with Advance or the ticks of a started simulator.
+type Simulator struct {
+	Service eebusapi.ServiceInterface
+
+	options Options
+
+	devices []DeviceInterface
+	script  []Step
+
+	// the simulated time since the start
+	elapsed time.Duration
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+
+	mux sync.Mutex
+}
+
+func NewSimulator(
+	serviceDescription *eebusapi.Configuration,
+	serviceHandler eebusapi.ServiceReaderInterface,
+	options Options,
+	log logging.LoggingInterface) *Simulator {
+	if options.Interval <= 0 {
+		options.Interval = DefaultInterval
+	}
+	if options.Speed <= 0 {
+		options.Speed = 1
+	}
+	if options.StartTime.IsZero() {
+		now := time.Now()
+		options.StartTime = time.Date(now.Year(), now.Month(), now.Day(), 6, 0, 0, 0, now.Location())
+	}
+
+	sim := &Simulator{
+		Service: service.NewService(serviceDescription, serviceHandler),
+		options: options,
+	}
+
+	sim.Service.SetLogging(log)
+
+	_ = util.SubscribeEvents(sim.Service, sim)
+
+	return sim
+}
+
+// returns the entity types of devices, in the order the configuration of the simulator service needs them
+func EntityTypes(devices ...DeviceInterface) []model.EntityTypeType {
+	var result []model.EntityTypeType
+	for _, device := range devices {
+		result = append(result, device.EntityType())
+	}
+
+	return result
+}
+
+// add a device, this has to be called before Setup
+//
+// the devices are assigned to the entities of the service configuration in
+// order, so the configuration has to contain EntityTypes of all devices
+func (s *Simulator) AddDevice(device DeviceInterface) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.devices = append(s.devices, device)
+}
+
+// returns the device with a name
+func (s *Simulator) Device(name string) (DeviceInterface, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.device(name)
+}
+
+// returns the device with a name, has to be called with the lock held
+func (s *Simulator) device(name string) (DeviceInterface, error) {
+	for _, device := range s.devices {
+		if device.Name() == name {
+			return device, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownDevice, name)
+}
+
+// Set up the EEBUS service and the features of the devices
+func (s *Simulator) Setup() error {
+	if err := s.Service.Setup(); err != nil {
+		return err
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// the first entity contains the device information
+	entities := slices.Clone(s.Service.LocalDevice().Entities()[1:])
+
+	for _, device := range s.devices {
+		index := slices.IndexFunc(entities, func(entity spineapi.EntityLocalInterface) bool {
+			return entity.EntityType() == device.EntityType()
+		})
+		if index < 0 {
+			return fmt.Errorf("no %s entity for the device %s", device.EntityType(), device.Name())
+		}
+
+		device.Setup(entities[index])
+		device.Publish()
+
+		entities = slices.Delete(entities, index, index+1)
+	}
+
+	return nil
+}
+
+// add script steps, they are run when the simulated time reaches them
+//
+// possible errors:
+//   - ErrUnknownDevice if a step refers to a device which was not added
+func (s *Simulator) AddSteps(steps ...Step) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, step := range steps {
+		if _, err := s.device(step.Device); err != nil {
+			return err
+		}
+	}
+
+	s.script = append(s.script, steps...)
+	slices.SortStableFunc(s.script, func(a, b Step) int {
+		return cmp.Compare(a.At, b.At)
+	})
+
+	return nil
+}
+
+// Start the EEBUS service and the simulated time
+//
+// each tick advances the simulated time by the interval, the ticks happen every interval divided by the speed
+func (s *Simulator) Start() {
+	s.Service.Start()
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.stop != nil {
+		return
+	}
+
+	s.stop = make(chan struct{})
+	s.wg.Add(1)
+	go s.run(s.stop)
+}
+
+func (s *Simulator) run(stop chan struct{}) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(time.Duration(float64(s.options.Interval) / s.options.Speed))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			s.Advance(s.options.Interval)
+		}
+	}
+}
+
+// Stop the simulated time and shutdown the EEBUS service
+func (s *Simulator) Shutdown() {
+	s.mux.Lock()
+	if s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+	}
+	s.mux.Unlock()
+
+	s.wg.Wait()
+
+	s.Service.Shutdown()
+
+	util.RemoveServiceEvents(s.Service)
+}
+
+// returns the current simulated time
+func (s *Simulator) Now() time.Time {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.options.StartTime.Add(s.elapsed)
+}
+
+// advance the simulated time
+//
+// the devices are stepped at most by the interval at a time, the script steps
+// are run at their time and the current state of all devices is published at the end
+func (s *Simulator) Advance(duration time.Duration) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	end := s.elapsed + duration
+
+	for {
+		s.runScript()
+
+		if s.elapsed >= end {
+			break
+		}
+
+		delta := min(s.options.Interval, end-s.elapsed)
+		// the devices are stepped to the time of the next script step
+		if len(s.script) > 0 && s.script[0].At > s.elapsed {
+			delta = min(delta, s.script[0].At-s.elapsed)
+		}
+
+		s.elapsed += delta
+		now := s.options.StartTime.Add(s.elapsed)
+
+		for _, device := range s.devices {
+			device.Step(now, delta)
+		}
+	}
+
+	for _, device := range s.devices {
+		device.Publish()
+	}
+}
+
+// run the script steps which are due, has to be called with the lock held
+func (s *Simulator) runScript() {
+	for len(s.script) > 0 && s.script[0].At <= s.elapsed {
+		step := s.script[0]
+		s.script = s.script[1:]
+
+		device, err := s.device(step.Device)
+		if err == nil {
+			err = device.Action(step.Action, step.Args)
+		}
+		if err != nil {
+			logging.Log().Errorf("script step %s: %s", step, err)
+			continue
+		}
+
+		logging.Log().Info("script step ", step)
+	}
+}
+
+var _ spineapi.EventHandlerInterface = (*Simulator)(nil)
+
+// handle SPINE events
+//
+// the spine stack stores data written by a remote device without notifying
+// the other subscribers, so the data is set again to notify them
+func (s *Simulator) HandleEvent(payload spineapi.EventPayload) {
+	if payload.EventType != spineapi.EventTypeDataChange ||
+		payload.CmdClassifier == nil ||
+		*payload.CmdClassifier != model.CmdClassifierTypeWrite ||
+		payload.LocalFeature == nil {
+		return
+	}
+
+	payload.LocalFeature.SetData(payload.Function, payload.LocalFeature.DataCopy(payload.Function))
+}
@@ -0,0 +1,275 @@
+package simulator
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevsoc"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/ucvapd"
+	eebusapi "github.com/enbility/eebus-go/api"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSimulatorSuite(t *testing.T) {
+	suite.Run(t, new(SimulatorSuite))
+}
+
+type SimulatorSuite struct {
+	suite.Suite
+
+	sut     *Simulator
+	wallbox *Wallbox
+	pv      *PV
+	battery *Battery
+	grid    *GridMeter
+
+	cem   *cem.Cem
+	evcc  ucevcc.UCEVCCInterface
+	evsoc ucevsoc.UCEVSOCInterface
+	opev  ucopev.UCOPEVInterface
+	mgcp  ucmgcp.UCMGCPInterface
+	vapd  ucvapd.UCVAPDInterface
+
+	// the last entity of each event
+	entities map[api.EventType]spineapi.EntityRemoteInterface
+	mux      sync.Mutex
+}
+
+func (s *SimulatorSuite) BeforeTest(suiteName, testName string) {
+	s.entities = make(map[api.EventType]spineapi.EntityRemoteInterface)
+
+	s.wallbox = NewWallbox(WallboxOptions{})
+	s.pv = NewPV(PVOptions{})
+	s.grid = NewGridMeter(GridMeterOptions{})
+	s.battery = NewBattery(BatteryOptions{StateOfCharge: 50, Meter: s.grid})
+	s.grid.AddSource(s.wallbox)
+	s.grid.AddSource(s.pv)
+	s.grid.AddSource(s.battery)
+
+	devices := []DeviceInterface{s.wallbox, s.pv, s.battery, s.grid}
+
+	certificate, err := cert.CreateCertificate("Demo", "Demo", "DE", "Demo-Unit-20")
+	assert.Nil(s.T(), err)
+	configuration, err := eebusapi.NewConfiguration(
+		"Demo", "Demo", "Simulator", "234567890",
+		model.DeviceTypeTypeGeneric,
+		EntityTypes(devices...),
+		7660, certificate, 230, time.Second*4)
+	assert.Nil(s.T(), err)
+
+	options := Options{
+		StartTime: time.Date(2024, 6, 1, 6, 0, 0, 0, time.UTC),
+		Interval:  time.Minute,
+	}
+	s.sut = NewSimulator(configuration, s, options, &logging.NoLogging{})
+	for _, device := range devices {
+		s.sut.AddDevice(device)
+	}
+	assert.Nil(s.T(), s.sut.Setup())
+
+	certificate, err = cert.CreateCertificate("Demo", "Demo", "DE", "Demo-Unit-21")
+	assert.Nil(s.T(), err)
+	configuration, err = eebusapi.NewConfiguration(
+		"Demo", "Demo", "HEMS", "123456789",
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		7661, certificate, 230, time.Second*4)
+	assert.Nil(s.T(), err)
+
+	s.cem = cem.NewCEM(configuration, s, s.eventCB, &logging.NoLogging{})
+	assert.Nil(s.T(), s.cem.Setup())
+
+	s.evcc = ucevcc.NewUCEVCC(s.cem.Service, s.eventCB)
+	s.evsoc = ucevsoc.NewUCEVSOC(s.cem.Service, s.eventCB)
+	s.opev = ucopev.NewUCOPEV(s.cem.Service, s.eventCB)
+	s.mgcp = ucmgcp.NewUCMGCP(s.cem.Service, s.eventCB)
+	s.vapd = ucvapd.NewUCVAPD(s.cem.Service, s.eventCB)
+	for _, usecase := range []api.UseCaseInterface{s.evcc, s.evsoc, s.opev, s.mgcp, s.vapd} {
+		s.cem.AddUseCase(usecase)
+	}
+}
+
+func (s *SimulatorSuite) AfterTest(suiteName, testName string) {
+	s.sut.Shutdown()
+	s.cem.Shutdown()
+}
+
+func (s *SimulatorSuite) eventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.entities[event] = entity
+}
+
+// waits for an event and returns its entity
+func (s *SimulatorSuite) waitForEntity(event api.EventType) spineapi.EntityRemoteInterface {
+	var entity spineapi.EntityRemoteInterface
+	assert.Eventually(s.T(), func() bool {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+
+		entity = s.entities[event]
+		return entity != nil
+	}, 5*time.Second, 10*time.Millisecond, "missing event %s", event)
+
+	return entity
+}
+
+func (s *SimulatorSuite) Test_Script() {
+	steps, err := ParseScript(strings.NewReader(`
+		# comment
+		1h wallbox plugin 30
+		2h pv clouds 0.5
+	`))
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []Step{
+		{At: time.Hour, Device: "wallbox", Action: "plugin", Args: []string{"30"}},
+		{At: 2 * time.Hour, Device: "pv", Action: "clouds", Args: []string{"0.5"}},
+	}, steps)
+
+	_, err = ParseScript(strings.NewReader("1h wallbox"))
+	assert.NotNil(s.T(), err)
+	_, err = ParseScript(strings.NewReader("later wallbox plugin"))
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.AddSteps(Step{Device: "heatpump", Action: "on"})
+	assert.ErrorIs(s.T(), err, ErrUnknownDevice)
+
+	err = s.sut.AddSteps(steps...)
+	assert.Nil(s.T(), err)
+
+	s.sut.Advance(30 * time.Minute)
+	assert.False(s.T(), s.wallbox.PluggedIn())
+
+	// the EV charges with 3 phases and 16 A from 7:00
+	s.sut.Advance(time.Hour)
+	assert.True(s.T(), s.wallbox.PluggedIn())
+	assert.Equal(s.T(), 16.0, s.wallbox.Current())
+	assert.InDelta(s.T(), 30+11040.0/2/60000*100, s.wallbox.StateOfCharge(), 0.01)
+	assert.Equal(s.T(), time.Date(2024, 6, 1, 7, 30, 0, 0, time.UTC), s.sut.Now())
+
+	// the PV production at 13:00 is the peak power reduced by the clouds
+	s.sut.Advance(5*time.Hour + 30*time.Minute)
+	assert.InDelta(s.T(), -5000, s.pv.Power(), 0.01)
+
+	// the EV is full, the battery charges with the surplus of the PV production
+	assert.Equal(s.T(), 100.0, s.wallbox.StateOfCharge())
+	assert.Equal(s.T(), 0.0, s.wallbox.Current())
+	assert.InDelta(s.T(), 4500, s.battery.Power(), 0.1)
+	assert.InDelta(s.T(), 0, s.grid.Power(), 0.1)
+
+	// a fault stops the battery, the surplus is fed into the grid
+	err = s.sut.Action("battery", "fault")
+	assert.Nil(s.T(), err)
+	s.sut.Advance(time.Minute)
+	assert.Equal(s.T(), 0.0, s.battery.Power())
+	assert.Less(s.T(), s.grid.Power(), -4000.0)
+
+	err = s.sut.Action("wallbox", "jump")
+	assert.ErrorIs(s.T(), err, ErrUnknownAction)
+	err = s.sut.Action("pv", "clouds", "many")
+	assert.ErrorIs(s.T(), err, ErrInvalidArguments)
+}
+
+func (s *SimulatorSuite) Test_Integration() {
+	connection := s.sut.ConnectLocal(s.cem.Service)
+	defer connection.Close()
+
+	grid := s.waitForEntity(ucmgcp.EntityAdded)
+	pv := s.waitForEntity(ucvapd.EntityAdded)
+
+	// entities added before the CEM subscribed to the node management are missed
+	assert.Eventually(s.T(), connection.Subscribed, 5*time.Second, 10*time.Millisecond)
+
+	// 12:00
+	s.sut.Advance(6 * time.Hour)
+
+	assert.Eventually(s.T(), func() bool {
+		power, err := s.vapd.Power(pv)
+		return err == nil && power > 9000
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Eventually(s.T(), func() bool {
+		factor, err := s.mgcp.PowerLimitationFactor(grid)
+		return err == nil && factor == 70
+	}, 5*time.Second, 10*time.Millisecond)
+
+	err := s.sut.Action("wallbox", "plugin", "40")
+	assert.Nil(s.T(), err)
+	ev := s.waitForEntity(ucevsoc.EntityAdded)
+
+	// the EV charges until the CEM subscribed to its measurements and got notified
+	assert.Eventually(s.T(), func() bool {
+		s.sut.Advance(time.Minute)
+		soc, err := s.evsoc.StateOfCharge(ev)
+		return err == nil && soc > 40
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the EV follows the overload protection limit written by the CEM
+	assert.Eventually(s.T(), func() bool {
+		_, err := s.opev.LoadControlLimits(ev)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	limits := []api.LoadLimitsPhase{
+		{Phase: model.ElectricalConnectionPhaseNameTypeA, IsActive: true, Value: 10},
+		{Phase: model.ElectricalConnectionPhaseNameTypeB, IsActive: true, Value: 10},
+		{Phase: model.ElectricalConnectionPhaseNameTypeC, IsActive: true, Value: 10},
+	}
+	_, err = s.opev.WriteLoadControlLimits(ev, limits)
+	assert.Nil(s.T(), err)
+
+	assert.Eventually(s.T(), func() bool {
+		values, err := s.opev.LoadControlLimits(ev)
+		return err == nil && len(values) == 3 && values[0] == 10
+	}, 5*time.Second, 10*time.Millisecond)
+
+	s.sut.Advance(time.Minute)
+	assert.Equal(s.T(), 10.0, s.wallbox.Current())
+
+	err = s.sut.Action("wallbox", "unplug")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ev, s.waitForEntity(ucevsoc.EntityRemoved))
+}
+
+func (s *SimulatorSuite) Test_LocalConnectionSubscribed() {
+	connection := s.sut.ConnectLocal(s.cem.Service)
+	defer connection.Close()
+
+	assert.Eventually(s.T(), connection.Subscribed, 5*time.Second, 10*time.Millisecond)
+
+	// an EV plugged in right after the subscription is reported without further simulation steps
+	err := s.sut.Action("wallbox", "plugin", "40")
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), s.waitForEntity(ucevsoc.EntityAdded))
+
+	connection.Close()
+	assert.False(s.T(), connection.Subscribed())
+}
+
+// eebusapi.ServiceReaderInterface
+
+func (s *SimulatorSuite) RemoteSKIConnected(service eebusapi.ServiceInterface, ski string) {}
+
+func (s *SimulatorSuite) RemoteSKIDisconnected(service eebusapi.ServiceInterface, ski string) {}
+
+func (s *SimulatorSuite) VisibleRemoteServicesUpdated(service eebusapi.ServiceInterface, entries []shipapi.RemoteService) {
+}
+
+func (s *SimulatorSuite) ServiceShipIDUpdate(ski string, shipdID string) {}
+
+func (s *SimulatorSuite) ServicePairingDetailUpdate(ski string, detail *shipapi.ConnectionStateDetail) {
+}
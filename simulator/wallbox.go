@@ -0,0 +1,441 @@
+package simulator
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/enbility/cemd/util"
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+type WallboxOptions struct {
+	// the name of the device in scripts, empty uses "wallbox"
+	Name string
+
+	// the brand reported in the manufacturer details, empty uses "Demo"
+	Brand string
+
+	// the number of phases the EV charges with, 0 uses 3
+	Phases uint
+
+	// the minimum and maximum charging current per phase in A, 0 uses 6 and 16
+	MinCurrent float64
+	MaxCurrent float64
+
+	// the voltage of each phase in V, 0 uses 230
+	Voltage float64
+
+	// the usable battery capacity of the EV in Wh, 0 uses 60000
+	Capacity float64
+
+	// the communication standard of the EV, empty uses ISO15118-2 ED1
+	CommunicationStandard model.DeviceConfigurationKeyValueStringType
+
+	// the MAC address the EV identifies with, empty uses "0123456789AB"
+	Identification string
+}
+
+// the measurement ids of the EV
+const (
+	evCurrentL1 = iota
+	evCurrentL2
+	evCurrentL3
+	evPowerL1
+	evPowerL2
+	evPowerL3
+	evEnergyCharged
+	evStateOfCharge
+)
+
+// the EV load control limit ids, the overload protection limits come first, followed by the
+// self consumption limits, both for each phase
+const (
+	evObligationLimits     = 0
+	evRecommendationLimits = 3
+)
+
+// Wallbox simulates an EVSE and an EV which can be plugged in
+//
+// The EV supports the EVCC, EVCEM, EVSOC, OPEV and OSCEV use cases, the EVSE
+// the EVSECC use case. The EV charges with the maximum current, reduced by the
+// active overload protection and self consumption limits. Below the minimum
+// current the charging is paused.
+//
+// Script actions:
+//   - plugin [soc]: plug in the EV with a state of charge in %, the default is 20
+//   - unplug: unplug the EV
+//   - soc <soc>: set the state of charge of the EV in %
+//   - fault [code]: set the EVSE into failure state, the charging stops
+//   - clear: clear the fault
+type Wallbox struct {
+	options WallboxOptions
+
+	evse          spineapi.EntityLocalInterface
+	evseDiagnosis spineapi.FeatureLocalInterface
+
+	ev            spineapi.EntityLocalInterface
+	evDiagnosis   spineapi.FeatureLocalInterface
+	evLoadControl spineapi.FeatureLocalInterface
+	evMeasurement *measurements
+
+	soc       float64
+	charged   float64
+	current   float64
+	faultCode string
+	fault     bool
+}
+
+func NewWallbox(options WallboxOptions) *Wallbox {
+	if options.Name == "" {
+		options.Name = "wallbox"
+	}
+	if options.Brand == "" {
+		options.Brand = "Demo"
+	}
+	if options.Phases == 0 {
+		options.Phases = 3
+	}
+	if options.MinCurrent == 0 {
+		options.MinCurrent = 6
+	}
+	if options.MaxCurrent == 0 {
+		options.MaxCurrent = 16
+	}
+	if options.Voltage == 0 {
+		options.Voltage = 230
+	}
+	if options.Capacity == 0 {
+		options.Capacity = 60000
+	}
+	if options.CommunicationStandard == "" {
+		options.CommunicationStandard = model.DeviceConfigurationKeyValueStringTypeISO151182ED1
+	}
+	if options.Identification == "" {
+		options.Identification = "0123456789AB"
+	}
+
+	return &Wallbox{
+		options: options,
+	}
+}
+
+var _ DeviceInterface = (*Wallbox)(nil)
+var _ PowerSourceInterface = (*Wallbox)(nil)
+
+func (w *Wallbox) Name() string {
+	return w.options.Name
+}
+
+func (w *Wallbox) EntityType() model.EntityTypeType {
+	return model.EntityTypeTypeEVSE
+}
+
+func (w *Wallbox) Setup(entity spineapi.EntityLocalInterface) {
+	w.evse = entity
+
+	addManufacturer(entity, w.options.Brand, "Wallbox", "wallbox-0001")
+	w.evseDiagnosis = addDiagnosis(entity)
+
+	addUseCases(entity, model.UseCaseActorTypeEVSE,
+		useCase{model.UseCaseNameTypeEVSECommissioningAndConfiguration, []model.UseCaseScenarioSupportType{1, 2}},
+	)
+}
+
+// returns if an EV is plugged in
+func (w *Wallbox) PluggedIn() bool {
+	return w.ev != nil
+}
+
+// returns the state of charge of the EV in %
+func (w *Wallbox) StateOfCharge() float64 {
+	return w.soc
+}
+
+// returns the charging current per phase in A
+func (w *Wallbox) Current() float64 {
+	return w.current
+}
+
+// returns the charging power in W
+func (w *Wallbox) Power() float64 {
+	return w.current * w.options.Voltage * float64(w.options.Phases)
+}
+
+func (w *Wallbox) Step(now time.Time, delta time.Duration) {
+	w.current = w.chargingCurrent()
+
+	energy := w.Power() * delta.Hours()
+	w.charged += energy
+	w.soc = math.Min(100, w.soc+energy/w.options.Capacity*100)
+}
+
+// returns the current the EV charges with per phase
+func (w *Wallbox) chargingCurrent() float64 {
+	if w.ev == nil || w.fault || w.soc >= 100 {
+		return 0
+	}
+
+	current := w.options.MaxCurrent
+
+	limits := spine.LocalFeatureDataCopyOfType[*model.LoadControlLimitListDataType]
+	data, err := limits(w.evLoadControl, model.FunctionTypeLoadControlLimitListData)
+	if err != nil {
+		return current
+	}
+
+	for _, item := range data.LoadControlLimitData {
+		if item.LimitId == nil || item.Value == nil || item.IsLimitActive == nil || !*item.IsLimitActive {
+			continue
+		}
+
+		current = math.Min(current, item.Value.GetValue())
+	}
+
+	if current < w.options.MinCurrent {
+		return 0
+	}
+
+	return current
+}
+
+func (w *Wallbox) Publish() {
+	state := model.DeviceDiagnosisOperatingStateTypeNormalOperation
+	if w.fault {
+		state = model.DeviceDiagnosisOperatingStateTypeFailure
+	}
+	setDiagnosisState(w.evseDiagnosis, state, w.faultCode)
+
+	if w.ev == nil {
+		return
+	}
+
+	evState := model.DeviceDiagnosisOperatingStateTypeNormalOperation
+	switch {
+	case w.fault:
+		evState = model.DeviceDiagnosisOperatingStateTypeFailure
+	case w.soc >= 100:
+		evState = model.DeviceDiagnosisOperatingStateTypeFinished
+	case w.current == 0:
+		evState = model.DeviceDiagnosisOperatingStateTypeStandby
+	}
+	setDiagnosisState(w.evDiagnosis, evState, "")
+
+	values := make([]float64, evStateOfCharge+1)
+	for phase := 0; phase < int(w.options.Phases); phase++ {
+		values[evCurrentL1+phase] = w.current
+		values[evPowerL1+phase] = w.current * w.options.Voltage
+	}
+	values[evEnergyCharged] = w.charged
+	values[evStateOfCharge] = w.soc
+
+	w.evMeasurement.publish(values, measurementState(w.fault))
+}
+
+func (w *Wallbox) Action(name string, args []string) error {
+	switch name {
+	case "plugin":
+		soc, err := floatArgument(args, 0, 20)
+		if err != nil {
+			return err
+		}
+		w.PlugIn(soc)
+	case "unplug":
+		w.Unplug()
+	case "soc":
+		soc, err := floatArgument(args, 0, w.soc)
+		if err != nil {
+			return err
+		}
+		w.soc = math.Max(0, math.Min(100, soc))
+	case "fault":
+		code := "fault"
+		if len(args) > 0 {
+			code = args[0]
+		}
+		w.InjectFault(code)
+	case "clear":
+		w.ClearFault()
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownAction, name)
+	}
+
+	return nil
+}
+
+// plug in the EV, this adds the EV entity
+//
+// parameters:
+//   - soc: the state of charge of the EV in %
+func (w *Wallbox) PlugIn(soc float64) {
+	if w.ev != nil {
+		return
+	}
+
+	w.soc = math.Max(0, math.Min(100, soc))
+	w.charged = 0
+	w.current = 0
+
+	localDevice := w.evse.Device()
+	address := append(append([]model.AddressEntityType{}, w.evse.Address().Entity...), 1)
+	ev := spine.NewEntityLocal(localDevice, model.EntityTypeTypeEV, address)
+
+	addManufacturer(ev, "Demo", "EV", "ev-0001")
+
+	addConfiguration(ev,
+		configurationKey{
+			name:      model.DeviceConfigurationKeyNameTypeCommunicationsStandard,
+			valueType: model.DeviceConfigurationKeyValueTypeTypeString,
+			value:     model.DeviceConfigurationKeyValueValueType{String: eebusutil.Ptr(w.options.CommunicationStandard)},
+		},
+		configurationKey{
+			name:      model.DeviceConfigurationKeyNameTypeAsymmetricChargingSupported,
+			valueType: model.DeviceConfigurationKeyValueTypeTypeBoolean,
+			value:     model.DeviceConfigurationKeyValueValueType{Boolean: eebusutil.Ptr(false)},
+		},
+	)
+
+	identification := addServerFeature(ev, model.FeatureTypeTypeIdentification, model.FunctionTypeIdentificationListData)
+	identification.SetData(model.FunctionTypeIdentificationListData, &model.IdentificationListDataType{
+		IdentificationData: []model.IdentificationDataType{
+			{
+				IdentificationId:    eebusutil.Ptr(model.IdentificationIdType(0)),
+				IdentificationType:  eebusutil.Ptr(model.IdentificationTypeTypeEui48),
+				IdentificationValue: eebusutil.Ptr(model.IdentificationValueType(w.options.Identification)),
+			},
+		},
+	})
+
+	w.evDiagnosis = addDiagnosis(ev)
+
+	var points []measurementPoint
+	for _, measurement := range []struct {
+		measurementType model.MeasurementTypeType
+		scope           model.ScopeTypeType
+		unit            model.UnitOfMeasurementType
+	}{
+		{model.MeasurementTypeTypeCurrent, model.ScopeTypeTypeACCurrent, model.UnitOfMeasurementTypeA},
+		{model.MeasurementTypeTypePower, model.ScopeTypeTypeACPower, model.UnitOfMeasurementTypeW},
+	} {
+		for _, phase := range util.PhaseNameMapping {
+			point := measurementPoint{
+				measurementType: measurement.measurementType,
+				scope:           measurement.scope,
+				unit:            measurement.unit,
+				phase:           phase,
+			}
+			if measurement.measurementType == model.MeasurementTypeTypeCurrent {
+				point.min = w.options.MinCurrent
+				point.max = w.options.MaxCurrent
+			}
+			points = append(points, point)
+		}
+	}
+	points = append(points,
+		measurementPoint{
+			measurementType: model.MeasurementTypeTypeEnergy,
+			scope:           model.ScopeTypeTypeCharge,
+			unit:            model.UnitOfMeasurementTypeWh,
+		},
+		measurementPoint{
+			measurementType: model.MeasurementTypeTypePercentage,
+			scope:           model.ScopeTypeTypeStateOfCharge,
+			unit:            model.UnitOfMeasurementTypepct,
+		},
+	)
+	w.evMeasurement = newMeasurements(ev, w.options.Phases, model.EnergyDirectionTypeConsume, points...)
+
+	w.evLoadControl = w.addLoadControl(ev)
+
+	w.ev = ev
+	w.Publish()
+
+	addUseCases(ev, model.UseCaseActorTypeEV,
+		useCase{model.UseCaseNameTypeEVCommissioningAndConfiguration, []model.UseCaseScenarioSupportType{1, 2, 3, 4, 5, 6, 7, 8}},
+		useCase{model.UseCaseNameTypeMeasurementOfElectricityDuringEVCharging, []model.UseCaseScenarioSupportType{1, 2, 3}},
+		useCase{model.UseCaseNameTypeEVStateOfCharge, []model.UseCaseScenarioSupportType{1}},
+		useCase{model.UseCaseNameTypeOverloadProtectionByEVChargingCurrentCurtailment, []model.UseCaseScenarioSupportType{1, 2, 3}},
+		useCase{model.UseCaseNameTypeOptimizationOfSelfConsumptionDuringEVCharging, []model.UseCaseScenarioSupportType{1, 2, 3}},
+	)
+
+	localDevice.AddEntity(ev)
+}
+
+// add the load control feature with a writable current limit per phase for the overload
+// protection and for the self consumption, the limits refer to the current measurements
+func (w *Wallbox) addLoadControl(ev spineapi.EntityLocalInterface) spineapi.FeatureLocalInterface {
+	feature := ev.GetOrAddFeature(model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+	feature.AddFunctionType(model.FunctionTypeLoadControlLimitDescriptionListData, true, false)
+	feature.AddFunctionType(model.FunctionTypeLoadControlLimitListData, true, true)
+
+	descriptions := &model.LoadControlLimitDescriptionListDataType{}
+	limits := &model.LoadControlLimitListDataType{}
+
+	for _, limit := range []struct {
+		first    int
+		category model.LoadControlCategoryType
+		scope    model.ScopeTypeType
+	}{
+		{evObligationLimits, model.LoadControlCategoryTypeObligation, model.ScopeTypeTypeOverloadProtection},
+		{evRecommendationLimits, model.LoadControlCategoryTypeRecommendation, model.ScopeTypeTypeSelfConsumption},
+	} {
+		for phase := range util.PhaseNameMapping {
+			limitId := eebusutil.Ptr(model.LoadControlLimitIdType(limit.first + phase))
+
+			descriptions.LoadControlLimitDescriptionData = append(descriptions.LoadControlLimitDescriptionData,
+				model.LoadControlLimitDescriptionDataType{
+					LimitId:        limitId,
+					LimitType:      eebusutil.Ptr(model.LoadControlLimitTypeTypeMaxValueLimit),
+					LimitCategory:  eebusutil.Ptr(limit.category),
+					LimitDirection: eebusutil.Ptr(model.EnergyDirectionTypeConsume),
+					MeasurementId:  eebusutil.Ptr(model.MeasurementIdType(evCurrentL1 + phase)),
+					Unit:           eebusutil.Ptr(model.UnitOfMeasurementTypeA),
+					ScopeType:      eebusutil.Ptr(limit.scope),
+				})
+
+			limits.LoadControlLimitData = append(limits.LoadControlLimitData, model.LoadControlLimitDataType{
+				LimitId:           limitId,
+				IsLimitChangeable: eebusutil.Ptr(true),
+				IsLimitActive:     eebusutil.Ptr(false),
+				Value:             model.NewScaledNumberType(w.options.MaxCurrent),
+			})
+		}
+	}
+
+	feature.SetData(model.FunctionTypeLoadControlLimitDescriptionListData, descriptions)
+	feature.SetData(model.FunctionTypeLoadControlLimitListData, limits)
+
+	return feature
+}
+
+// unplug the EV, this removes the EV entity
+func (w *Wallbox) Unplug() {
+	if w.ev == nil {
+		return
+	}
+
+	ev := w.ev
+	w.ev = nil
+	w.current = 0
+
+	ev.Device().RemoveEntity(ev)
+}
+
+// set the EVSE into failure state, the EV stops charging
+//
+// parameters:
+//   - code: the error code reported by the EVSE
+func (w *Wallbox) InjectFault(code string) {
+	w.fault = true
+	w.faultCode = code
+	w.current = 0
+}
+
+// clear an injected fault
+func (w *Wallbox) ClearFault() {
+	w.fault = false
+	w.faultCode = ""
+}
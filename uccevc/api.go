@@ -1,6 +1,8 @@
 package uccevc
 
 import (
+	"context"
+
 	"github.com/enbility/cemd/api"
 	spineapi "github.com/enbility/spine-go/api"
 )
@@ -89,4 +91,55 @@ type UCCEVCInterface interface {
 	// Scenario 5 & 6
 
 	// this is automatically covered by the SPINE implementation
+
+	// context aware variants
+
+	// the getters above with a context
+	//
+	// parameters:
+	//   - ctx: the context, its deadline limits the wait for a fresh value
+	//   - entity: the entity of the device
+	//   - options: if Fresh is set, the data is requested from the remote entity and the reply is awaited before the value is returned
+	//
+	// possible errors:
+	//   - the context error if the context is done before the reply is received
+	//   - and those of the getters above
+	EnergyDemandContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (api.Demand, error)
+	TimeSlotConstraintsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (api.TimeSlotConstraints, error)
+	IncentiveConstraintsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (api.IncentiveSlotConstraints, error)
+	ChargePlanConstraintsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]api.DurationSlotValue, error)
+	ChargePlanContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (api.ChargePlan, error)
+
+	// WritePowerLimits with a context
+	//
+	// parameters:
+	//   - options: if WaitForResult is set, the result of the remote entity is awaited within the context deadline
+	//
+	// possible errors:
+	//   - ErrWriteRejected if the remote entity responded with an error result
+	//   - the context error if the context is done before the result is received
+	//   - and those of WritePowerLimits
+	WritePowerLimitsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, data []api.DurationSlotValue, options api.WriteOptions) error
+
+	// WriteIncentiveTableDescriptions with a context
+	//
+	// parameters:
+	//   - options: if WaitForResult is set, the result of the remote entity is awaited within the context deadline
+	//
+	// possible errors:
+	//   - ErrWriteRejected if the remote entity responded with an error result
+	//   - the context error if the context is done before the result is received
+	//   - and those of WriteIncentiveTableDescriptions
+	WriteIncentiveTableDescriptionsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, data []api.IncentiveTariffDescription, options api.WriteOptions) error
+
+	// WriteIncentives with a context
+	//
+	// parameters:
+	//   - options: if WaitForResult is set, the result of the remote entity is awaited within the context deadline
+	//
+	// possible errors:
+	//   - ErrWriteRejected if the remote entity responded with an error result
+	//   - the context error if the context is done before the result is received
+	//   - and those of WriteIncentives
+	WriteIncentivesContext(ctx context.Context, entity spineapi.EntityRemoteInterface, data []api.DurationSlotValue, options api.WriteOptions) error
 }
@@ -0,0 +1,88 @@
+package uccevc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cemtest"
+	"github.com/enbility/cemd/conformance"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestConformanceSuite(t *testing.T) {
+	suite.Run(t, new(ConformanceSuite))
+}
+
+type ConformanceSuite struct {
+	suite.Suite
+}
+
+const specification = "EEBus_UC_TS_CoordinatedEVCharging"
+
+var requirements = []conformance.Requirement{
+	conformance.UseCaseAnnounced("CEVC-G-01", specification, model.UseCaseActorTypeCEM, 1, 2, 3),
+	conformance.RemoteUseCase("CEVC-G-02", specification, model.UseCaseActorTypeEV, 2, 3, 4, 5, 6, 7, 8),
+	conformance.EntityType("CEVC-G-03", specification, model.EntityTypeTypeEV),
+	conformance.ClientFeature("CEVC-G-04", specification, model.FeatureTypeTypeTimeSeries),
+	conformance.ClientFeature("CEVC-G-05", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.ServerFeature("CEVC-G-06", specification, model.FeatureTypeTypeTimeSeries),
+	conformance.Subscription("CEVC-G-07", specification, model.FeatureTypeTypeTimeSeries),
+	conformance.Binding("CEVC-G-08", specification, model.FeatureTypeTypeTimeSeries),
+	conformance.Read("CEVC-G-09", specification, model.FeatureTypeTypeTimeSeries, model.FunctionTypeTimeSeriesDescriptionListData),
+	conformance.Read("CEVC-G-10", specification, model.FeatureTypeTypeTimeSeries, model.FunctionTypeTimeSeriesConstraintsListData),
+	conformance.Read("CEVC-G-11", specification, model.FeatureTypeTypeTimeSeries, model.FunctionTypeTimeSeriesListData),
+	conformance.ResponseTime("CEVC-G-12", conformance.ImplementationReference,
+		conformance.Notification(model.FeatureTypeTypeTimeSeries, model.FunctionTypeTimeSeriesDescriptionListData),
+		conformance.ReadResponse(model.FeatureTypeTypeTimeSeries, model.FunctionTypeTimeSeriesListData), time.Second),
+	conformance.ClientFeature("CEVC-S1-01", specification, model.FeatureTypeTypeDeviceConfiguration),
+	conformance.Subscription("CEVC-S1-02", specification, model.FeatureTypeTypeDeviceConfiguration),
+	conformance.Read("CEVC-S1-03", specification, model.FeatureTypeTypeDeviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData),
+	conformance.Write("CEVC-S2-01", specification, model.FeatureTypeTypeTimeSeries, model.FunctionTypeTimeSeriesListData,
+		func(c *conformance.Context) error {
+			return c.UseCase.(*UCCEVC).WritePowerLimits(c.Entity, []api.DurationSlotValue{
+				{Duration: time.Hour, Value: 11000},
+			})
+		}),
+	conformance.ClientFeature("CEVC-S3-01", specification, model.FeatureTypeTypeIncentiveTable),
+	conformance.ServerFeature("CEVC-S3-02", specification, model.FeatureTypeTypeIncentiveTable),
+	conformance.Subscription("CEVC-S3-03", specification, model.FeatureTypeTypeIncentiveTable),
+	conformance.Binding("CEVC-S3-04", specification, model.FeatureTypeTypeIncentiveTable),
+	conformance.Read("CEVC-S3-05", specification, model.FeatureTypeTypeIncentiveTable, model.FunctionTypeIncentiveTableDescriptionData),
+	conformance.Read("CEVC-S3-06", specification, model.FeatureTypeTypeIncentiveTable, model.FunctionTypeIncentiveTableConstraintsData),
+	conformance.Read("CEVC-S3-07", specification, model.FeatureTypeTypeIncentiveTable, model.FunctionTypeIncentiveTableData),
+	conformance.Write("CEVC-S3-08", specification, model.FeatureTypeTypeIncentiveTable, model.FunctionTypeIncentiveTableDescriptionData,
+		func(c *conformance.Context) error {
+			return c.UseCase.(*UCCEVC).WriteIncentiveTableDescriptions(c.Entity, []api.IncentiveTariffDescription{
+				{
+					Tiers: []api.IncentiveTableDescriptionTier{
+						{
+							Id:         0,
+							Type:       model.TierTypeTypeDynamicCost,
+							Boundaries: []api.TierBoundaryDescription{{Id: 0, Type: model.TierBoundaryTypeTypePowerBoundary, Unit: model.UnitOfMeasurementTypeW}},
+							Incentives: []api.IncentiveDescription{{Id: 0, Type: model.IncentiveTypeTypeAbsoluteCost, Currency: model.CurrencyTypeEur}},
+						},
+					},
+				},
+			})
+		}),
+	conformance.Write("CEVC-S3-09", specification, model.FeatureTypeTypeIncentiveTable, model.FunctionTypeIncentiveTableData,
+		func(c *conformance.Context) error {
+			return c.UseCase.(*UCCEVC).WriteIncentives(c.Entity, []api.DurationSlotValue{
+				{Duration: time.Hour, Value: 0.30},
+			})
+		}),
+}
+
+func (s *ConformanceSuite) Test_Requirements() {
+	conformance.Verify(s.T(), conformance.Setup{
+		UseCase: func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) api.UseCaseInterface {
+			return NewUCCEVC(service, eventCB)
+		},
+		Remote: func(device *cemtest.DeviceBuilder) *cemtest.EntityBuilder {
+			return cemtest.EVCoordinatedCharging(cemtest.EVISO15118WithSoC(cemtest.EVSE3Phase(device), 50))
+		},
+	}, requirements)
+}
@@ -0,0 +1,115 @@
+package uccevc
+
+import (
+	"context"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// context aware variant of EnergyDemand
+func (e *UCCEVC) EnergyDemandContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (api.Demand, error) {
+	requests := []util.DataRequest{util.TimeSeriesValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (api.Demand, error) {
+		return e.EnergyDemand(entity)
+	})
+}
+
+// context aware variant of TimeSlotConstraints
+func (e *UCCEVC) TimeSlotConstraintsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (api.TimeSlotConstraints, error) {
+	requests := []util.DataRequest{util.TimeSeriesConstraintsRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (api.TimeSlotConstraints, error) {
+		return e.TimeSlotConstraints(entity)
+	})
+}
+
+// context aware variant of IncentiveConstraints
+func (e *UCCEVC) IncentiveConstraintsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (api.IncentiveSlotConstraints, error) {
+	requests := []util.DataRequest{util.IncentiveTableConstraintsRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (api.IncentiveSlotConstraints, error) {
+		return e.IncentiveConstraints(entity)
+	})
+}
+
+// context aware variant of ChargePlanConstraints
+func (e *UCCEVC) ChargePlanConstraintsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]api.DurationSlotValue, error) {
+	requests := []util.DataRequest{util.TimeSeriesValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]api.DurationSlotValue, error) {
+		return e.ChargePlanConstraints(entity)
+	})
+}
+
+// context aware variant of ChargePlan
+func (e *UCCEVC) ChargePlanContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (api.ChargePlan, error) {
+	requests := []util.DataRequest{util.TimeSeriesValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (api.ChargePlan, error) {
+		return e.ChargePlan(entity)
+	})
+}
+
+// context aware variant of WritePowerLimits
+//
+// with options.WaitForResult set, the result of the remote entity is awaited within the context deadline
+func (e *UCCEVC) WritePowerLimitsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	data []api.DurationSlotValue,
+	options api.WriteOptions) error {
+	_, err := util.WriteWithContext(ctx, e.service, entity, model.FeatureTypeTypeTimeSeries, options, e.results, func() (*model.MsgCounterType, error) {
+		return e.writePowerLimits(entity, data)
+	})
+
+	return err
+}
+
+// context aware variant of WriteIncentiveTableDescriptions
+//
+// with options.WaitForResult set, the result of the remote entity is awaited within the context deadline
+func (e *UCCEVC) WriteIncentiveTableDescriptionsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	data []api.IncentiveTariffDescription,
+	options api.WriteOptions) error {
+	_, err := util.WriteWithContext(ctx, e.service, entity, model.FeatureTypeTypeIncentiveTable, options, e.results, func() (*model.MsgCounterType, error) {
+		return e.writeIncentiveTableDescriptions(entity, data)
+	})
+
+	return err
+}
+
+// context aware variant of WriteIncentives
+//
+// with options.WaitForResult set, the result of the remote entity is awaited within the context deadline
+func (e *UCCEVC) WriteIncentivesContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	data []api.DurationSlotValue,
+	options api.WriteOptions) error {
+	_, err := util.WriteWithContext(ctx, e.service, entity, model.FeatureTypeTypeIncentiveTable, options, e.results, func() (*model.MsgCounterType, error) {
+		return e.writeIncentives(entity, data)
+	})
+
+	return err
+}
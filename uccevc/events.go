@@ -1,20 +1,50 @@
 package uccevc
 
 import (
+	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	"github.com/enbility/ship-go/logging"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
 )
 
+// returns the SPINE events the usecase handles
+func (e *UCCEVC) EventInterest() api.EventInterest {
+	return api.EventInterest{
+		EntityTypes:  e.validEntityTypes,
+		FeatureTypes: e.clientFeatures,
+		Functions: []model.FunctionType{
+			model.FunctionTypeTimeSeriesDescriptionListData,
+			model.FunctionTypeTimeSeriesListData,
+			model.FunctionTypeIncentiveTableDescriptionData,
+			model.FunctionTypeIncentiveTableData,
+		},
+		DeviceEvents: true,
+	}
+}
+
 // handle SPINE events
 func (e *UCCEVC) HandleEvent(payload spineapi.EventPayload) {
 	// only about events from an EV entity or device changes for this remote device
 
+	if util.IsDeviceDisconnected(payload) {
+		e.deviceDisconnected(payload.Ski, payload.Device)
+		return
+	}
+
 	if !util.IsCompatibleEntity(payload.Entity, e.validEntityTypes) {
 		return
 	}
 
+	if util.IsEntityDisconnected(payload) {
+		e.entityRemoved(payload.Ski, payload.Entity)
+		return
+	}
+
+	if util.IsEntityConnected(payload) || payload.EventType == spineapi.EventTypeDataChange {
+		e.entityAdded(payload.Ski, payload.Entity)
+	}
+
 	if util.IsEntityConnected(payload) {
 		e.evConnected(payload.Entity)
 		return
@@ -43,6 +73,27 @@ func (e *UCCEVC) HandleEvent(payload spineapi.EventPayload) {
 	}
 }
 
+// a compatible entity was connected or sent data
+func (e *UCCEVC) entityAdded(ski string, entity spineapi.EntityRemoteInterface) {
+	if e.entities.Add(entity) {
+		e.eventCB(ski, entity.Device(), entity, EntityAdded)
+	}
+}
+
+// a compatible entity was disconnected
+func (e *UCCEVC) entityRemoved(ski string, entity spineapi.EntityRemoteInterface) {
+	if e.entities.Remove(entity) {
+		e.eventCB(ski, entity.Device(), entity, EntityRemoved)
+	}
+}
+
+// a remote device was disconnected, so all of its known entities are removed
+func (e *UCCEVC) deviceDisconnected(ski string, device spineapi.DeviceRemoteInterface) {
+	for _, entity := range e.entities.RemoveDevice(device) {
+		e.eventCB(ski, device, entity, EntityRemoved)
+	}
+}
+
 // an EV was connected
 func (e *UCCEVC) evConnected(entity spineapi.EntityRemoteInterface) {
 	// initialise features, e.g. subscriptions, descriptions
@@ -90,6 +141,11 @@ func (e *UCCEVC) evConnected(entity spineapi.EntityRemoteInterface) {
 		if _, err := evIncentiveTable.RequestDescriptions(); err != nil {
 			logging.Log().Debug(err)
 		}
+
+		// get incentivetable constraints
+		if _, err := evIncentiveTable.RequestConstraints(); err != nil {
+			logging.Log().Debug(err)
+		}
 	}
 }
 
@@ -1,6 +1,7 @@
 package uccevc
 
 import (
+	"strings"
 	"time"
 
 	eebusutil "github.com/enbility/eebus-go/util"
@@ -41,6 +42,29 @@ func (s *UCCEVCSuite) Test_Events() {
 	s.sut.HandleEvent(payload)
 }
 
+func (s *UCCEVCSuite) Test_EventsRequestIncentiveTableConstraints() {
+	s.popSentMessages()
+
+	payload := spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     s.remoteDevice,
+		Entity:     s.evEntity,
+		EventType:  spineapi.EventTypeEntityChange,
+		ChangeType: spineapi.ElementChangeAdd,
+	}
+	s.sut.HandleEvent(payload)
+
+	// the incentive table constraints are required to write incentives in scenario 3
+	reads := 0
+	for _, message := range s.popSentMessages() {
+		if strings.Contains(string(message), `"cmdClassifier":"read"`) &&
+			strings.Contains(string(message), `"incentiveTableConstraintsData"`) {
+			reads++
+		}
+	}
+	assert.Equal(s.T(), 1, reads)
+}
+
 func (s *UCCEVCSuite) Test_evTimeSeriesDescriptionDataUpdate() {
 	s.sut.evTimeSeriesDescriptionDataUpdate(remoteSki, s.mockRemoteEntity)
 
@@ -77,6 +77,10 @@ func (e *UCCEVC) EnergyDemand(entity spineapi.EntityRemoteInterface) (api.Demand
 		return demand, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 1); err != nil {
+		return demand, err
+	}
+
 	evTimeSeries, err := util.TimeSeries(e.service, entity)
 	if err != nil {
 		return demand, eebusapi.ErrDataNotAvailable
@@ -62,38 +62,45 @@ func (e *UCCEVC) TimeSlotConstraints(entity spineapi.EntityRemoteInterface) (api
 // send power limits to the EV
 // if no data is provided, default power limits with the max possible value for 7 days will be sent
 func (e *UCCEVC) WritePowerLimits(entity spineapi.EntityRemoteInterface, data []api.DurationSlotValue) error {
+	_, err := e.writePowerLimits(entity, data)
+
+	return err
+}
+
+// write the power limits and return the message counter of the write
+func (e *UCCEVC) writePowerLimits(entity spineapi.EntityRemoteInterface, data []api.DurationSlotValue) (*model.MsgCounterType, error) {
 	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
-		return api.ErrNoCompatibleEntity
+		return nil, api.ErrNoCompatibleEntity
 	}
 
 	evTimeSeries, err := util.TimeSeries(e.service, entity)
 	if err != nil {
-		return eebusapi.ErrDataNotAvailable
+		return nil, eebusapi.ErrDataNotAvailable
 	}
 
 	if len(data) == 0 {
 		data, err = e.defaultPowerLimits(entity)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	constraints, err := e.TimeSlotConstraints(entity)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if constraints.MinSlots != 0 && constraints.MinSlots > uint(len(data)) {
-		return errors.New("too few charge slots provided")
+		return nil, errors.New("too few charge slots provided")
 	}
 
 	if constraints.MaxSlots != 0 && constraints.MaxSlots < uint(len(data)) {
-		return errors.New("too many charge slots provided")
+		return nil, errors.New("too many charge slots provided")
 	}
 
 	desc, err := evTimeSeries.GetDescriptionForType(model.TimeSeriesTypeTypeConstraints)
 	if err != nil {
-		return eebusapi.ErrDataNotAvailable
+		return nil, eebusapi.ErrDataNotAvailable
 	}
 
 	timeSeriesSlots := []model.TimeSeriesSlotType{}
@@ -128,9 +135,9 @@ func (e *UCCEVC) WritePowerLimits(entity spineapi.EntityRemoteInterface, data []
 		TimeSeriesSlot: timeSeriesSlots,
 	}
 
-	_, err = evTimeSeries.WriteValues([]model.TimeSeriesDataType{timeSeriesData})
+	msgCounter, err := evTimeSeries.WriteValues([]model.TimeSeriesDataType{timeSeriesData})
 
-	return err
+	return msgCounter, err
 }
 
 func (e *UCCEVC) defaultPowerLimits(entity spineapi.EntityRemoteInterface) ([]api.DurationSlotValue, error) {
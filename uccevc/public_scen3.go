@@ -48,20 +48,27 @@ func (e *UCCEVC) IncentiveConstraints(entity spineapi.EntityRemoteInterface) (ap
 //
 // SPINE UC CoordinatedEVCharging 2.4.3
 func (e *UCCEVC) WriteIncentiveTableDescriptions(entity spineapi.EntityRemoteInterface, data []api.IncentiveTariffDescription) error {
+	_, err := e.writeIncentiveTableDescriptions(entity, data)
+
+	return err
+}
+
+// write the incentive table descriptions and return the message counter of the write
+func (e *UCCEVC) writeIncentiveTableDescriptions(entity spineapi.EntityRemoteInterface, data []api.IncentiveTariffDescription) (*model.MsgCounterType, error) {
 	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
-		return api.ErrNoCompatibleEntity
+		return nil, api.ErrNoCompatibleEntity
 	}
 
 	evIncentiveTable, err := util.IncentiveTable(e.service, entity)
 	if err != nil {
 		logging.Log().Error("incentivetable feature not found")
-		return err
+		return nil, err
 	}
 
 	descriptions, err := evIncentiveTable.GetDescriptionsForScope(model.ScopeTypeTypeSimpleIncentiveTable)
 	if err != nil {
 		logging.Log().Error(err)
-		return err
+		return nil, err
 	}
 
 	// default tariff
@@ -174,25 +181,32 @@ func (e *UCCEVC) WriteIncentiveTableDescriptions(entity spineapi.EntityRemoteInt
 		}
 	}
 
-	_, err = evIncentiveTable.WriteDescriptions(descData)
+	msgCounter, err := evIncentiveTable.WriteDescriptions(descData)
 	if err != nil {
 		logging.Log().Error(err)
-		return err
+		return nil, err
 	}
 
-	return nil
+	return msgCounter, nil
 }
 
 // send incentives to the EV
 // if no data is provided, default incentives with the same price for 7 days will be sent
 func (e *UCCEVC) WriteIncentives(entity spineapi.EntityRemoteInterface, data []api.DurationSlotValue) error {
+	_, err := e.writeIncentives(entity, data)
+
+	return err
+}
+
+// write the incentives and return the message counter of the write
+func (e *UCCEVC) writeIncentives(entity spineapi.EntityRemoteInterface, data []api.DurationSlotValue) (*model.MsgCounterType, error) {
 	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
-		return api.ErrNoCompatibleEntity
+		return nil, api.ErrNoCompatibleEntity
 	}
 
 	evIncentiveTable, err := util.IncentiveTable(e.service, entity)
 	if err != nil {
-		return eebusapi.ErrDataNotAvailable
+		return nil, eebusapi.ErrDataNotAvailable
 	}
 
 	if len(data) == 0 {
@@ -206,15 +220,15 @@ func (e *UCCEVC) WriteIncentives(entity spineapi.EntityRemoteInterface, data []a
 
 	constraints, err := e.IncentiveConstraints(entity)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if constraints.MinSlots != 0 && constraints.MinSlots > uint(len(data)) {
-		return errors.New("too few charge slots provided")
+		return nil, errors.New("too few charge slots provided")
 	}
 
 	if constraints.MaxSlots != 0 && constraints.MaxSlots < uint(len(data)) {
-		return errors.New("too many charge slots provided")
+		return nil, errors.New("too many charge slots provided")
 	}
 
 	incentiveSlots := []model.IncentiveTableIncentiveSlotType{}
@@ -270,7 +284,7 @@ func (e *UCCEVC) WriteIncentives(entity spineapi.EntityRemoteInterface, data []a
 		IncentiveSlot: incentiveSlots,
 	}
 
-	_, err = evIncentiveTable.WriteValues([]model.IncentiveTableType{incentiveData})
+	msgCounter, err := evIncentiveTable.WriteValues([]model.IncentiveTableType{incentiveData})
 
-	return err
+	return msgCounter, err
 }
@@ -6,7 +6,6 @@ import (
 	eebusapi "github.com/enbility/eebus-go/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
-	"github.com/enbility/spine-go/spine"
 )
 
 type UCCEVC struct {
@@ -15,6 +14,15 @@ type UCCEVC struct {
 	eventCB api.EventHandlerCB
 
 	validEntityTypes []model.EntityTypeType
+
+	// the client features for the remote server features the usecase uses
+	clientFeatures []model.FeatureTypeType
+
+	// the known compatible remote entities
+	entities util.KnownEntities
+
+	// the writes waiting for their result
+	results *util.ResultWaiters
 }
 
 var _ UCCEVCInterface = (*UCCEVC)(nil)
@@ -23,13 +31,20 @@ func NewUCCEVC(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) *U
 	uc := &UCCEVC{
 		service: service,
 		eventCB: eventCB,
+
+		results: util.NewResultWaiters(),
 	}
 
 	uc.validEntityTypes = []model.EntityTypeType{
 		model.EntityTypeTypeEV,
 	}
 
-	_ = spine.Events.Subscribe(uc)
+	uc.clientFeatures = []model.FeatureTypeType{
+		model.FeatureTypeTypeDeviceConfiguration,
+		model.FeatureTypeTypeTimeSeries,
+		model.FeatureTypeTypeIncentiveTable,
+		model.FeatureTypeTypeElectricalConnection,
+	}
 
 	return uc
 }
@@ -42,15 +57,10 @@ func (e *UCCEVC) AddFeatures() {
 	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
 
 	// client features
-	var clientFeatures = []model.FeatureTypeType{
-		model.FeatureTypeTypeDeviceConfiguration,
-		model.FeatureTypeTypeTimeSeries,
-		model.FeatureTypeTypeIncentiveTable,
-		model.FeatureTypeTypeElectricalConnection,
-	}
-	for _, feature := range clientFeatures {
+	for _, feature := range e.clientFeatures {
 		f := localEntity.GetOrAddFeature(feature, model.RoleTypeClient)
 		f.AddResultHandler(e)
+		e.results.AddResultHandler(f)
 	}
 }
 
@@ -66,6 +76,36 @@ func (e *UCCEVC) AddUseCase() {
 		[]model.UseCaseScenarioSupportType{1, 2, 3})
 }
 
+func (e *UCCEVC) RemoveUseCase() {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.RemoveUseCaseSupport(model.UseCaseActorTypeCEM, e.UseCaseName())
+}
+
+func (e *UCCEVC) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeCEM, e.UseCaseName(), available)
+}
+
+// returns the client features for the remote server features the usecase uses
+func (e *UCCEVC) ClientFeatures() []model.FeatureTypeType {
+	return e.clientFeatures
+}
+
+// returns what a remote entity has to provide to support the usecase
+func (e *UCCEVC) RemoteRequirements() api.UseCaseRequirements {
+	return api.UseCaseRequirements{
+		EntityTypes: e.validEntityTypes,
+		Actors:      []model.UseCaseActorType{model.UseCaseActorTypeEV},
+		Scenarios:   []model.UseCaseScenarioSupportType{2, 3, 4, 5, 6, 7, 8},
+		ServerFeatures: []model.FeatureTypeType{
+			model.FeatureTypeTypeTimeSeries,
+			model.FeatureTypeTypeIncentiveTable,
+		},
+	}
+}
+
 // returns if the entity supports the usecase
 //
 // possible errors:
@@ -78,15 +118,7 @@ func (e *UCCEVC) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool
 
 	// check if the usecase and mandatory scenarios are supported and
 	// if the required server features are available
-	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
-		model.UseCaseActorTypeEV,
-		e.UseCaseName(),
-		[]model.UseCaseScenarioSupportType{2, 3, 4, 5, 6, 7, 8},
-		[]model.FeatureTypeType{
-			model.FeatureTypeTypeTimeSeries,
-			model.FeatureTypeTypeIncentiveTable,
-		},
-	) {
+	if !util.VerifyUseCaseRequirements(entity, e.UseCaseName(), e.RemoteRequirements()) {
 		return false, nil
 	}
 
@@ -112,3 +144,50 @@ func (e *UCCEVC) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool
 
 	return true, nil
 }
+
+// returns the scenarios the remote entity announces and for which data is available
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCCEVC) SupportedScenarios(entity spineapi.EntityRemoteInterface) ([]model.UseCaseScenarioSupportType, error) {
+	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	checks := map[model.UseCaseScenarioSupportType]func() error{
+		1: func() error {
+			_, err := e.EnergyDemand(entity)
+			return err
+		},
+		2: func() error {
+			_, err := e.TimeSlotConstraints(entity)
+			return err
+		},
+		3: func() error {
+			_, err := e.IncentiveConstraints(entity)
+			return err
+		},
+		4: func() error {
+			_, err := e.ChargePlan(entity)
+			return err
+		},
+	}
+
+	return util.SupportedScenarios(entity, e.UseCaseName(), e.RemoteRequirements(), checks), nil
+}
+
+// request the descriptions, constraints and values of all features the usecase uses from a remote entity
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCCEVC) Refresh(entity spineapi.EntityRemoteInterface) ([]api.RefreshResult, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	return util.RefreshFeatures(e.service, entity, e.clientFeatures), nil
+}
+
+func (e *UCCEVC) CompatibleEntities() []spineapi.EntityRemoteInterface {
+	return e.entities.Entities()
+}
@@ -1,6 +1,8 @@
 package ucevcc
 
 import (
+	"context"
+
 	"github.com/enbility/cemd/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
@@ -75,4 +77,24 @@ type UCEVCCInterface interface {
 	// parameters:
 	//   - entity: the entity of the EV
 	IsInSleepMode(entity spineapi.EntityRemoteInterface) (bool, error)
+
+	// context aware variants
+
+	// the getters above with a context
+	//
+	// parameters:
+	//   - ctx: the context, its deadline limits the wait for a fresh value
+	//   - entity: the entity of the device
+	//   - options: if Fresh is set, the data is requested from the remote entity and the reply is awaited before the value is returned
+	//
+	// possible errors:
+	//   - the context error if the context is done before the reply is received
+	//   - and those of the getters above
+	ChargeStateContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (api.EVChargeStateType, error)
+	CommunicationStandardContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (model.DeviceConfigurationKeyValueStringType, error)
+	AsymmetricChargingSupportContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (bool, error)
+	IdentificationsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]api.IdentificationItem, error)
+	IsInSleepModeContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (bool, error)
+	ManufacturerDataContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (string, string, error)
+	CurrentLimitsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]float64, []float64, []float64, error)
 }
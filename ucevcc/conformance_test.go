@@ -0,0 +1,61 @@
+package ucevcc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cemtest"
+	"github.com/enbility/cemd/conformance"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestConformanceSuite(t *testing.T) {
+	suite.Run(t, new(ConformanceSuite))
+}
+
+type ConformanceSuite struct {
+	suite.Suite
+}
+
+const specification = "EEBus_UC_TS_EVCommissioningAndConfiguration"
+
+var requirements = []conformance.Requirement{
+	conformance.UseCaseAnnounced("EVCC-G-01", specification, model.UseCaseActorTypeCEM, 1, 2, 3, 4, 5, 6, 7, 8),
+	conformance.RemoteUseCase("EVCC-G-02", specification, model.UseCaseActorTypeEV, 1, 2, 3, 8),
+	conformance.EntityType("EVCC-G-03", specification, model.EntityTypeTypeEV),
+	conformance.ClientFeature("EVCC-G-04", specification, model.FeatureTypeTypeDeviceConfiguration),
+	conformance.ServerFeature("EVCC-G-05", specification, model.FeatureTypeTypeDeviceConfiguration),
+	conformance.Subscription("EVCC-G-06", specification, model.FeatureTypeTypeDeviceConfiguration),
+	conformance.Read("EVCC-G-07", specification, model.FeatureTypeTypeDeviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData),
+	conformance.Read("EVCC-G-08", specification, model.FeatureTypeTypeDeviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueListData),
+	conformance.ResponseTime("EVCC-G-09", conformance.ImplementationReference,
+		conformance.Notification(model.FeatureTypeTypeDeviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData),
+		conformance.ReadResponse(model.FeatureTypeTypeDeviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueListData), time.Second),
+	conformance.ClientFeature("EVCC-S4-01", specification, model.FeatureTypeTypeIdentification),
+	conformance.Subscription("EVCC-S4-02", specification, model.FeatureTypeTypeIdentification),
+	conformance.Read("EVCC-S4-03", specification, model.FeatureTypeTypeIdentification, model.FunctionTypeIdentificationListData),
+	conformance.ClientFeature("EVCC-S5-01", specification, model.FeatureTypeTypeDeviceClassification),
+	conformance.Subscription("EVCC-S5-02", specification, model.FeatureTypeTypeDeviceClassification),
+	conformance.Read("EVCC-S5-03", specification, model.FeatureTypeTypeDeviceClassification, model.FunctionTypeDeviceClassificationManufacturerData),
+	conformance.ClientFeature("EVCC-S6-01", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.Subscription("EVCC-S6-02", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.Read("EVCC-S6-03", specification, model.FeatureTypeTypeElectricalConnection, model.FunctionTypeElectricalConnectionParameterDescriptionListData),
+	conformance.Read("EVCC-S6-04", specification, model.FeatureTypeTypeElectricalConnection, model.FunctionTypeElectricalConnectionPermittedValueSetListData),
+	conformance.ClientFeature("EVCC-S7-01", specification, model.FeatureTypeTypeDeviceDiagnosis),
+	conformance.Subscription("EVCC-S7-02", specification, model.FeatureTypeTypeDeviceDiagnosis),
+	conformance.Read("EVCC-S7-03", specification, model.FeatureTypeTypeDeviceDiagnosis, model.FunctionTypeDeviceDiagnosisStateData),
+}
+
+func (s *ConformanceSuite) Test_Requirements() {
+	conformance.Verify(s.T(), conformance.Setup{
+		UseCase: func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) api.UseCaseInterface {
+			return NewUCEVCC(service, eventCB)
+		},
+		Remote: func(device *cemtest.DeviceBuilder) *cemtest.EntityBuilder {
+			return cemtest.EVISO15118WithSoC(cemtest.EVSE3Phase(device), 50)
+		},
+	}, requirements)
+}
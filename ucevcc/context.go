@@ -0,0 +1,112 @@
+package ucevcc
+
+import (
+	"context"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// context aware variant of ChargeState
+func (e *UCEVCC) ChargeStateContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (api.EVChargeStateType, error) {
+	requests := []util.DataRequest{util.DeviceDiagnosisStateRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (api.EVChargeStateType, error) {
+		return e.ChargeState(entity)
+	})
+}
+
+// context aware variant of CommunicationStandard
+func (e *UCEVCC) CommunicationStandardContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (model.DeviceConfigurationKeyValueStringType, error) {
+	requests := []util.DataRequest{util.DeviceConfigurationKeyValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (model.DeviceConfigurationKeyValueStringType, error) {
+		return e.CommunicationStandard(entity)
+	})
+}
+
+// context aware variant of AsymmetricChargingSupport
+func (e *UCEVCC) AsymmetricChargingSupportContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (bool, error) {
+	requests := []util.DataRequest{util.DeviceConfigurationKeyValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (bool, error) {
+		return e.AsymmetricChargingSupport(entity)
+	})
+}
+
+// context aware variant of Identifications
+func (e *UCEVCC) IdentificationsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]api.IdentificationItem, error) {
+	requests := []util.DataRequest{util.IdentificationValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]api.IdentificationItem, error) {
+		return e.Identifications(entity)
+	})
+}
+
+// context aware variant of IsInSleepMode
+func (e *UCEVCC) IsInSleepModeContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (bool, error) {
+	requests := []util.DataRequest{util.DeviceDiagnosisStateRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (bool, error) {
+		return e.IsInSleepMode(entity)
+	})
+}
+
+// context aware variant of ManufacturerData
+func (e *UCEVCC) ManufacturerDataContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (string, string, error) {
+	requests := []util.DataRequest{util.DeviceClassificationManufacturerRequest}
+
+	var serialNumber string
+	deviceName, err := util.ReadWithContext(ctx, e.service, entity, options, requests, func() (string, error) {
+		var deviceName string
+		var err error
+		deviceName, serialNumber, err = e.ManufacturerData(entity)
+		return deviceName, err
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return deviceName, serialNumber, nil
+}
+
+// context aware variant of CurrentLimits
+func (e *UCEVCC) CurrentLimitsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]float64, []float64, []float64, error) {
+	requests := []util.DataRequest{util.ElectricalConnectionPermittedValuesRequest}
+
+	var resultMax, resultDefault []float64
+	resultMin, err := util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]float64, error) {
+		var resultMin []float64
+		var err error
+		resultMin, resultMax, resultDefault, err = e.CurrentLimits(entity)
+		return resultMin, err
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return resultMin, resultMax, resultDefault, nil
+}
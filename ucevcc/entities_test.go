@@ -0,0 +1,37 @@
+package ucevcc
+
+import (
+	"github.com/enbility/cemd/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCEVCCSuite) Test_EntityEvents() {
+	var events []api.EventType
+	s.sut.eventCB = func(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+		assert.Equal(s.T(), s.evEntity, entity)
+		events = append(events, event)
+	}
+
+	payload := spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     s.remoteDevice,
+		Entity:     s.evEntity,
+		EventType:  spineapi.EventTypeEntityChange,
+		ChangeType: spineapi.ElementChangeAdd,
+	}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), []api.EventType{EntityAdded, EvConnected}, events)
+	assert.Equal(s.T(), []spineapi.EntityRemoteInterface{s.evEntity}, s.sut.CompatibleEntities())
+
+	// the EV is disconnected together with its device
+	payload = spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     s.remoteDevice,
+		EventType:  spineapi.EventTypeDeviceChange,
+		ChangeType: spineapi.ElementChangeRemove,
+	}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), []api.EventType{EntityAdded, EvConnected, EvDisconnected, EntityRemoved}, events)
+	assert.Equal(s.T(), 0, len(s.sut.CompatibleEntities()))
+}
@@ -1,18 +1,37 @@
 package ucevcc
 
 import (
+	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	"github.com/enbility/ship-go/logging"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
 )
 
+// returns the SPINE events the usecase handles
+func (e *UCEVCC) EventInterest() api.EventInterest {
+	return api.EventInterest{
+		EntityTypes:  e.validEntityTypes,
+		FeatureTypes: e.clientFeatures,
+		Functions: []model.FunctionType{
+			model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData,
+			model.FunctionTypeDeviceConfigurationKeyValueListData,
+			model.FunctionTypeDeviceDiagnosisStateData,
+			model.FunctionTypeDeviceClassificationManufacturerData,
+			model.FunctionTypeElectricalConnectionParameterDescriptionListData,
+			model.FunctionTypeElectricalConnectionPermittedValueSetListData,
+			model.FunctionTypeIdentificationListData,
+		},
+		DeviceEvents: true,
+	}
+}
+
 // handle SPINE events
 func (e *UCEVCC) HandleEvent(payload spineapi.EventPayload) {
 	// only about events from an EV entity or device changes for this remote device
 
 	if util.IsDeviceDisconnected(payload) {
-		e.evDisconnected(payload.Ski, payload.Entity)
+		e.deviceDisconnected(payload.Ski, payload.Device)
 		return
 	}
 
@@ -21,13 +40,19 @@ func (e *UCEVCC) HandleEvent(payload spineapi.EventPayload) {
 	}
 
 	if util.IsEntityConnected(payload) {
+		e.entityAdded(payload.Ski, payload.Entity)
 		e.evConnected(payload.Ski, payload.Entity)
 		return
 	} else if util.IsEntityDisconnected(payload) {
 		e.evDisconnected(payload.Ski, payload.Entity)
+		e.entityRemoved(payload.Ski, payload.Entity)
 		return
 	}
 
+	if payload.EventType == spineapi.EventTypeDataChange {
+		e.entityAdded(payload.Ski, payload.Entity)
+	}
+
 	if payload.EventType != spineapi.EventTypeDataChange ||
 		payload.ChangeType != spineapi.ElementChangeUpdate {
 		return
@@ -51,6 +76,28 @@ func (e *UCEVCC) HandleEvent(payload spineapi.EventPayload) {
 	}
 }
 
+// a compatible entity was connected or sent data
+func (e *UCEVCC) entityAdded(ski string, entity spineapi.EntityRemoteInterface) {
+	if e.entities.Add(entity) {
+		e.eventCB(ski, entity.Device(), entity, EntityAdded)
+	}
+}
+
+// a compatible entity was disconnected
+func (e *UCEVCC) entityRemoved(ski string, entity spineapi.EntityRemoteInterface) {
+	if e.entities.Remove(entity) {
+		e.eventCB(ski, entity.Device(), entity, EntityRemoved)
+	}
+}
+
+// a remote device was disconnected, so all of its known entities are removed
+func (e *UCEVCC) deviceDisconnected(ski string, device spineapi.DeviceRemoteInterface) {
+	for _, entity := range e.entities.RemoveDevice(device) {
+		e.evDisconnected(ski, entity)
+		e.eventCB(ski, device, entity, EntityRemoved)
+	}
+}
+
 // an EV was connected
 func (e *UCEVCC) evConnected(ski string, entity spineapi.EntityRemoteInterface) {
 	// initialise features, e.g. subscriptions, descriptions
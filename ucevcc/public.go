@@ -118,6 +118,10 @@ func (e *UCEVCC) CommunicationStandard(entity spineapi.EntityRemoteInterface) (m
 		return unknown, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 2); err != nil {
+		return unknown, err
+	}
+
 	data, err := e.deviceConfigurationValueForKeyName(entity, model.DeviceConfigurationKeyNameTypeCommunicationsStandard, model.DeviceConfigurationKeyValueTypeTypeString)
 	if err != nil || data == nil {
 		return unknown, eebusapi.ErrDataNotAvailable
@@ -140,6 +144,10 @@ func (e *UCEVCC) AsymmetricChargingSupport(entity spineapi.EntityRemoteInterface
 		return false, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 3); err != nil {
+		return false, err
+	}
+
 	data, err := e.deviceConfigurationValueForKeyName(entity, model.DeviceConfigurationKeyNameTypeAsymmetricChargingSupported, model.DeviceConfigurationKeyValueTypeTypeBoolean)
 	if err != nil || data == nil {
 		return false, eebusapi.ErrDataNotAvailable
@@ -163,6 +171,10 @@ func (e *UCEVCC) Identifications(entity spineapi.EntityRemoteInterface) ([]api.I
 		return nil, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 4); err != nil {
+		return nil, err
+	}
+
 	evIdentification, err := util.Identification(e.service, entity)
 	if err != nil {
 		return nil, eebusapi.ErrDataNotAvailable
@@ -209,6 +221,10 @@ func (e *UCEVCC) ManufacturerData(
 		return deviceName, serialNumber, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 5); err != nil {
+		return deviceName, serialNumber, err
+	}
+
 	evDeviceClassification, err := util.DeviceClassification(e.service, entity)
 	if err != nil {
 		return deviceName, serialNumber, eebusapi.ErrDataNotAvailable
@@ -240,6 +256,10 @@ func (e *UCEVCC) CurrentLimits(entity spineapi.EntityRemoteInterface) ([]float64
 		return nil, nil, nil, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 6); err != nil {
+		return nil, nil, nil, err
+	}
+
 	evElectricalConnection, err := util.ElectricalConnection(e.service, entity)
 	if err != nil {
 		return nil, nil, nil, eebusapi.ErrDataNotAvailable
@@ -284,6 +304,10 @@ func (e *UCEVCC) IsInSleepMode(
 		return false, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 7); err != nil {
+		return false, err
+	}
+
 	evseDeviceDiagnosis, err := util.DeviceDiagnosis(e.service, entity)
 	if err != nil {
 		return false, err
@@ -11,6 +11,21 @@ const (
 )
 
 const (
+	// A remote entity compatible with the use case was added
+	//
+	// The callback with this message provides:
+	//   - the device of the entity
+	//   - the entity, its address is available with Address()
+	//
+	// The event is sent before any other event of the entity
+	EntityAdded api.EventType = "ucevcc.EntityAdded"
+
+	// A remote entity compatible with the use case was removed, or its device was disconnected
+	//
+	// The callback with this message provides:
+	//   - the device of the entity
+	//   - the entity, its address is available with Address()
+	EntityRemoved api.EventType = "ucevcc.EntityRemoved"
 
 	// An EV was connected
 	//
@@ -6,7 +6,6 @@ import (
 	serviceapi "github.com/enbility/eebus-go/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
-	"github.com/enbility/spine-go/spine"
 )
 
 type UCEVCC struct {
@@ -15,6 +14,12 @@ type UCEVCC struct {
 	eventCB api.EventHandlerCB
 
 	validEntityTypes []model.EntityTypeType
+
+	// the client features for the remote server features the usecase uses
+	clientFeatures []model.FeatureTypeType
+
+	// the known compatible remote entities
+	entities util.KnownEntities
 }
 
 var _ UCEVCCInterface = (*UCEVCC)(nil)
@@ -29,7 +34,13 @@ func NewUCEVCC(service serviceapi.ServiceInterface, eventCB api.EventHandlerCB)
 		model.EntityTypeTypeEV,
 	}
 
-	_ = spine.Events.Subscribe(uc)
+	uc.clientFeatures = []model.FeatureTypeType{
+		model.FeatureTypeTypeDeviceConfiguration,
+		model.FeatureTypeTypeIdentification,
+		model.FeatureTypeTypeDeviceClassification,
+		model.FeatureTypeTypeElectricalConnection,
+		model.FeatureTypeTypeDeviceDiagnosis,
+	}
 
 	return uc
 }
@@ -42,14 +53,7 @@ func (e *UCEVCC) AddFeatures() {
 	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
 
 	// client features
-	var clientFeatures = []model.FeatureTypeType{
-		model.FeatureTypeTypeDeviceConfiguration,
-		model.FeatureTypeTypeIdentification,
-		model.FeatureTypeTypeDeviceClassification,
-		model.FeatureTypeTypeElectricalConnection,
-		model.FeatureTypeTypeDeviceDiagnosis,
-	}
-	for _, feature := range clientFeatures {
+	for _, feature := range e.clientFeatures {
 		f := localEntity.GetOrAddFeature(feature, model.RoleTypeClient)
 		f.AddResultHandler(e)
 	}
@@ -67,6 +71,33 @@ func (e *UCEVCC) AddUseCase() {
 		[]model.UseCaseScenarioSupportType{1, 2, 3, 4, 5, 6, 7, 8})
 }
 
+func (e *UCEVCC) RemoveUseCase() {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.RemoveUseCaseSupport(model.UseCaseActorTypeCEM, e.UseCaseName())
+}
+
+func (e *UCEVCC) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeCEM, e.UseCaseName(), available)
+}
+
+// returns the client features for the remote server features the usecase uses
+func (e *UCEVCC) ClientFeatures() []model.FeatureTypeType {
+	return e.clientFeatures
+}
+
+// returns what a remote entity has to provide to support the usecase
+func (e *UCEVCC) RemoteRequirements() api.UseCaseRequirements {
+	return api.UseCaseRequirements{
+		EntityTypes:    e.validEntityTypes,
+		Actors:         []model.UseCaseActorType{model.UseCaseActorTypeEV},
+		Scenarios:      []model.UseCaseScenarioSupportType{1, 2, 3, 8},
+		ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeDeviceConfiguration},
+	}
+}
+
 // returns if the entity supports the usecase
 //
 // possible errors:
@@ -79,14 +110,68 @@ func (e *UCEVCC) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool
 
 	// check if the usecase and mandatory scenarios are supported and
 	// if the required server features are available
-	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
-		model.UseCaseActorTypeEV,
-		e.UseCaseName(),
-		[]model.UseCaseScenarioSupportType{1, 2, 3, 8},
-		[]model.FeatureTypeType{model.FeatureTypeTypeDeviceConfiguration},
-	) {
+	if !util.VerifyUseCaseRequirements(entity, e.UseCaseName(), e.RemoteRequirements()) {
 		return false, nil
 	}
 
 	return true, nil
 }
+
+// returns the scenarios the remote entity announces and for which data is available
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCEVCC) SupportedScenarios(entity spineapi.EntityRemoteInterface) ([]model.UseCaseScenarioSupportType, error) {
+	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	checks := map[model.UseCaseScenarioSupportType]func() error{
+		1: func() error {
+			_, err := e.ChargeState(entity)
+			return err
+		},
+		2: func() error {
+			_, err := e.CommunicationStandard(entity)
+			return err
+		},
+		3: func() error {
+			_, err := e.AsymmetricChargingSupport(entity)
+			return err
+		},
+		4: func() error {
+			_, err := e.Identifications(entity)
+			return err
+		},
+		5: func() error {
+			_, _, err := e.ManufacturerData(entity)
+			return err
+		},
+		6: func() error {
+			_, _, _, err := e.CurrentLimits(entity)
+			return err
+		},
+		7: func() error {
+			_, err := e.IsInSleepMode(entity)
+			return err
+		},
+	}
+
+	return util.SupportedScenarios(entity, e.UseCaseName(), e.RemoteRequirements(), checks), nil
+}
+
+// request the descriptions, constraints and values of all features the usecase uses from a remote entity
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCEVCC) Refresh(entity spineapi.EntityRemoteInterface) ([]api.RefreshResult, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	return util.RefreshFeatures(e.service, entity, e.clientFeatures), nil
+}
+
+func (e *UCEVCC) CompatibleEntities() []spineapi.EntityRemoteInterface {
+	return e.entities.Entities()
+}
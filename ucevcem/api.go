@@ -1,6 +1,9 @@
 package ucevcem
 
 import (
+	"context"
+	"time"
+
 	"github.com/enbility/cemd/api"
 	spineapi "github.com/enbility/spine-go/api"
 )
@@ -11,6 +14,12 @@ import (
 type UCEVCEMInterface interface {
 	api.UseCaseInterface
 
+	// set the age after which measurement values are reported as stale
+	//
+	// parameters:
+	//   - threshold: the maximum age, 0 disables the staleness detection
+	SetStaleThreshold(threshold time.Duration)
+
 	// return the number of ac connected phases of the EV or 0 if it is unknown
 	//
 	// parameters:
@@ -25,6 +34,13 @@ type UCEVCEMInterface interface {
 	//   - entity: the entity of the EV
 	CurrentPerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error)
 
+	// return the last current measurement for each phase of the connected EV
+	// including the phase, timestamp, state and source of each value
+	//
+	// parameters:
+	//   - entity: the entity of the EV
+	CurrentPerPhaseDetails(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error)
+
 	// Scenario 2
 
 	// return the last power measurement for each phase of the connected EV
@@ -33,6 +49,13 @@ type UCEVCEMInterface interface {
 	//   - entity: the entity of the EV
 	PowerPerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error)
 
+	// return the last power measurement for each phase of the connected EV
+	// including the phase, timestamp, state and source of each value
+	//
+	// parameters:
+	//   - entity: the entity of the EV
+	PowerPerPhaseDetails(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error)
+
 	// Scenario 3
 
 	// return the charged energy measurement in Wh of the connected EV
@@ -40,4 +63,23 @@ type UCEVCEMInterface interface {
 	// parameters:
 	//   - entity: the entity of the EV
 	EnergyCharged(entity spineapi.EntityRemoteInterface) (float64, error)
+
+	// context aware variants
+
+	// the getters above with a context
+	//
+	// parameters:
+	//   - ctx: the context, its deadline limits the wait for a fresh value
+	//   - entity: the entity of the device
+	//   - options: if Fresh is set, the data is requested from the remote entity and the reply is awaited before the value is returned
+	//
+	// possible errors:
+	//   - the context error if the context is done before the reply is received
+	//   - and those of the getters above
+	PhasesConnectedContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (uint, error)
+	CurrentPerPhaseContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]float64, error)
+	CurrentPerPhaseDetailsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]api.MeasurementResult, error)
+	PowerPerPhaseContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]float64, error)
+	PowerPerPhaseDetailsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]api.MeasurementResult, error)
+	EnergyChargedContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (float64, error)
 }
@@ -0,0 +1,48 @@
+package ucevcem
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cemtest"
+	"github.com/enbility/cemd/conformance"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestConformanceSuite(t *testing.T) {
+	suite.Run(t, new(ConformanceSuite))
+}
+
+type ConformanceSuite struct {
+	suite.Suite
+}
+
+const specification = "EEBus_UC_TS_MeasurementOfElectricityDuringEVCharging"
+
+var requirements = []conformance.Requirement{
+	conformance.UseCaseAnnounced("EVCEM-G-01", specification, model.UseCaseActorTypeCEM, 1, 2, 3),
+	conformance.RemoteUseCase("EVCEM-G-02", specification, model.UseCaseActorTypeEV),
+	conformance.EntityType("EVCEM-G-03", specification, model.EntityTypeTypeEV),
+	conformance.ClientFeature("EVCEM-G-04", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.ClientFeature("EVCEM-G-05", specification, model.FeatureTypeTypeMeasurement),
+	conformance.Subscription("EVCEM-G-06", specification, model.FeatureTypeTypeMeasurement),
+	conformance.Read("EVCEM-G-07", specification, model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData),
+	conformance.Read("EVCEM-G-08", specification, model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData),
+	conformance.ResponseTime("EVCEM-G-09", conformance.ImplementationReference,
+		conformance.Notification(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData),
+		conformance.ReadResponse(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData), time.Second),
+}
+
+func (s *ConformanceSuite) Test_Requirements() {
+	conformance.Verify(s.T(), conformance.Setup{
+		UseCase: func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) api.UseCaseInterface {
+			return NewUCEVCEM(service, eventCB)
+		},
+		Remote: func(device *cemtest.DeviceBuilder) *cemtest.EntityBuilder {
+			return cemtest.EVIEC61851(cemtest.EVSE3Phase(device))
+		},
+	}, requirements)
+}
@@ -0,0 +1,81 @@
+package ucevcem
+
+import (
+	"context"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// context aware variant of PhasesConnected
+func (e *UCEVCEM) PhasesConnectedContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (uint, error) {
+	requests := []util.DataRequest{util.ElectricalConnectionDescriptionsRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (uint, error) {
+		return e.PhasesConnected(entity)
+	})
+}
+
+// context aware variant of CurrentPerPhase
+func (e *UCEVCEM) CurrentPerPhaseContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]float64, error) {
+		return e.CurrentPerPhase(entity)
+	})
+}
+
+// context aware variant of CurrentPerPhaseDetails
+func (e *UCEVCEM) CurrentPerPhaseDetailsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]api.MeasurementResult, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]api.MeasurementResult, error) {
+		return e.CurrentPerPhaseDetails(entity)
+	})
+}
+
+// context aware variant of PowerPerPhase
+func (e *UCEVCEM) PowerPerPhaseContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]float64, error) {
+		return e.PowerPerPhase(entity)
+	})
+}
+
+// context aware variant of PowerPerPhaseDetails
+func (e *UCEVCEM) PowerPerPhaseDetailsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]api.MeasurementResult, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]api.MeasurementResult, error) {
+		return e.PowerPerPhaseDetails(entity)
+	})
+}
+
+// context aware variant of EnergyCharged
+func (e *UCEVCEM) EnergyChargedContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.EnergyCharged(entity)
+	})
+}
@@ -1,20 +1,49 @@
 package ucevcem
 
 import (
+	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	"github.com/enbility/ship-go/logging"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
 )
 
+// returns the SPINE events the usecase handles
+func (e *UCEVCEM) EventInterest() api.EventInterest {
+	return api.EventInterest{
+		EntityTypes:  e.validEntityTypes,
+		FeatureTypes: e.clientFeatures,
+		Functions: []model.FunctionType{
+			model.FunctionTypeElectricalConnectionDescriptionListData,
+			model.FunctionTypeMeasurementDescriptionListData,
+			model.FunctionTypeMeasurementListData,
+		},
+		DeviceEvents: true,
+	}
+}
+
 // handle SPINE events
 func (e *UCEVCEM) HandleEvent(payload spineapi.EventPayload) {
 	// only about events from an EV entity or device changes for this remote device
 
+	if util.IsDeviceDisconnected(payload) {
+		e.deviceDisconnected(payload.Ski, payload.Device)
+		return
+	}
+
 	if !util.IsCompatibleEntity(payload.Entity, e.validEntityTypes) {
 		return
 	}
 
+	if util.IsEntityDisconnected(payload) {
+		e.entityRemoved(payload.Ski, payload.Entity)
+		return
+	}
+
+	if util.IsEntityConnected(payload) || payload.EventType == spineapi.EventTypeDataChange {
+		e.entityAdded(payload.Ski, payload.Entity)
+	}
+
 	if util.IsEntityConnected(payload) {
 		e.evConnected(payload.Entity)
 		return
@@ -34,6 +63,27 @@ func (e *UCEVCEM) HandleEvent(payload spineapi.EventPayload) {
 	}
 }
 
+// a compatible entity was connected or sent data
+func (e *UCEVCEM) entityAdded(ski string, entity spineapi.EntityRemoteInterface) {
+	if e.entities.Add(entity) {
+		e.eventCB(ski, entity.Device(), entity, EntityAdded)
+	}
+}
+
+// a compatible entity was disconnected
+func (e *UCEVCEM) entityRemoved(ski string, entity spineapi.EntityRemoteInterface) {
+	if e.entities.Remove(entity) {
+		e.eventCB(ski, entity.Device(), entity, EntityRemoved)
+	}
+}
+
+// a remote device was disconnected, so all of its known entities are removed
+func (e *UCEVCEM) deviceDisconnected(ski string, device spineapi.DeviceRemoteInterface) {
+	for _, entity := range e.entities.RemoveDevice(device) {
+		e.eventCB(ski, device, entity, EntityRemoved)
+	}
+}
+
 // an EV was connected
 func (e *UCEVCEM) evConnected(entity spineapi.EntityRemoteInterface) {
 	// initialise features, e.g. subscriptions, descriptions
@@ -36,16 +36,50 @@ func (e *UCEVCEM) PhasesConnected(entity spineapi.EntityRemoteInterface) (uint,
 	return 0, nil
 }
 
+// set the age after which measurement values are reported as stale
+//
+// the default is api.DefaultStaleThreshold, 0 disables the staleness detection
+func (e *UCEVCEM) SetStaleThreshold(threshold time.Duration) {
+	e.staleThreshold.Store(int64(threshold))
+}
+
 // return the last current measurement for each phase of the connected EV
 //
+// the values are read from the local cache, use Refresh or CurrentPerPhaseContext
+// with api.ReadOptions{Fresh: true} to request current values from the EV
+//
 // possible errors:
 //   - ErrDataNotAvailable if no such measurement is (yet) available
 //   - and others
 func (e *UCEVCEM) CurrentPerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error) {
+	data, err := e.CurrentPerPhaseDetails(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []float64
+	for _, item := range data {
+		result = append(result, item.Value)
+	}
+
+	return result, nil
+}
+
+// return the last current measurement for each phase of the connected EV
+// including the phase, timestamp, state and source of each value
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such measurement is (yet) available
+//   - and others
+func (e *UCEVCEM) CurrentPerPhaseDetails(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error) {
 	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
 		return nil, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 1); err != nil {
+		return nil, err
+	}
+
 	evMeasurement, err := util.Measurement(e.service, entity)
 	evElectricalConnection, err2 := util.ElectricalConnection(e.service, entity)
 	if err != nil || err2 != nil {
@@ -60,9 +94,7 @@ func (e *UCEVCEM) CurrentPerPhase(entity spineapi.EntityRemoteInterface) ([]floa
 		return nil, err
 	}
 
-	var result []float64
-	refetch := true
-	compare := time.Now().Add(-1 * time.Minute)
+	var result []api.MeasurementResult
 
 	for _, phase := range util.PhaseNameMapping {
 		for _, item := range data {
@@ -75,23 +107,12 @@ func (e *UCEVCEM) CurrentPerPhase(entity spineapi.EntityRemoteInterface) ([]floa
 				continue
 			}
 
-			phaseValue := item.Value.GetValue()
-			result = append(result, phaseValue)
-
-			if item.Timestamp != nil {
-				if timestamp, err := item.Timestamp.GetTime(); err == nil {
-					refetch = timestamp.Before(compare)
-				}
-			}
+			value := util.MeasurementResultForData(item, time.Duration(e.staleThreshold.Load()))
+			value.Phase = phase
+			result = append(result, value)
 		}
 	}
 
-	// if there was no timestamp provided or the time for the last value
-	// is older than 1 minute, send a read request
-	if refetch {
-		_, _ = evMeasurement.RequestValues()
-	}
-
 	return result, nil
 }
 
@@ -101,10 +122,32 @@ func (e *UCEVCEM) CurrentPerPhase(entity spineapi.EntityRemoteInterface) ([]floa
 //   - ErrDataNotAvailable if no such measurement is (yet) available
 //   - and others
 func (e *UCEVCEM) PowerPerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error) {
+	data, err := e.PowerPerPhaseDetails(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.MeasurementResultValues(data), nil
+}
+
+// return the last power measurement for each phase of the connected EV
+// including the phase, timestamp, state and source of each value
+//
+// if the EV provides no power measurements, the power is calculated from the current
+// and the configured voltage
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such measurement is (yet) available
+//   - and others
+func (e *UCEVCEM) PowerPerPhaseDetails(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error) {
 	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
 		return nil, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 2); err != nil {
+		return nil, err
+	}
+
 	evMeasurement, err := util.Measurement(e.service, entity)
 	evElectricalConnection, err2 := util.ElectricalConnection(e.service, entity)
 	if err != nil || err2 != nil {
@@ -130,7 +173,7 @@ func (e *UCEVCEM) PowerPerPhase(entity spineapi.EntityRemoteInterface) ([]float6
 		}
 	}
 
-	var result []float64
+	var result []api.MeasurementResult
 
 	for _, phase := range util.PhaseNameMapping {
 		for _, item := range data {
@@ -143,12 +186,14 @@ func (e *UCEVCEM) PowerPerPhase(entity spineapi.EntityRemoteInterface) ([]float6
 				continue
 			}
 
-			phaseValue := item.Value.GetValue()
+			value := util.MeasurementResultForData(item, time.Duration(e.staleThreshold.Load()))
+			value.Phase = phase
 			if !powerAvailable {
-				phaseValue *= e.service.Configuration().Voltage()
+				value.Value *= e.service.Configuration().Voltage()
+				value.ValueSource = model.MeasurementValueSourceTypeCalculatedValue
 			}
 
-			result = append(result, phaseValue)
+			result = append(result, value)
 		}
 	}
 
@@ -165,6 +210,10 @@ func (e *UCEVCEM) EnergyCharged(entity spineapi.EntityRemoteInterface) (float64,
 		return 0, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 3); err != nil {
+		return 0, err
+	}
+
 	evMeasurement, err := util.Measurement(e.service, entity)
 	if err != nil {
 		return 0, err
@@ -1,6 +1,8 @@
 package ucevcem
 
 import (
+	"time"
+
 	eebusutil "github.com/enbility/eebus-go/util"
 	"github.com/enbility/spine-go/model"
 	"github.com/stretchr/testify/assert"
@@ -108,9 +110,84 @@ func (s *UCEVCEMSuite) Test_EVCurrentPerPhase() {
 	fErr = rFeature.UpdateData(model.FunctionTypeMeasurementListData, measData, nil, nil)
 	assert.Nil(s.T(), fErr)
 
+	s.popSentMessages()
+
+	// the value has no timestamp, but is still only read from the cache
 	data, err = s.sut.CurrentPerPhase(s.evEntity)
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), 10.0, data[0])
+	assert.Equal(s.T(), 0, len(s.popSentMessages()))
+}
+
+func (s *UCEVCEMSuite) Test_EVCurrentPerPhaseDetails() {
+	data, err := s.sut.CurrentPerPhaseDetails(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	data, err = s.sut.CurrentPerPhaseDetails(s.evEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	paramDesc := &model.ElectricalConnectionParameterDescriptionListDataType{
+		ElectricalConnectionParameterDescriptionData: []model.ElectricalConnectionParameterDescriptionDataType{
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				ParameterId:            eebusutil.Ptr(model.ElectricalConnectionParameterIdType(0)),
+				MeasurementId:          eebusutil.Ptr(model.MeasurementIdType(0)),
+				ScopeType:              eebusutil.Ptr(model.ScopeTypeTypeACCurrent),
+				AcMeasuredPhases:       eebusutil.Ptr(model.ElectricalConnectionPhaseNameTypeA),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.evEntity, model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	fErr := rFeature.UpdateData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, paramDesc, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	measDesc := &model.MeasurementDescriptionListDataType{
+		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(0)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypeCurrent),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACCurrent),
+			},
+		},
+	}
+
+	rFeature = s.remoteDevice.FeatureByEntityTypeAndRole(s.evEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	fErr = rFeature.UpdateData(model.FunctionTypeMeasurementDescriptionListData, measDesc, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	timestamp := time.Now().Add(-2 * time.Minute).UTC()
+	measData := &model.MeasurementListDataType{
+		MeasurementData: []model.MeasurementDataType{
+			{
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+				Value:         model.NewScaledNumberType(10),
+				Timestamp:     model.NewAbsoluteOrRelativeTimeTypeFromTime(timestamp),
+				ValueSource:   eebusutil.Ptr(model.MeasurementValueSourceTypeMeasuredValue),
+			},
+		},
+	}
+
+	fErr = rFeature.UpdateData(model.FunctionTypeMeasurementListData, measData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.CurrentPerPhaseDetails(s.evEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), 10.0, data[0].Value)
+	assert.Equal(s.T(), timestamp.Unix(), data[0].Timestamp.Unix())
+	assert.Equal(s.T(), model.MeasurementValueStateTypeNormal, data[0].ValueState)
+	assert.Equal(s.T(), model.MeasurementValueSourceTypeMeasuredValue, data[0].ValueSource)
+	assert.True(s.T(), data[0].IsStale)
+
+	s.sut.SetStaleThreshold(5 * time.Minute)
+
+	data, err = s.sut.CurrentPerPhaseDetails(s.evEntity)
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), data[0].IsStale)
 }
 
 func (s *UCEVCEMSuite) Test_EVPowerPerPhase_Power() {
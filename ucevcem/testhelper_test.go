@@ -2,6 +2,7 @@ package ucevcem
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -34,6 +35,26 @@ type UCEVCEMSuite struct {
 	remoteDevice     spineapi.DeviceRemoteInterface
 	mockRemoteEntity *mocks.EntityRemoteInterface
 	evEntity         spineapi.EntityRemoteInterface
+
+	mux          sync.Mutex
+	sentMessages [][]byte
+}
+
+func (s *UCEVCEMSuite) writeMessage(message []byte) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.sentMessages = append(s.sentMessages, message)
+}
+
+// returns the messages sent to the remote device and clears the list
+func (s *UCEVCEMSuite) popSentMessages() [][]byte {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	messages := s.sentMessages
+	s.sentMessages = nil
+	return messages
 }
 
 func (s *UCEVCEMSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
@@ -66,20 +87,20 @@ func (s *UCEVCEMSuite) BeforeTest(suiteName, testName string) {
 	s.sut.AddUseCase()
 
 	var entities []spineapi.EntityRemoteInterface
-	s.remoteDevice, entities = setupDevices(s.service, s.T())
+	s.remoteDevice, entities = setupDevices(s.service, s.writeMessage, s.T())
 	s.evEntity = entities[1]
 }
 
 const remoteSki string = "testremoteski"
 
 func setupDevices(
-	eebusService eebusapi.ServiceInterface, t *testing.T) (
+	eebusService eebusapi.ServiceInterface, writeMessage func([]byte), t *testing.T) (
 	spineapi.DeviceRemoteInterface,
 	[]spineapi.EntityRemoteInterface) {
 	localDevice := eebusService.LocalDevice()
 
 	writeHandler := shipmocks.NewShipConnectionDataWriterInterface(t)
-	writeHandler.EXPECT().WriteShipMessageWithPayload(mock.Anything).Return().Maybe()
+	writeHandler.EXPECT().WriteShipMessageWithPayload(mock.Anything).Run(writeMessage).Return().Maybe()
 	sender := spine.NewSender(writeHandler)
 	remoteDevice := spine.NewDeviceRemote(localDevice, remoteSki, sender)
 
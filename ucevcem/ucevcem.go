@@ -1,12 +1,13 @@
 package ucevcem
 
 import (
+	"sync/atomic"
+
 	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	serviceapi "github.com/enbility/eebus-go/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
-	"github.com/enbility/spine-go/spine"
 )
 
 type UCEVCEM struct {
@@ -15,6 +16,15 @@ type UCEVCEM struct {
 	eventCB api.EventHandlerCB
 
 	validEntityTypes []model.EntityTypeType
+
+	// the client features for the remote server features the usecase uses
+	clientFeatures []model.FeatureTypeType
+
+	// the known compatible remote entities
+	entities util.KnownEntities
+
+	// the age after which measurement values are reported as stale, as a time.Duration
+	staleThreshold atomic.Int64
 }
 
 var _ UCEVCEMInterface = (*UCEVCEM)(nil)
@@ -24,12 +34,16 @@ func NewUCEVCEM(service serviceapi.ServiceInterface, eventCB api.EventHandlerCB)
 		service: service,
 		eventCB: eventCB,
 	}
+	uc.staleThreshold.Store(int64(api.DefaultStaleThreshold))
 
 	uc.validEntityTypes = []model.EntityTypeType{
 		model.EntityTypeTypeEV,
 	}
 
-	_ = spine.Events.Subscribe(uc)
+	uc.clientFeatures = []model.FeatureTypeType{
+		model.FeatureTypeTypeElectricalConnection,
+		model.FeatureTypeTypeMeasurement,
+	}
 
 	return uc
 }
@@ -42,11 +56,7 @@ func (e *UCEVCEM) AddFeatures() {
 	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
 
 	// client features
-	var clientFeatures = []model.FeatureTypeType{
-		model.FeatureTypeTypeElectricalConnection,
-		model.FeatureTypeTypeMeasurement,
-	}
-	for _, feature := range clientFeatures {
+	for _, feature := range e.clientFeatures {
 		f := localEntity.GetOrAddFeature(feature, model.RoleTypeClient)
 		f.AddResultHandler(e)
 	}
@@ -64,6 +74,31 @@ func (e *UCEVCEM) AddUseCase() {
 		[]model.UseCaseScenarioSupportType{1, 2, 3})
 }
 
+func (e *UCEVCEM) RemoveUseCase() {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.RemoveUseCaseSupport(model.UseCaseActorTypeCEM, e.UseCaseName())
+}
+
+func (e *UCEVCEM) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeCEM, e.UseCaseName(), available)
+}
+
+// returns the client features for the remote server features the usecase uses
+func (e *UCEVCEM) ClientFeatures() []model.FeatureTypeType {
+	return e.clientFeatures
+}
+
+// returns what a remote entity has to provide to support the usecase
+func (e *UCEVCEM) RemoteRequirements() api.UseCaseRequirements {
+	return api.UseCaseRequirements{
+		EntityTypes: e.validEntityTypes,
+		Actors:      []model.UseCaseActorType{model.UseCaseActorTypeEV},
+	}
+}
+
 // returns if the entity supports the usecase
 //
 // possible errors:
@@ -76,14 +111,52 @@ func (e *UCEVCEM) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (boo
 
 	// check if the usecase and mandatory scenarios are supported and
 	// if the required server features are available
-	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
-		model.UseCaseActorTypeEV,
-		e.UseCaseName(),
-		nil,
-		nil,
-	) {
+	if !util.VerifyUseCaseRequirements(entity, e.UseCaseName(), e.RemoteRequirements()) {
 		return false, nil
 	}
 
 	return true, nil
 }
+
+// returns the scenarios the remote entity announces and for which data is available
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCEVCEM) SupportedScenarios(entity spineapi.EntityRemoteInterface) ([]model.UseCaseScenarioSupportType, error) {
+	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	checks := map[model.UseCaseScenarioSupportType]func() error{
+		1: func() error {
+			_, err := e.CurrentPerPhaseDetails(entity)
+			return err
+		},
+		2: func() error {
+			_, err := e.PowerPerPhase(entity)
+			return err
+		},
+		3: func() error {
+			_, err := e.EnergyCharged(entity)
+			return err
+		},
+	}
+
+	return util.SupportedScenarios(entity, e.UseCaseName(), e.RemoteRequirements(), checks), nil
+}
+
+// request the descriptions, constraints and values of all features the usecase uses from a remote entity
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCEVCEM) Refresh(entity spineapi.EntityRemoteInterface) ([]api.RefreshResult, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	return util.RefreshFeatures(e.service, entity, e.clientFeatures), nil
+}
+
+func (e *UCEVCEM) CompatibleEntities() []spineapi.EntityRemoteInterface {
+	return e.entities.Entities()
+}
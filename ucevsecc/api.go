@@ -1,6 +1,8 @@
 package ucevsecc
 
 import (
+	"context"
+
 	"github.com/enbility/cemd/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
@@ -27,4 +29,19 @@ type UCEVSECCInterface interface {
 	//
 	// returns operatingState, lastErrorCode, error
 	OperatingState(entity spineapi.EntityRemoteInterface) (model.DeviceDiagnosisOperatingStateType, string, error)
+
+	// context aware variants
+
+	// the getters above with a context
+	//
+	// parameters:
+	//   - ctx: the context, its deadline limits the wait for a fresh value
+	//   - entity: the entity of the device
+	//   - options: if Fresh is set, the data is requested from the remote entity and the reply is awaited before the value is returned
+	//
+	// possible errors:
+	//   - the context error if the context is done before the reply is received
+	//   - and those of the getters above
+	ManufacturerDataContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (string, string, error)
+	OperatingStateContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (model.DeviceDiagnosisOperatingStateType, string, error)
 }
@@ -0,0 +1,48 @@
+package ucevsecc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cemtest"
+	"github.com/enbility/cemd/conformance"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestConformanceSuite(t *testing.T) {
+	suite.Run(t, new(ConformanceSuite))
+}
+
+type ConformanceSuite struct {
+	suite.Suite
+}
+
+const specification = "EEBus_UC_TS_EVSECommissioningAndConfiguration"
+
+var requirements = []conformance.Requirement{
+	conformance.UseCaseAnnounced("EVSECC-G-01", specification, model.UseCaseActorTypeCEM, 1, 2),
+	conformance.RemoteUseCase("EVSECC-G-02", specification, model.UseCaseActorTypeEVSE, 2),
+	conformance.EntityType("EVSECC-G-03", specification, model.EntityTypeTypeEVSE),
+	conformance.ClientFeature("EVSECC-S1-01", specification, model.FeatureTypeTypeDeviceClassification),
+	conformance.Read("EVSECC-S1-02", specification, model.FeatureTypeTypeDeviceClassification, model.FunctionTypeDeviceClassificationManufacturerData),
+	conformance.ClientFeature("EVSECC-S2-01", specification, model.FeatureTypeTypeDeviceDiagnosis),
+	conformance.ServerFeature("EVSECC-S2-02", specification, model.FeatureTypeTypeDeviceDiagnosis),
+	conformance.Read("EVSECC-S2-03", specification, model.FeatureTypeTypeDeviceDiagnosis, model.FunctionTypeDeviceDiagnosisStateData),
+	conformance.ResponseTime("EVSECC-S2-04", conformance.ImplementationReference,
+		conformance.Announcement(),
+		conformance.ReadResponse(model.FeatureTypeTypeDeviceDiagnosis, model.FunctionTypeDeviceDiagnosisStateData), time.Second),
+}
+
+func (s *ConformanceSuite) Test_Requirements() {
+	conformance.Verify(s.T(), conformance.Setup{
+		UseCase: func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) api.UseCaseInterface {
+			return NewUCEVSECC(service, eventCB)
+		},
+		Remote: func(device *cemtest.DeviceBuilder) *cemtest.EntityBuilder {
+			return cemtest.EVSE3Phase(device)
+		},
+	}, requirements)
+}
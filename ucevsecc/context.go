@@ -0,0 +1,52 @@
+package ucevsecc
+
+import (
+	"context"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// context aware variant of ManufacturerData
+func (e *UCEVSECC) ManufacturerDataContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (string, string, error) {
+	requests := []util.DataRequest{util.DeviceClassificationManufacturerRequest}
+
+	var serialNumber string
+	deviceName, err := util.ReadWithContext(ctx, e.service, entity, options, requests, func() (string, error) {
+		var deviceName string
+		var err error
+		deviceName, serialNumber, err = e.ManufacturerData(entity)
+		return deviceName, err
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return deviceName, serialNumber, nil
+}
+
+// context aware variant of OperatingState
+func (e *UCEVSECC) OperatingStateContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (model.DeviceDiagnosisOperatingStateType, string, error) {
+	requests := []util.DataRequest{util.DeviceDiagnosisStateRequest}
+
+	var lastErrorCode string
+	operatingState, err := util.ReadWithContext(ctx, e.service, entity, options, requests, func() (model.DeviceDiagnosisOperatingStateType, error) {
+		var operatingState model.DeviceDiagnosisOperatingStateType
+		var err error
+		operatingState, lastErrorCode, err = e.OperatingState(entity)
+		return operatingState, err
+	})
+	if err != nil {
+		return model.DeviceDiagnosisOperatingStateTypeNormalOperation, "", err
+	}
+
+	return operatingState, lastErrorCode, nil
+}
@@ -1,17 +1,31 @@
 package ucevsecc
 
 import (
+	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
 )
 
+// returns the SPINE events the usecase handles
+func (e *UCEVSECC) EventInterest() api.EventInterest {
+	return api.EventInterest{
+		EntityTypes:  e.validEntityTypes,
+		FeatureTypes: e.clientFeatures,
+		Functions: []model.FunctionType{
+			model.FunctionTypeDeviceClassificationManufacturerData,
+			model.FunctionTypeDeviceDiagnosisStateData,
+		},
+		DeviceEvents: true,
+	}
+}
+
 // handle SPINE events
 func (e *UCEVSECC) HandleEvent(payload spineapi.EventPayload) {
 	// only about events from an EVSE entity or device changes for this remote device
 
 	if util.IsDeviceDisconnected(payload) {
-		e.evseDisconnected(payload.Ski, payload.Entity)
+		e.deviceDisconnected(payload.Ski, payload.Device)
 		return
 	}
 
@@ -20,13 +34,19 @@ func (e *UCEVSECC) HandleEvent(payload spineapi.EventPayload) {
 	}
 
 	if util.IsEntityConnected(payload) {
+		e.entityAdded(payload.Ski, payload.Entity)
 		e.evseConnected(payload.Ski, payload.Entity)
 		return
 	} else if util.IsEntityDisconnected(payload) {
 		e.evseDisconnected(payload.Ski, payload.Entity)
+		e.entityRemoved(payload.Ski, payload.Entity)
 		return
 	}
 
+	if payload.EventType == spineapi.EventTypeDataChange {
+		e.entityAdded(payload.Ski, payload.Entity)
+	}
+
 	if payload.EventType != spineapi.EventTypeDataChange ||
 		payload.ChangeType != spineapi.ElementChangeUpdate {
 		return
@@ -40,6 +60,28 @@ func (e *UCEVSECC) HandleEvent(payload spineapi.EventPayload) {
 	}
 }
 
+// a compatible entity was connected or sent data
+func (e *UCEVSECC) entityAdded(ski string, entity spineapi.EntityRemoteInterface) {
+	if e.entities.Add(entity) {
+		e.eventCB(ski, entity.Device(), entity, EntityAdded)
+	}
+}
+
+// a compatible entity was disconnected
+func (e *UCEVSECC) entityRemoved(ski string, entity spineapi.EntityRemoteInterface) {
+	if e.entities.Remove(entity) {
+		e.eventCB(ski, entity.Device(), entity, EntityRemoved)
+	}
+}
+
+// a remote device was disconnected, so all of its known entities are removed
+func (e *UCEVSECC) deviceDisconnected(ski string, device spineapi.DeviceRemoteInterface) {
+	for _, entity := range e.entities.RemoveDevice(device) {
+		e.evseDisconnected(ski, entity)
+		e.eventCB(ski, device, entity, EntityRemoved)
+	}
+}
+
 // an EVSE was connected
 func (e *UCEVSECC) evseConnected(ski string, entity spineapi.EntityRemoteInterface) {
 	if evseDeviceClassification, err := util.DeviceClassification(e.service, entity); err == nil {
@@ -23,6 +23,10 @@ func (e *UCEVSECC) ManufacturerData(
 		return deviceName, serialNumber, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 1); err != nil {
+		return deviceName, serialNumber, err
+	}
+
 	evseDeviceClassification, err := util.DeviceClassification(e.service, entity)
 	if err != nil {
 		return deviceName, serialNumber, err
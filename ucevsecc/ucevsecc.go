@@ -6,7 +6,6 @@ import (
 	eebusapi "github.com/enbility/eebus-go/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
-	"github.com/enbility/spine-go/spine"
 )
 
 type UCEVSECC struct {
@@ -15,6 +14,12 @@ type UCEVSECC struct {
 	eventCB api.EventHandlerCB
 
 	validEntityTypes []model.EntityTypeType
+
+	// the client features for the remote server features the usecase uses
+	clientFeatures []model.FeatureTypeType
+
+	// the known compatible remote entities
+	entities util.KnownEntities
 }
 
 var _ UCEVSECCInterface = (*UCEVSECC)(nil)
@@ -29,7 +34,10 @@ func NewUCEVSECC(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB)
 		model.EntityTypeTypeEVSE,
 	}
 
-	_ = spine.Events.Subscribe(uc)
+	uc.clientFeatures = []model.FeatureTypeType{
+		model.FeatureTypeTypeDeviceClassification,
+		model.FeatureTypeTypeDeviceDiagnosis,
+	}
 
 	return uc
 }
@@ -42,12 +50,7 @@ func (e *UCEVSECC) AddFeatures() {
 	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
 
 	// client features
-	var clientFeatures = []model.FeatureTypeType{
-		model.FeatureTypeTypeDeviceClassification,
-		model.FeatureTypeTypeDeviceDiagnosis,
-	}
-
-	for _, feature := range clientFeatures {
+	for _, feature := range e.clientFeatures {
 		f := localEntity.GetOrAddFeature(feature, model.RoleTypeClient)
 		f.AddResultHandler(e)
 	}
@@ -65,6 +68,38 @@ func (e *UCEVSECC) AddUseCase() {
 		[]model.UseCaseScenarioSupportType{1, 2})
 }
 
+func (e *UCEVSECC) RemoveUseCase() {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.RemoveUseCaseSupport(model.UseCaseActorTypeCEM, e.UseCaseName())
+}
+
+func (e *UCEVSECC) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeCEM, e.UseCaseName(), available)
+}
+
+// returns the client features for the remote server features the usecase uses
+func (e *UCEVSECC) ClientFeatures() []model.FeatureTypeType {
+	return e.clientFeatures
+}
+
+// returns what a remote entity has to provide to support the usecase
+func (e *UCEVSECC) RemoteRequirements() api.UseCaseRequirements {
+	return api.UseCaseRequirements{
+		EntityTypes: e.validEntityTypes,
+		Actors: []model.UseCaseActorType{
+			model.UseCaseActorTypeEVSE,
+			// Workaround for the Porsche Mobile Charger Connect that falsely reports
+			// the usecase to be on the EV actor
+			model.UseCaseActorTypeEV,
+		},
+		Scenarios:      []model.UseCaseScenarioSupportType{2},
+		ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeDeviceDiagnosis},
+	}
+}
+
 // returns if the entity supports the usecase
 //
 // possible errors:
@@ -77,24 +112,48 @@ func (e *UCEVSECC) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bo
 
 	// check if the usecase and mandatory scenarios are supported and
 	// if the required server features are available
-	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
-		model.UseCaseActorTypeEVSE,
-		e.UseCaseName(),
-		[]model.UseCaseScenarioSupportType{2},
-		[]model.FeatureTypeType{model.FeatureTypeTypeDeviceDiagnosis},
-	) {
-		// Workaround for the Porsche Mobile Charger Connect that falsely reports
-		// the usecase to be on the EV actor
-		if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
-			model.UseCaseActorTypeEV,
-			e.UseCaseName(),
-			[]model.UseCaseScenarioSupportType{2},
-			[]model.FeatureTypeType{model.FeatureTypeTypeDeviceDiagnosis},
-		) {
-			return false, nil
-		}
-
+	if !util.VerifyUseCaseRequirements(entity, e.UseCaseName(), e.RemoteRequirements()) {
+		return false, nil
 	}
 
 	return true, nil
 }
+
+// returns the scenarios the remote entity announces and for which data is available
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCEVSECC) SupportedScenarios(entity spineapi.EntityRemoteInterface) ([]model.UseCaseScenarioSupportType, error) {
+	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	checks := map[model.UseCaseScenarioSupportType]func() error{
+		1: func() error {
+			_, _, err := e.ManufacturerData(entity)
+			return err
+		},
+		2: func() error {
+			_, _, err := e.OperatingState(entity)
+			return err
+		},
+	}
+
+	return util.SupportedScenarios(entity, e.UseCaseName(), e.RemoteRequirements(), checks), nil
+}
+
+// request the descriptions, constraints and values of all features the usecase uses from a remote entity
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCEVSECC) Refresh(entity spineapi.EntityRemoteInterface) ([]api.RefreshResult, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	return util.RefreshFeatures(e.service, entity, e.clientFeatures), nil
+}
+
+func (e *UCEVSECC) CompatibleEntities() []spineapi.EntityRemoteInterface {
+	return e.entities.Entities()
+}
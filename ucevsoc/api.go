@@ -1,6 +1,9 @@
 package ucevsoc
 
 import (
+	"context"
+	"time"
+
 	"github.com/enbility/cemd/api"
 	spineapi "github.com/enbility/spine-go/api"
 )
@@ -11,6 +14,12 @@ import (
 type UCEVSOCInterface interface {
 	api.UseCaseInterface
 
+	// set the age after which measurement values are reported as stale
+	//
+	// parameters:
+	//   - threshold: the maximum age, 0 disables the staleness detection
+	SetStaleThreshold(threshold time.Duration)
+
 	// Scenario 1
 
 	// return the EVscurrent state of charge of the EV or an error it is unknown
@@ -19,5 +28,26 @@ type UCEVSOCInterface interface {
 	//   - entity: the entity of the EV
 	StateOfCharge(entity spineapi.EntityRemoteInterface) (float64, error)
 
+	// return the current state of charge of the EV including the timestamp, state and source of the value
+	//
+	// parameters:
+	//   - entity: the entity of the EV
+	StateOfChargeDetails(entity spineapi.EntityRemoteInterface) (api.MeasurementResult, error)
+
 	// Scenario 2 to 4 are not supported, as there is no EV supporting this as of today
+
+	// context aware variants
+
+	// the getters above with a context
+	//
+	// parameters:
+	//   - ctx: the context, its deadline limits the wait for a fresh value
+	//   - entity: the entity of the device
+	//   - options: if Fresh is set, the data is requested from the remote entity and the reply is awaited before the value is returned
+	//
+	// possible errors:
+	//   - the context error if the context is done before the reply is received
+	//   - and those of the getters above
+	StateOfChargeContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (float64, error)
+	StateOfChargeDetailsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (api.MeasurementResult, error)
 }
@@ -0,0 +1,48 @@
+package ucevsoc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cemtest"
+	"github.com/enbility/cemd/conformance"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestConformanceSuite(t *testing.T) {
+	suite.Run(t, new(ConformanceSuite))
+}
+
+type ConformanceSuite struct {
+	suite.Suite
+}
+
+const specification = "EEBus_UC_TS_EVStateOfCharge"
+
+var requirements = []conformance.Requirement{
+	conformance.UseCaseAnnounced("EVSOC-G-01", specification, model.UseCaseActorTypeCEM, 1),
+	conformance.RemoteUseCase("EVSOC-G-02", specification, model.UseCaseActorTypeEV, 1),
+	conformance.EntityType("EVSOC-G-03", specification, model.EntityTypeTypeEV),
+	conformance.ClientFeature("EVSOC-S1-01", specification, model.FeatureTypeTypeMeasurement),
+	conformance.ServerFeature("EVSOC-S1-02", specification, model.FeatureTypeTypeMeasurement),
+	conformance.Subscription("EVSOC-S1-03", specification, model.FeatureTypeTypeMeasurement),
+	conformance.Read("EVSOC-S1-04", specification, model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData),
+	conformance.Read("EVSOC-S1-05", specification, model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData),
+	conformance.ResponseTime("EVSOC-S1-06", conformance.ImplementationReference,
+		conformance.Notification(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData),
+		conformance.ReadResponse(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData), time.Second),
+}
+
+func (s *ConformanceSuite) Test_Requirements() {
+	conformance.Verify(s.T(), conformance.Setup{
+		UseCase: func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) api.UseCaseInterface {
+			return NewUCEVSOC(service, eventCB)
+		},
+		Remote: func(device *cemtest.DeviceBuilder) *cemtest.EntityBuilder {
+			return cemtest.EVISO15118WithSoC(cemtest.EVSE3Phase(device), 50)
+		},
+	}, requirements)
+}
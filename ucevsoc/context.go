@@ -0,0 +1,33 @@
+package ucevsoc
+
+import (
+	"context"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// context aware variant of StateOfCharge
+func (e *UCEVSOC) StateOfChargeContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.StateOfCharge(entity)
+	})
+}
+
+// context aware variant of StateOfChargeDetails
+func (e *UCEVSOC) StateOfChargeDetailsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (api.MeasurementResult, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (api.MeasurementResult, error) {
+		return e.StateOfChargeDetails(entity)
+	})
+}
@@ -1,20 +1,48 @@
 package ucevsoc
 
 import (
+	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	"github.com/enbility/ship-go/logging"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
 )
 
+// returns the SPINE events the usecase handles
+func (e *UCEVSOC) EventInterest() api.EventInterest {
+	return api.EventInterest{
+		EntityTypes:  e.validEntityTypes,
+		FeatureTypes: e.clientFeatures,
+		Functions: []model.FunctionType{
+			model.FunctionTypeMeasurementDescriptionListData,
+			model.FunctionTypeMeasurementListData,
+		},
+		DeviceEvents: true,
+	}
+}
+
 // handle SPINE events
 func (e *UCEVSOC) HandleEvent(payload spineapi.EventPayload) {
 	// only about events from an EV entity or device changes for this remote device
 
+	if util.IsDeviceDisconnected(payload) {
+		e.deviceDisconnected(payload.Ski, payload.Device)
+		return
+	}
+
 	if !util.IsCompatibleEntity(payload.Entity, e.validEntityTypes) {
 		return
 	}
 
+	if util.IsEntityDisconnected(payload) {
+		e.entityRemoved(payload.Ski, payload.Entity)
+		return
+	}
+
+	if util.IsEntityConnected(payload) || payload.EventType == spineapi.EventTypeDataChange {
+		e.entityAdded(payload.Ski, payload.Entity)
+	}
+
 	if util.IsEntityConnected(payload) {
 		e.evConnected(payload.Entity)
 		return
@@ -28,11 +56,34 @@ func (e *UCEVSOC) HandleEvent(payload spineapi.EventPayload) {
 	// the codefactor warning is invalid, as .(type) check can not be replaced with if then
 	//revive:disable-next-line
 	switch payload.Data.(type) {
+	case *model.MeasurementDescriptionListDataType:
+		e.evMeasurementDescriptionDataUpdate(payload.Entity)
 	case *model.MeasurementListDataType:
 		e.evMeasurementDataUpdate(payload.Ski, payload.Entity)
 	}
 }
 
+// a compatible entity was connected or sent data
+func (e *UCEVSOC) entityAdded(ski string, entity spineapi.EntityRemoteInterface) {
+	if e.entities.Add(entity) {
+		e.eventCB(ski, entity.Device(), entity, EntityAdded)
+	}
+}
+
+// a compatible entity was disconnected
+func (e *UCEVSOC) entityRemoved(ski string, entity spineapi.EntityRemoteInterface) {
+	if e.entities.Remove(entity) {
+		e.eventCB(ski, entity.Device(), entity, EntityRemoved)
+	}
+}
+
+// a remote device was disconnected, so all of its known entities are removed
+func (e *UCEVSOC) deviceDisconnected(ski string, device spineapi.DeviceRemoteInterface) {
+	for _, entity := range e.entities.RemoveDevice(device) {
+		e.eventCB(ski, device, entity, EntityRemoved)
+	}
+}
+
 // an EV was connected
 func (e *UCEVSOC) evConnected(entity spineapi.EntityRemoteInterface) {
 	// initialise features, e.g. subscriptions, descriptions
@@ -53,6 +104,16 @@ func (e *UCEVSOC) evConnected(entity spineapi.EntityRemoteInterface) {
 	}
 }
 
+// the measurement description data of an EV was updated
+func (e *UCEVSOC) evMeasurementDescriptionDataUpdate(entity spineapi.EntityRemoteInterface) {
+	if evMeasurement, err := util.Measurement(e.service, entity); err == nil {
+		// get measurement values
+		if _, err := evMeasurement.RequestValues(); err != nil {
+			logging.Log().Debug(err)
+		}
+	}
+}
+
 // the measurement data of an EV was updated
 func (e *UCEVSOC) evMeasurementDataUpdate(ski string, entity spineapi.EntityRemoteInterface) {
 	// Scenario 1
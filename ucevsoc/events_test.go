@@ -1,6 +1,8 @@
 package ucevsoc
 
 import (
+	"strings"
+
 	eebusutil "github.com/enbility/eebus-go/util"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
@@ -26,10 +28,49 @@ func (s *UCEVSOCSuite) Test_Events() {
 
 	payload.EventType = spineapi.EventTypeDataChange
 	payload.ChangeType = spineapi.ElementChangeUpdate
+	payload.Data = eebusutil.Ptr(model.MeasurementDescriptionListDataType{})
+	s.sut.HandleEvent(payload)
+
 	payload.Data = eebusutil.Ptr(model.MeasurementListDataType{})
 	s.sut.HandleEvent(payload)
 }
 
+func (s *UCEVSOCSuite) Test_EventsRequestValues() {
+	s.popSentMessages()
+
+	payload := spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     s.remoteDevice,
+		Entity:     s.evEntity,
+		EventType:  spineapi.EventTypeEntityChange,
+		ChangeType: spineapi.ElementChangeAdd,
+	}
+	s.sut.HandleEvent(payload)
+
+	// the values can only be interpreted with their descriptions
+	assert.Equal(s.T(), 0, countValueReads(s.popSentMessages()))
+
+	payload.EventType = spineapi.EventTypeDataChange
+	payload.ChangeType = spineapi.ElementChangeUpdate
+	payload.Data = eebusutil.Ptr(model.MeasurementDescriptionListDataType{})
+	s.sut.HandleEvent(payload)
+
+	assert.Equal(s.T(), 1, countValueReads(s.popSentMessages()))
+}
+
+// returns the number of messages reading the measurement values
+func countValueReads(messages [][]byte) int {
+	count := 0
+	for _, message := range messages {
+		if strings.Contains(string(message), `"cmdClassifier":"read"`) &&
+			strings.Contains(string(message), `"measurementListData"`) {
+			count++
+		}
+	}
+
+	return count
+}
+
 func (s *UCEVSOCSuite) Test_evMeasurementDataUpdate() {
 	s.sut.evMeasurementDataUpdate(remoteSki, s.mockRemoteEntity)
 
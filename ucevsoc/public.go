@@ -1,6 +1,8 @@
 package ucevsoc
 
 import (
+	"time"
+
 	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	eebusapi "github.com/enbility/eebus-go/api"
@@ -8,6 +10,13 @@ import (
 	"github.com/enbility/spine-go/model"
 )
 
+// set the age after which measurement values are reported as stale
+//
+// the default is api.DefaultStaleThreshold, 0 disables the staleness detection
+func (e *UCEVSOC) SetStaleThreshold(threshold time.Duration) {
+	e.staleThreshold.Store(int64(threshold))
+}
+
 // return the last known SoC of the connected EV
 //
 // only works with a current ISO15118-2 with VAS or ISO15118-20
@@ -17,25 +26,43 @@ import (
 //   - ErrDataNotAvailable if no such measurement is (yet) available
 //   - and others
 func (e *UCEVSOC) StateOfCharge(entity spineapi.EntityRemoteInterface) (float64, error) {
+	result, err := e.StateOfChargeDetails(entity)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Value, nil
+}
+
+// return the last known SoC of the connected EV
+// including the timestamp, state and source of the value
+//
+// only works with a current ISO15118-2 with VAS or ISO15118-20
+// communication between EVSE and EV
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such measurement is (yet) available
+//   - and others
+func (e *UCEVSOC) StateOfChargeDetails(entity spineapi.EntityRemoteInterface) (api.MeasurementResult, error) {
 	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
-		return 0, api.ErrNoCompatibleEntity
+		return api.MeasurementResult{}, api.ErrNoCompatibleEntity
 	}
 
 	evMeasurement, err := util.Measurement(e.service, entity)
 	if err != nil || evMeasurement == nil {
-		return 0, err
+		return api.MeasurementResult{}, err
 	}
 
 	data, err := evMeasurement.GetValuesForTypeCommodityScope(model.MeasurementTypeTypePercentage, model.CommodityTypeTypeElectricity, model.ScopeTypeTypeStateOfCharge)
 	if err != nil {
-		return 0, err
+		return api.MeasurementResult{}, err
 	}
 
 	// we assume there is only one value, nil is already checked
 	value := data[0].Value
 	if value == nil {
-		return 0, eebusapi.ErrDataNotAvailable
+		return api.MeasurementResult{}, eebusapi.ErrDataNotAvailable
 	}
 
-	return value.GetValue(), nil
+	return util.MeasurementResultForData(data[0], time.Duration(e.staleThreshold.Load())), nil
 }
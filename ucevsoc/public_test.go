@@ -1,6 +1,8 @@
 package ucevsoc
 
 import (
+	"time"
+
 	eebusutil "github.com/enbility/eebus-go/util"
 	"github.com/enbility/spine-go/model"
 	"github.com/stretchr/testify/assert"
@@ -89,3 +91,100 @@ func (s *UCEVSOCSuite) Test_StateOfCharge() {
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), 80.0, data)
 }
+
+func (s *UCEVSOCSuite) Test_StateOfChargeDetails() {
+	timestamp := time.Now().Add(-2 * time.Minute).UTC()
+
+	data, err := s.sut.StateOfChargeDetails(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	data, err = s.sut.StateOfChargeDetails(s.evEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	ucData := &model.NodeManagementUseCaseDataType{
+		UseCaseInformation: []model.UseCaseInformationDataType{
+			{
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeEV),
+				UseCaseSupport: []model.UseCaseSupportType{
+					{
+						UseCaseName:      eebusutil.Ptr(model.UseCaseNameTypeEVStateOfCharge),
+						UseCaseAvailable: eebusutil.Ptr(true),
+						ScenarioSupport:  []model.UseCaseScenarioSupportType{1},
+					},
+				},
+			},
+		},
+	}
+
+	nodemgmtEntity := s.remoteDevice.Entity([]model.AddressEntityType{0})
+	nodeFeature := s.remoteDevice.FeatureByEntityTypeAndRole(nodemgmtEntity, model.FeatureTypeTypeNodeManagement, model.RoleTypeSpecial)
+	fErr := nodeFeature.UpdateData(model.FunctionTypeNodeManagementUseCaseData, ucData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.StateOfChargeDetails(s.evEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	measDesc := &model.MeasurementDescriptionListDataType{
+		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(0)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypePercentage),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeStateOfCharge),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.evEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	fErr = rFeature.UpdateData(model.FunctionTypeMeasurementDescriptionListData, measDesc, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.StateOfChargeDetails(s.evEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	measData := &model.MeasurementListDataType{
+		MeasurementData: []model.MeasurementDataType{
+			{
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+			},
+		},
+	}
+
+	fErr = rFeature.UpdateData(model.FunctionTypeMeasurementListData, measData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.StateOfChargeDetails(s.evEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	measData = &model.MeasurementListDataType{
+		MeasurementData: []model.MeasurementDataType{
+			{
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+				Value:         model.NewScaledNumberType(80),
+				Timestamp:     model.NewAbsoluteOrRelativeTimeTypeFromTime(timestamp),
+				ValueState:    eebusutil.Ptr(model.MeasurementValueStateTypeOutofrange),
+			},
+		},
+	}
+
+	fErr = rFeature.UpdateData(model.FunctionTypeMeasurementListData, measData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.StateOfChargeDetails(s.evEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 80.0, data.Value)
+	assert.Equal(s.T(), timestamp.Unix(), data.Timestamp.Unix())
+	assert.Equal(s.T(), model.MeasurementValueStateTypeOutofrange, data.ValueState)
+	assert.True(s.T(), data.IsStale)
+
+	s.sut.SetStaleThreshold(0)
+
+	data, err = s.sut.StateOfChargeDetails(s.evEntity)
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), data.IsStale)
+}
@@ -3,6 +3,22 @@ package ucevsoc
 import "github.com/enbility/cemd/api"
 
 const (
+	// A remote entity compatible with the use case was added
+	//
+	// The callback with this message provides:
+	//   - the device of the entity
+	//   - the entity, its address is available with Address()
+	//
+	// The event is sent before any other event of the entity
+	EntityAdded api.EventType = "ucevsoc.EntityAdded"
+
+	// A remote entity compatible with the use case was removed, or its device was disconnected
+	//
+	// The callback with this message provides:
+	//   - the device of the entity
+	//   - the entity, its address is available with Address()
+	EntityRemoved api.EventType = "ucevsoc.EntityRemoved"
+
 	// EV state of charge data was updated
 	//
 	// The callback with this message provides:
@@ -56,3 +56,8 @@ func (s *UCEVSOCSuite) Test_IsUseCaseSupported() {
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), true, data)
 }
+
+func (s *UCEVSOCSuite) Test_EventInterestDescriptions() {
+	// the values are requested once their descriptions are received
+	assert.Contains(s.T(), s.sut.EventInterest().Functions, model.FunctionTypeMeasurementDescriptionListData)
+}
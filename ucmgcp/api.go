@@ -1,6 +1,9 @@
 package ucmgcp
 
 import (
+	"context"
+	"time"
+
 	"github.com/enbility/cemd/api"
 	spineapi "github.com/enbility/spine-go/api"
 )
@@ -11,6 +14,12 @@ import (
 type UCMGCPInterface interface {
 	api.UseCaseInterface
 
+	// set the age after which measurement values are reported as stale
+	//
+	// parameters:
+	//   - threshold: the maximum age, 0 disables the staleness detection
+	SetStaleThreshold(threshold time.Duration)
+
 	// Scenario 1
 
 	// return the current power limitation factor
@@ -19,6 +28,7 @@ type UCMGCPInterface interface {
 	//   - entity: the entity of the device (e.g. SMGW)
 	//
 	// possible errors:
+	//   - ErrScenarioNotSupported if the remote device does not announce the scenario
 	//   - ErrDataNotAvailable if no such limit is (yet) available
 	//   - and others
 	PowerLimitationFactor(entity spineapi.EntityRemoteInterface) (float64, error)
@@ -35,6 +45,12 @@ type UCMGCPInterface interface {
 	//   - negative values are used for production
 	Power(entity spineapi.EntityRemoteInterface) (float64, error)
 
+	// return the momentary power consumption or production at the grid connection point including the timestamp, state and source of the value
+	//
+	// parameters:
+	//   - entity: the entity of the device (e.g. SMGW)
+	PowerDetails(entity spineapi.EntityRemoteInterface) (api.MeasurementResult, error)
+
 	// Scenario 3
 
 	// return the total feed in energy at the grid connection point
@@ -69,6 +85,17 @@ type UCMGCPInterface interface {
 	//   - negative values are used for production
 	CurrentPerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error)
 
+	// return the momentary current consumption or production at the grid connection point
+	// including the phase, timestamp, state and source of each value
+	//
+	// parameters:
+	//   - entity: the entity of the device (e.g. SMGW)
+	//
+	// return values:
+	//   - positive values are used for consumption
+	//   - negative values are used for production
+	CurrentPerPhaseDetails(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error)
+
 	// Scenario 6
 
 	// return the voltage phase details at the grid connection point
@@ -77,6 +104,13 @@ type UCMGCPInterface interface {
 	//   - entity: the entity of the device (e.g. SMGW)
 	VoltagePerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error)
 
+	// return the voltage per phase at the grid connection point
+	// including the phase, timestamp, state and source of each value
+	//
+	// parameters:
+	//   - entity: the entity of the device (e.g. SMGW)
+	VoltagePerPhaseDetails(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error)
+
 	// Scenario 7
 
 	// return frequency at the grid connection point
@@ -84,4 +118,27 @@ type UCMGCPInterface interface {
 	// parameters:
 	//   - entity: the entity of the device (e.g. SMGW)
 	Frequency(entity spineapi.EntityRemoteInterface) (float64, error)
+
+	// context aware variants
+
+	// the getters above with a context
+	//
+	// parameters:
+	//   - ctx: the context, its deadline limits the wait for a fresh value
+	//   - entity: the entity of the device
+	//   - options: if Fresh is set, the data is requested from the remote entity and the reply is awaited before the value is returned
+	//
+	// possible errors:
+	//   - the context error if the context is done before the reply is received
+	//   - and those of the getters above
+	PowerLimitationFactorContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (float64, error)
+	PowerContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (float64, error)
+	PowerDetailsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (api.MeasurementResult, error)
+	EnergyFeedInContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (float64, error)
+	EnergyConsumedContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (float64, error)
+	CurrentPerPhaseContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]float64, error)
+	CurrentPerPhaseDetailsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]api.MeasurementResult, error)
+	VoltagePerPhaseContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]float64, error)
+	VoltagePerPhaseDetailsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]api.MeasurementResult, error)
+	FrequencyContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (float64, error)
 }
@@ -0,0 +1,57 @@
+package ucmgcp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cemtest"
+	"github.com/enbility/cemd/conformance"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestConformanceSuite(t *testing.T) {
+	suite.Run(t, new(ConformanceSuite))
+}
+
+type ConformanceSuite struct {
+	suite.Suite
+}
+
+const specification = "EEBus_UC_TS_MonitoringOfGridConnectionPoint"
+
+var requirements = []conformance.Requirement{
+	conformance.UseCaseAnnounced("MGCP-G-01", specification, model.UseCaseActorTypeMonitoringAppliance, 1, 2, 3, 4, 5, 6, 7),
+	conformance.RemoteUseCase("MGCP-G-02", specification, model.UseCaseActorTypeGridConnectionPoint, 2, 3, 4),
+	conformance.EntityType("MGCP-G-03", specification, model.EntityTypeTypeGridConnectionPointOfPremises),
+	conformance.ClientFeature("MGCP-G-04", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.ClientFeature("MGCP-G-05", specification, model.FeatureTypeTypeMeasurement),
+	conformance.ServerFeature("MGCP-G-06", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.ServerFeature("MGCP-G-07", specification, model.FeatureTypeTypeMeasurement),
+	conformance.Subscription("MGCP-G-08", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.Subscription("MGCP-G-09", specification, model.FeatureTypeTypeMeasurement),
+	conformance.Read("MGCP-G-10", specification, model.FeatureTypeTypeElectricalConnection, model.FunctionTypeElectricalConnectionDescriptionListData),
+	conformance.Read("MGCP-G-11", specification, model.FeatureTypeTypeElectricalConnection, model.FunctionTypeElectricalConnectionParameterDescriptionListData),
+	conformance.Read("MGCP-G-12", specification, model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData),
+	conformance.Read("MGCP-G-13", specification, model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData),
+	conformance.ResponseTime("MGCP-G-14", conformance.ImplementationReference,
+		conformance.Notification(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData),
+		conformance.ReadResponse(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData), time.Second),
+	conformance.ClientFeature("MGCP-S1-01", specification, model.FeatureTypeTypeDeviceConfiguration),
+	conformance.Subscription("MGCP-S1-02", specification, model.FeatureTypeTypeDeviceConfiguration),
+	conformance.Read("MGCP-S1-03", specification, model.FeatureTypeTypeDeviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData),
+	conformance.Read("MGCP-S1-04", specification, model.FeatureTypeTypeDeviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueListData),
+}
+
+func (s *ConformanceSuite) Test_Requirements() {
+	conformance.Verify(s.T(), conformance.Setup{
+		UseCase: func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) api.UseCaseInterface {
+			return NewUCMGCP(service, eventCB)
+		},
+		Remote: func(device *cemtest.DeviceBuilder) *cemtest.EntityBuilder {
+			return cemtest.GridConnectionPoint(device)
+		},
+	}, requirements)
+}
@@ -0,0 +1,129 @@
+package ucmgcp
+
+import (
+	"context"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// context aware variant of PowerLimitationFactor
+func (e *UCMGCP) PowerLimitationFactorContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.DeviceConfigurationKeyValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.PowerLimitationFactor(entity)
+	})
+}
+
+// context aware variant of Power
+func (e *UCMGCP) PowerContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.Power(entity)
+	})
+}
+
+// context aware variant of PowerDetails
+func (e *UCMGCP) PowerDetailsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (api.MeasurementResult, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (api.MeasurementResult, error) {
+		return e.PowerDetails(entity)
+	})
+}
+
+// context aware variant of EnergyFeedIn
+func (e *UCMGCP) EnergyFeedInContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.EnergyFeedIn(entity)
+	})
+}
+
+// context aware variant of EnergyConsumed
+func (e *UCMGCP) EnergyConsumedContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.EnergyConsumed(entity)
+	})
+}
+
+// context aware variant of CurrentPerPhase
+func (e *UCMGCP) CurrentPerPhaseContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]float64, error) {
+		return e.CurrentPerPhase(entity)
+	})
+}
+
+// context aware variant of CurrentPerPhaseDetails
+func (e *UCMGCP) CurrentPerPhaseDetailsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]api.MeasurementResult, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]api.MeasurementResult, error) {
+		return e.CurrentPerPhaseDetails(entity)
+	})
+}
+
+// context aware variant of VoltagePerPhase
+func (e *UCMGCP) VoltagePerPhaseContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]float64, error) {
+		return e.VoltagePerPhase(entity)
+	})
+}
+
+// context aware variant of VoltagePerPhaseDetails
+func (e *UCMGCP) VoltagePerPhaseDetailsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]api.MeasurementResult, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]api.MeasurementResult, error) {
+		return e.VoltagePerPhaseDetails(entity)
+	})
+}
+
+// context aware variant of Frequency
+func (e *UCMGCP) FrequencyContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.Frequency(entity)
+	})
+}
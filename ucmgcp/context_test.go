@@ -0,0 +1,66 @@
+package ucmgcp
+
+import (
+	"context"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCMGCPSuite) Test_FrequencyContext() {
+	ctx := context.Background()
+	options := api.ReadOptions{Fresh: true}
+
+	data, err := s.sut.FrequencyContext(ctx, s.mockRemoteEntity, options)
+	assert.ErrorIs(s.T(), err, api.ErrNoCompatibleEntity)
+	assert.Equal(s.T(), 0.0, data)
+
+	descData := &model.MeasurementDescriptionListDataType{
+		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(0)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypeFrequency),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACFrequency),
+			},
+		},
+	}
+
+	measData := &model.MeasurementListDataType{
+		MeasurementData: []model.MeasurementDataType{
+			{
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+				Value:         model.NewScaledNumberType(50),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.smgwEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	fErr := rFeature.UpdateData(model.FunctionTypeMeasurementDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+	fErr = rFeature.UpdateData(model.FunctionTypeMeasurementListData, measData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	// the cached value
+	data, err = s.sut.FrequencyContext(ctx, s.smgwEntity, api.ReadOptions{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 50.0, data)
+
+	// the remote device does not reply to the request
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Millisecond*10)
+	defer cancel()
+
+	data, err = s.sut.FrequencyContext(timeoutCtx, s.smgwEntity, options)
+	assert.ErrorIs(s.T(), err, context.DeadlineExceeded)
+	assert.Equal(s.T(), 0.0, data)
+
+	// no request is sent for a scenario the remote device does not announce
+	s.setUseCaseScenarios([]model.UseCaseScenarioSupportType{2, 3, 4})
+
+	data, err = s.sut.FrequencyContext(ctx, s.smgwEntity, options)
+	assert.ErrorIs(s.T(), err, api.ErrScenarioNotSupported)
+	assert.Equal(s.T(), 0.0, data)
+}
@@ -0,0 +1,57 @@
+package ucmgcp
+
+import (
+	"github.com/enbility/cemd/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCMGCPSuite) Test_EntityEvents() {
+	var events []api.EventType
+	s.sut.eventCB = func(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+		assert.Equal(s.T(), s.smgwEntity, entity)
+		events = append(events, event)
+	}
+
+	assert.Equal(s.T(), 0, len(s.sut.CompatibleEntities()))
+
+	payload := spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     s.remoteDevice,
+		Entity:     s.smgwEntity,
+		EventType:  spineapi.EventTypeEntityChange,
+		ChangeType: spineapi.ElementChangeAdd,
+	}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), []api.EventType{EntityAdded}, events)
+	assert.Equal(s.T(), []spineapi.EntityRemoteInterface{s.smgwEntity}, s.sut.CompatibleEntities())
+
+	// a known entity is not added again
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), 1, len(events))
+
+	payload.ChangeType = spineapi.ElementChangeRemove
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), []api.EventType{EntityAdded, EntityRemoved}, events)
+	assert.Equal(s.T(), 0, len(s.sut.CompatibleEntities()))
+
+	// data of an entity connected before the use case was created
+	payload.EventType = spineapi.EventTypeDataChange
+	payload.ChangeType = spineapi.ElementChangeUpdate
+	payload.Data = &model.DeviceConfigurationKeyValueDescriptionListDataType{}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), EntityAdded, events[2])
+	assert.Equal(s.T(), 1, len(s.sut.CompatibleEntities()))
+
+	// all entities of a disconnected device are removed
+	payload = spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     s.remoteDevice,
+		EventType:  spineapi.EventTypeDeviceChange,
+		ChangeType: spineapi.ElementChangeRemove,
+	}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), EntityRemoved, events[3])
+	assert.Equal(s.T(), 0, len(s.sut.CompatibleEntities()))
+}
@@ -1,20 +1,50 @@
 package ucmgcp
 
 import (
+	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	"github.com/enbility/ship-go/logging"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
 )
 
+// returns the SPINE events the usecase handles
+func (e *UCMGCP) EventInterest() api.EventInterest {
+	return api.EventInterest{
+		EntityTypes:  e.validEntityTypes,
+		FeatureTypes: e.clientFeatures,
+		Functions: []model.FunctionType{
+			model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData,
+			model.FunctionTypeDeviceConfigurationKeyValueListData,
+			model.FunctionTypeMeasurementDescriptionListData,
+			model.FunctionTypeMeasurementListData,
+		},
+		DeviceEvents: true,
+	}
+}
+
 // handle SPINE events
 func (e *UCMGCP) HandleEvent(payload spineapi.EventPayload) {
 	// only about events from an SGMW entity or device changes for this remote device
 
+	if util.IsDeviceDisconnected(payload) {
+		e.deviceDisconnected(payload.Ski, payload.Device)
+		return
+	}
+
 	if !util.IsCompatibleEntity(payload.Entity, e.validEntityTypes) {
 		return
 	}
 
+	if util.IsEntityDisconnected(payload) {
+		e.entityRemoved(payload.Ski, payload.Entity)
+		return
+	}
+
+	if util.IsEntityConnected(payload) || payload.EventType == spineapi.EventTypeDataChange {
+		e.entityAdded(payload.Ski, payload.Entity)
+	}
+
 	if util.IsEntityConnected(payload) {
 		e.gridConnected(payload.Entity)
 		return
@@ -37,6 +67,27 @@ func (e *UCMGCP) HandleEvent(payload spineapi.EventPayload) {
 	}
 }
 
+// a compatible entity was connected or sent data
+func (e *UCMGCP) entityAdded(ski string, entity spineapi.EntityRemoteInterface) {
+	if e.entities.Add(entity) {
+		e.eventCB(ski, entity.Device(), entity, EntityAdded)
+	}
+}
+
+// a compatible entity was disconnected
+func (e *UCMGCP) entityRemoved(ski string, entity spineapi.EntityRemoteInterface) {
+	if e.entities.Remove(entity) {
+		e.eventCB(ski, entity.Device(), entity, EntityRemoved)
+	}
+}
+
+// a remote device was disconnected, so all of its known entities are removed
+func (e *UCMGCP) deviceDisconnected(ski string, device spineapi.DeviceRemoteInterface) {
+	for _, entity := range e.entities.RemoveDevice(device) {
+		e.eventCB(ski, device, entity, EntityRemoved)
+	}
+}
+
 // process required steps when a grid device is connected
 func (e *UCMGCP) gridConnected(entity spineapi.EntityRemoteInterface) {
 	if deviceConfiguration, err := util.DeviceConfiguration(e.service, entity); err == nil {
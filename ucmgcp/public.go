@@ -1,6 +1,8 @@
 package ucmgcp
 
 import (
+	"time"
+
 	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	eebusapi "github.com/enbility/eebus-go/api"
@@ -8,6 +10,13 @@ import (
 	"github.com/enbility/spine-go/model"
 )
 
+// set the age after which measurement values are reported as stale
+//
+// the default is api.DefaultStaleThreshold, 0 disables the staleness detection
+func (e *UCMGCP) SetStaleThreshold(threshold time.Duration) {
+	e.staleThreshold.Store(int64(threshold))
+}
+
 // Scenario 1
 
 // return the current power limitation factor
@@ -20,6 +29,10 @@ func (e *UCMGCP) PowerLimitationFactor(entity spineapi.EntityRemoteInterface) (f
 		return 0, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 1); err != nil {
+		return 0, err
+	}
+
 	measurement, err := util.Measurement(e.service, entity)
 	if err != nil || measurement == nil {
 		return 0, err
@@ -62,11 +75,29 @@ func (e *UCMGCP) PowerLimitationFactor(entity spineapi.EntityRemoteInterface) (f
 //   - positive values are used for consumption
 //   - negative values are used for production
 func (e *UCMGCP) Power(entity spineapi.EntityRemoteInterface) (float64, error) {
+	result, err := e.PowerDetails(entity)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Value, nil
+}
+
+// return the momentary power consumption or production at the grid connection point
+// including the timestamp, state and source of the value
+//
+//   - positive values are used for consumption
+//   - negative values are used for production
+func (e *UCMGCP) PowerDetails(entity spineapi.EntityRemoteInterface) (api.MeasurementResult, error) {
 	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
-		return 0, api.ErrNoCompatibleEntity
+		return api.MeasurementResult{}, api.ErrNoCompatibleEntity
+	}
+
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 2); err != nil {
+		return api.MeasurementResult{}, err
 	}
 
-	values, err := util.MeasurementValuesForTypeCommodityScope(
+	data, err := util.MeasurementDataForTypeCommodityScope(
 		e.service,
 		entity,
 		model.MeasurementTypeTypePower,
@@ -76,13 +107,13 @@ func (e *UCMGCP) Power(entity spineapi.EntityRemoteInterface) (float64, error) {
 		nil,
 	)
 	if err != nil {
-		return 0, err
+		return api.MeasurementResult{}, err
 	}
-	if len(values) != 1 {
-		return 0, eebusapi.ErrDataNotAvailable
+	if len(data) != 1 {
+		return api.MeasurementResult{}, eebusapi.ErrDataNotAvailable
 	}
 
-	return values[0], nil
+	return util.MeasurementResultForData(data[0], time.Duration(e.staleThreshold.Load())), nil
 }
 
 // Scenario 3
@@ -95,6 +126,10 @@ func (e *UCMGCP) EnergyFeedIn(entity spineapi.EntityRemoteInterface) (float64, e
 		return 0, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 3); err != nil {
+		return 0, err
+	}
+
 	measurement := model.MeasurementTypeTypeEnergy
 	commodity := model.CommodityTypeTypeElectricity
 	scope := model.ScopeTypeTypeGridFeedIn
@@ -125,6 +160,10 @@ func (e *UCMGCP) EnergyConsumed(entity spineapi.EntityRemoteInterface) (float64,
 		return 0, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 4); err != nil {
+		return 0, err
+	}
+
 	measurement := model.MeasurementTypeTypeEnergy
 	commodity := model.CommodityTypeTypeElectricity
 	scope := model.ScopeTypeTypeGridConsumption
@@ -152,18 +191,36 @@ func (e *UCMGCP) EnergyConsumed(entity spineapi.EntityRemoteInterface) (float64,
 //   - positive values are used for consumption
 //   - negative values are used for production
 func (e *UCMGCP) CurrentPerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error) {
+	data, err := e.CurrentPerPhaseDetails(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.MeasurementResultValues(data), nil
+}
+
+// return the momentary current consumption or production at the grid connection point
+// including the phase, timestamp, state and source of each value
+//
+//   - positive values are used for consumption
+//   - negative values are used for production
+func (e *UCMGCP) CurrentPerPhaseDetails(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error) {
 	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
 		return nil, api.ErrNoCompatibleEntity
 	}
 
-	return util.MeasurementValuesForTypeCommodityScope(
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 5); err != nil {
+		return nil, err
+	}
+
+	return util.MeasurementPhaseResultsForTypeCommodityScope(
 		e.service,
 		entity,
 		model.MeasurementTypeTypeCurrent,
 		model.CommodityTypeTypeElectricity,
 		model.ScopeTypeTypeACCurrent,
 		model.EnergyDirectionTypeConsume,
-		util.PhaseNameMapping,
+		time.Duration(e.staleThreshold.Load()),
 	)
 }
 
@@ -171,18 +228,33 @@ func (e *UCMGCP) CurrentPerPhase(entity spineapi.EntityRemoteInterface) ([]float
 
 // return the voltage phase details at the grid connection point
 func (e *UCMGCP) VoltagePerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error) {
+	data, err := e.VoltagePerPhaseDetails(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.MeasurementResultValues(data), nil
+}
+
+// return the voltage per phase at the grid connection point
+// including the phase, timestamp, state and source of each value
+func (e *UCMGCP) VoltagePerPhaseDetails(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error) {
 	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
 		return nil, api.ErrNoCompatibleEntity
 	}
 
-	return util.MeasurementValuesForTypeCommodityScope(
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 6); err != nil {
+		return nil, err
+	}
+
+	return util.MeasurementPhaseResultsForTypeCommodityScope(
 		e.service,
 		entity,
 		model.MeasurementTypeTypeVoltage,
 		model.CommodityTypeTypeElectricity,
 		model.ScopeTypeTypeACVoltage,
 		"",
-		util.PhaseNameMapping,
+		time.Duration(e.staleThreshold.Load()),
 	)
 }
 
@@ -194,6 +266,10 @@ func (e *UCMGCP) Frequency(entity spineapi.EntityRemoteInterface) (float64, erro
 		return 0, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 7); err != nil {
+		return 0, err
+	}
+
 	measurement := model.MeasurementTypeTypeFrequency
 	commodity := model.CommodityTypeTypeElectricity
 	scope := model.ScopeTypeTypeACFrequency
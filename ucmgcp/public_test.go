@@ -1,6 +1,10 @@
 package ucmgcp
 
 import (
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
 	eebusutil "github.com/enbility/eebus-go/util"
 	"github.com/enbility/spine-go/model"
 	"github.com/stretchr/testify/assert"
@@ -129,6 +133,97 @@ func (s *UCMGCPSuite) Test_Power() {
 	assert.Equal(s.T(), 10.0, data)
 }
 
+func (s *UCMGCPSuite) Test_PowerDetails() {
+	timestamp := time.Now().Add(-2 * time.Minute).UTC()
+
+	data, err := s.sut.PowerDetails(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	data, err = s.sut.PowerDetails(s.smgwEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	descData := &model.MeasurementDescriptionListDataType{
+		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(0)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypePower),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACPowerTotal),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.smgwEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	fErr := rFeature.UpdateData(model.FunctionTypeMeasurementDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.PowerDetails(s.smgwEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	measData := &model.MeasurementListDataType{
+		MeasurementData: []model.MeasurementDataType{
+			{
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+				Value:         model.NewScaledNumberType(10),
+				Timestamp:     model.NewAbsoluteOrRelativeTimeTypeFromTime(timestamp),
+				ValueState:    eebusutil.Ptr(model.MeasurementValueStateTypeOutofrange),
+			},
+		},
+	}
+
+	fErr = rFeature.UpdateData(model.FunctionTypeMeasurementListData, measData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.PowerDetails(s.smgwEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	elDescData := &model.ElectricalConnectionDescriptionListDataType{
+		ElectricalConnectionDescriptionData: []model.ElectricalConnectionDescriptionDataType{
+			{
+				ElectricalConnectionId:  eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				PositiveEnergyDirection: eebusutil.Ptr(model.EnergyDirectionTypeConsume),
+			},
+		},
+	}
+
+	rElFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.smgwEntity, model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionDescriptionListData, elDescData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.PowerDetails(s.smgwEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	elParamData := &model.ElectricalConnectionParameterDescriptionListDataType{
+		ElectricalConnectionParameterDescriptionData: []model.ElectricalConnectionParameterDescriptionDataType{
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				MeasurementId:          eebusutil.Ptr(model.MeasurementIdType(0)),
+			},
+		},
+	}
+
+	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, elParamData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.PowerDetails(s.smgwEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 10.0, data.Value)
+	assert.Equal(s.T(), timestamp.Unix(), data.Timestamp.Unix())
+	assert.Equal(s.T(), model.MeasurementValueStateTypeOutofrange, data.ValueState)
+	assert.True(s.T(), data.IsStale)
+
+	s.sut.SetStaleThreshold(0)
+
+	data, err = s.sut.PowerDetails(s.smgwEntity)
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), data.IsStale)
+}
+
 func (s *UCMGCPSuite) Test_EnergyFeedIn() {
 	data, err := s.sut.EnergyFeedIn(s.mockRemoteEntity)
 	assert.NotNil(s.T(), err)
@@ -323,6 +418,14 @@ func (s *UCMGCPSuite) Test_CurrentPerPhase() {
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), []float64{10, 10, 10}, data)
 
+	details, err := s.sut.CurrentPerPhaseDetails(s.smgwEntity)
+	assert.Nil(s.T(), err)
+	if assert.Equal(s.T(), 3, len(details)) {
+		for index, phase := range util.PhaseNameMapping {
+			assert.Equal(s.T(), phase, details[index].Phase)
+			assert.Equal(s.T(), 10.0, details[index].Value)
+		}
+	}
 }
 
 func (s *UCMGCPSuite) Test_VoltagePerPhase() {
@@ -462,3 +565,33 @@ func (s *UCMGCPSuite) Test_Frequency() {
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), 50.0, data)
 }
+
+func (s *UCMGCPSuite) Test_Frequency_ScenarioNotSupported() {
+	descData := &model.MeasurementDescriptionListDataType{
+		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(0)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypeFrequency),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACFrequency),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.smgwEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	fErr := rFeature.UpdateData(model.FunctionTypeMeasurementDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	s.setUseCaseScenarios([]model.UseCaseScenarioSupportType{2, 3, 4})
+
+	data, err := s.sut.Frequency(s.smgwEntity)
+	assert.ErrorIs(s.T(), err, api.ErrScenarioNotSupported)
+	assert.Equal(s.T(), 0.0, data)
+
+	s.setUseCaseScenarios([]model.UseCaseScenarioSupportType{2, 3, 4, 7})
+
+	data, err = s.sut.Frequency(s.smgwEntity)
+	assert.NotNil(s.T(), err)
+	assert.NotErrorIs(s.T(), err, api.ErrScenarioNotSupported)
+	assert.Equal(s.T(), 0.0, data)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/enbility/spine-go/mocks"
 	"github.com/enbility/spine-go/model"
 	"github.com/enbility/spine-go/spine"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
@@ -73,6 +74,29 @@ func (s *UCMGCPSuite) BeforeTest(suiteName, testName string) {
 
 const remoteSki string = "testremoteski"
 
+// announce the usecase on the remote device with the given scenarios
+func (s *UCMGCPSuite) setUseCaseScenarios(scenarios []model.UseCaseScenarioSupportType) {
+	ucData := &model.NodeManagementUseCaseDataType{
+		UseCaseInformation: []model.UseCaseInformationDataType{
+			{
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeGridConnectionPoint),
+				UseCaseSupport: []model.UseCaseSupportType{
+					{
+						UseCaseName:      eebusutil.Ptr(model.UseCaseNameTypeMonitoringOfGridConnectionPoint),
+						UseCaseAvailable: eebusutil.Ptr(true),
+						ScenarioSupport:  scenarios,
+					},
+				},
+			},
+		},
+	}
+
+	nodemgmtEntity := s.remoteDevice.Entity([]model.AddressEntityType{0})
+	nodeFeature := s.remoteDevice.FeatureByEntityTypeAndRole(nodemgmtEntity, model.FeatureTypeTypeNodeManagement, model.RoleTypeSpecial)
+	fErr := nodeFeature.UpdateData(model.FunctionTypeNodeManagementUseCaseData, ucData, nil, nil)
+	assert.Nil(s.T(), fErr)
+}
+
 func setupDevices(
 	eebusService eebusapi.ServiceInterface, t *testing.T) (
 	spineapi.DeviceRemoteInterface,
@@ -3,6 +3,22 @@ package ucmgcp
 import "github.com/enbility/cemd/api"
 
 const (
+	// A remote entity compatible with the use case was added
+	//
+	// The callback with this message provides:
+	//   - the device of the entity
+	//   - the entity, its address is available with Address()
+	//
+	// The event is sent before any other event of the entity
+	EntityAdded api.EventType = "ucmgcp.EntityAdded"
+
+	// A remote entity compatible with the use case was removed, or its device was disconnected
+	//
+	// The callback with this message provides:
+	//   - the device of the entity
+	//   - the entity, its address is available with Address()
+	EntityRemoved api.EventType = "ucmgcp.EntityRemoved"
+
 	// Grid maximum allowed feed-in power as percentage value of the cumulated
 	// nominal peak power of all electricity producting PV systems was updated
 	//
@@ -1,12 +1,13 @@
 package ucmgcp
 
 import (
+	"sync/atomic"
+
 	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	eebusapi "github.com/enbility/eebus-go/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
-	"github.com/enbility/spine-go/spine"
 )
 
 type UCMGCP struct {
@@ -15,6 +16,15 @@ type UCMGCP struct {
 	eventCB api.EventHandlerCB
 
 	validEntityTypes []model.EntityTypeType
+
+	// the client features for the remote server features the usecase uses
+	clientFeatures []model.FeatureTypeType
+
+	// the known compatible remote entities
+	entities util.KnownEntities
+
+	// the age after which measurement values are reported as stale, as a time.Duration
+	staleThreshold atomic.Int64
 }
 
 var _ UCMGCPInterface = (*UCMGCP)(nil)
@@ -24,12 +34,18 @@ func NewUCMGCP(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) *U
 		service: service,
 		eventCB: eventCB,
 	}
+	uc.staleThreshold.Store(int64(api.DefaultStaleThreshold))
 
 	uc.validEntityTypes = []model.EntityTypeType{
 		model.EntityTypeTypeCEM,
 		model.EntityTypeTypeGridConnectionPointOfPremises,
 	}
-	_ = spine.Events.Subscribe(uc)
+
+	uc.clientFeatures = []model.FeatureTypeType{
+		model.FeatureTypeTypeDeviceConfiguration,
+		model.FeatureTypeTypeElectricalConnection,
+		model.FeatureTypeTypeMeasurement,
+	}
 
 	return uc
 }
@@ -42,12 +58,7 @@ func (e *UCMGCP) AddFeatures() {
 	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
 
 	// client features
-	var clientFeatures = []model.FeatureTypeType{
-		model.FeatureTypeTypeDeviceConfiguration,
-		model.FeatureTypeTypeElectricalConnection,
-		model.FeatureTypeTypeMeasurement,
-	}
-	for _, feature := range clientFeatures {
+	for _, feature := range e.clientFeatures {
 		f := localEntity.GetOrAddFeature(feature, model.RoleTypeClient)
 		f.AddResultHandler(e)
 	}
@@ -65,6 +76,36 @@ func (e *UCMGCP) AddUseCase() {
 		[]model.UseCaseScenarioSupportType{1, 2, 3, 4, 5, 6, 7})
 }
 
+func (e *UCMGCP) RemoveUseCase() {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.RemoveUseCaseSupport(model.UseCaseActorTypeMonitoringAppliance, e.UseCaseName())
+}
+
+func (e *UCMGCP) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeMonitoringAppliance, e.UseCaseName(), available)
+}
+
+// returns the client features for the remote server features the usecase uses
+func (e *UCMGCP) ClientFeatures() []model.FeatureTypeType {
+	return e.clientFeatures
+}
+
+// returns what a remote entity has to provide to support the usecase
+func (e *UCMGCP) RemoteRequirements() api.UseCaseRequirements {
+	return api.UseCaseRequirements{
+		EntityTypes: e.validEntityTypes,
+		Actors:      []model.UseCaseActorType{model.UseCaseActorTypeGridConnectionPoint},
+		Scenarios:   []model.UseCaseScenarioSupportType{2, 3, 4},
+		ServerFeatures: []model.FeatureTypeType{
+			model.FeatureTypeTypeElectricalConnection,
+			model.FeatureTypeTypeMeasurement,
+		},
+	}
+}
+
 // returns if the entity supports the usecase
 //
 // possible errors:
@@ -77,15 +118,7 @@ func (e *UCMGCP) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool
 
 	// check if the usecase and mandatory scenarios are supported and
 	// if the required server features are available
-	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
-		model.UseCaseActorTypeGridConnectionPoint,
-		e.UseCaseName(),
-		[]model.UseCaseScenarioSupportType{2, 3, 4},
-		[]model.FeatureTypeType{
-			model.FeatureTypeTypeElectricalConnection,
-			model.FeatureTypeTypeMeasurement,
-		},
-	) {
+	if !util.VerifyUseCaseRequirements(entity, e.UseCaseName(), e.RemoteRequirements()) {
 		return false, nil
 	}
 
@@ -95,7 +128,7 @@ func (e *UCMGCP) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool
 		return false, eebusapi.ErrFunctionNotSupported
 	}
 
-	_, err1 := measurement.GetDescriptionsForScope(model.ScopeTypeTypeACPower)
+	_, err1 := measurement.GetDescriptionsForScope(model.ScopeTypeTypeACPowerTotal)
 	_, err2 := measurement.GetDescriptionsForScope(model.ScopeTypeTypeGridFeedIn)
 	_, err3 := measurement.GetDescriptionsForScope(model.ScopeTypeTypeGridConsumption)
 	if err1 != nil || err2 != nil || err3 != nil {
@@ -114,3 +147,62 @@ func (e *UCMGCP) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool
 
 	return true, nil
 }
+
+// returns the scenarios the remote entity announces and for which data is available
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCMGCP) SupportedScenarios(entity spineapi.EntityRemoteInterface) ([]model.UseCaseScenarioSupportType, error) {
+	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	checks := map[model.UseCaseScenarioSupportType]func() error{
+		1: func() error {
+			_, err := e.PowerLimitationFactor(entity)
+			return err
+		},
+		2: func() error {
+			_, err := e.Power(entity)
+			return err
+		},
+		3: func() error {
+			_, err := e.EnergyFeedIn(entity)
+			return err
+		},
+		4: func() error {
+			_, err := e.EnergyConsumed(entity)
+			return err
+		},
+		5: func() error {
+			_, err := e.CurrentPerPhase(entity)
+			return err
+		},
+		6: func() error {
+			_, err := e.VoltagePerPhase(entity)
+			return err
+		},
+		7: func() error {
+			_, err := e.Frequency(entity)
+			return err
+		},
+	}
+
+	return util.SupportedScenarios(entity, e.UseCaseName(), e.RemoteRequirements(), checks), nil
+}
+
+// request the descriptions, constraints and values of all features the usecase uses from a remote entity
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCMGCP) Refresh(entity spineapi.EntityRemoteInterface) ([]api.RefreshResult, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	return util.RefreshFeatures(e.service, entity, e.clientFeatures), nil
+}
+
+func (e *UCMGCP) CompatibleEntities() []spineapi.EntityRemoteInterface {
+	return e.entities.Entities()
+}
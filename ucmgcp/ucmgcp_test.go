@@ -43,7 +43,7 @@ func (s *UCMGCPSuite) Test_IsUseCaseSupported() {
 		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
 			{
 				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
-				ScopeType:     eebusutil.Ptr(model.ScopeTypeTypeACPower),
+				ScopeType:     eebusutil.Ptr(model.ScopeTypeTypeACPowerTotal),
 			},
 			{
 				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(1)),
@@ -80,3 +80,131 @@ func (s *UCMGCPSuite) Test_IsUseCaseSupported() {
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), true, data)
 }
+
+func (s *UCMGCPSuite) Test_SupportedScenarios() {
+	data, err := s.sut.SupportedScenarios(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	data, err = s.sut.SupportedScenarios(s.smgwEntity)
+	assert.Nil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	s.setUseCaseScenarios([]model.UseCaseScenarioSupportType{7, 2, 3, 4})
+
+	// no data is available yet
+	data, err = s.sut.SupportedScenarios(s.smgwEntity)
+	assert.Nil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	descData := &model.MeasurementDescriptionListDataType{
+		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(0)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypeFrequency),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACFrequency),
+			},
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(1)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypeVoltage),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACVoltage),
+			},
+		},
+	}
+
+	measData := &model.MeasurementListDataType{
+		MeasurementData: []model.MeasurementDataType{
+			{
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+				Value:         model.NewScaledNumberType(50),
+			},
+			{
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(1)),
+				Value:         model.NewScaledNumberType(230),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.smgwEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	fErr := rFeature.UpdateData(model.FunctionTypeMeasurementDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+	fErr = rFeature.UpdateData(model.FunctionTypeMeasurementListData, measData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	// the voltage is available, but scenario 6 is not announced
+	data, err = s.sut.SupportedScenarios(s.smgwEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []model.UseCaseScenarioSupportType{7}, data)
+}
+
+func (s *UCMGCPSuite) Test_Refresh() {
+	result, err := s.sut.Refresh(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), result)
+
+	result, err = s.sut.Refresh(s.smgwEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(result))
+
+	for _, item := range result {
+		assert.Nil(s.T(), item.Error)
+		assert.NotEqual(s.T(), 0, len(item.Functions))
+	}
+
+	assert.Equal(s.T(), model.FeatureTypeTypeMeasurement, result[2].Feature)
+	assert.Equal(s.T(), 3, len(result[2].Functions))
+}
+
+func (s *UCMGCPSuite) Test_IsUseCaseSupportedPowerScope() {
+	s.setUseCaseScenarios([]model.UseCaseScenarioSupportType{2, 3, 4})
+
+	elData := &model.ElectricalConnectionDescriptionListDataType{
+		ElectricalConnectionDescriptionData: []model.ElectricalConnectionDescriptionDataType{
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+			},
+		},
+	}
+
+	elFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.smgwEntity, model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	fErr := elFeature.UpdateData(model.FunctionTypeElectricalConnectionDescriptionListData, elData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	descData := func(powerScope model.ScopeTypeType) *model.MeasurementDescriptionListDataType {
+		return &model.MeasurementDescriptionListDataType{
+			MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+				{
+					MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+					ScopeType:     eebusutil.Ptr(powerScope),
+				},
+				{
+					MeasurementId: eebusutil.Ptr(model.MeasurementIdType(1)),
+					ScopeType:     eebusutil.Ptr(model.ScopeTypeTypeGridFeedIn),
+				},
+				{
+					MeasurementId: eebusutil.Ptr(model.MeasurementIdType(2)),
+					ScopeType:     eebusutil.Ptr(model.ScopeTypeTypeGridConsumption),
+				},
+			},
+		}
+	}
+
+	measurementFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.smgwEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+
+	// scenario 2 is the momentary power consumption/production of the total grid connection point
+	fErr = measurementFeature.UpdateData(model.FunctionTypeMeasurementDescriptionListData, descData(model.ScopeTypeTypeACPower), nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err := s.sut.IsUseCaseSupported(s.smgwEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), false, data)
+
+	fErr = measurementFeature.UpdateData(model.FunctionTypeMeasurementDescriptionListData, descData(model.ScopeTypeTypeACPowerTotal), nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.IsUseCaseSupported(s.smgwEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, data)
+}
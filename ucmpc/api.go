@@ -1,6 +1,9 @@
 package ucmpc
 
 import (
+	"context"
+	"time"
+
 	"github.com/enbility/cemd/api"
 	spineapi "github.com/enbility/spine-go/api"
 )
@@ -11,6 +14,12 @@ import (
 type UCMCPInterface interface {
 	api.UseCaseInterface
 
+	// set the age after which measurement values are reported as stale
+	//
+	// parameters:
+	//   - threshold: the maximum age, 0 disables the staleness detection
+	SetStaleThreshold(threshold time.Duration)
+
 	// Scenario 1
 
 	// return the momentary active power consumption or production
@@ -23,16 +32,35 @@ type UCMCPInterface interface {
 	//   - and others
 	Power(entity spineapi.EntityRemoteInterface) (float64, error)
 
+	// return the momentary active power consumption or production including the timestamp, state and source of the value
+	//
+	// parameters:
+	//   - entity: the entity of the device (e.g. EVSE)
+	PowerDetails(entity spineapi.EntityRemoteInterface) (api.MeasurementResult, error)
+
 	// return the momentary active phase specific power consumption or production per phase
 	//
 	// parameters:
 	//   - entity: the entity of the device (e.g. EVSE)
 	//
 	// possible errors:
+	//   - ErrScenarioNotSupported if the remote device does not announce the scenario
 	//   - ErrDataNotAvailable if no such limit is (yet) available
 	//   - and others
 	PowerPerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error)
 
+	// return the momentary active phase specific power consumption or production per phase
+	// including the phase, timestamp, state and source of each value
+	//
+	// parameters:
+	//   - entity: the entity of the device (e.g. EVSE)
+	//
+	// possible errors:
+	//   - ErrScenarioNotSupported if the remote device does not announce the scenario
+	//   - ErrDataNotAvailable if no such limit is (yet) available
+	//   - and others
+	PowerPerPhaseDetails(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error)
+
 	// Scenario 2
 
 	// return the total consumption energy
@@ -64,6 +92,17 @@ type UCMCPInterface interface {
 	//   - negative values are used for production
 	CurrentPerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error)
 
+	// return the momentary phase specific current consumption or production
+	// including the phase, timestamp, state and source of each value
+	//
+	// parameters:
+	//   - entity: the entity of the device (e.g. EVSE)
+	//
+	// return values
+	//   - positive values are used for consumption
+	//   - negative values are used for production
+	CurrentPerPhaseDetails(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error)
+
 	// Scenario 4
 
 	// return the phase specific voltage details
@@ -72,6 +111,13 @@ type UCMCPInterface interface {
 	//   - entity: the entity of the device (e.g. EVSE)
 	VoltagePerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error)
 
+	// return the phase specific voltage
+	// including the phase, timestamp, state and source of each value
+	//
+	// parameters:
+	//   - entity: the entity of the device (e.g. EVSE)
+	VoltagePerPhaseDetails(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error)
+
 	// Scenario 5
 
 	// return frequency
@@ -79,4 +125,28 @@ type UCMCPInterface interface {
 	// parameters:
 	//   - entity: the entity of the device (e.g. EVSE)
 	Frequency(entity spineapi.EntityRemoteInterface) (float64, error)
+
+	// context aware variants
+
+	// the getters above with a context
+	//
+	// parameters:
+	//   - ctx: the context, its deadline limits the wait for a fresh value
+	//   - entity: the entity of the device
+	//   - options: if Fresh is set, the data is requested from the remote entity and the reply is awaited before the value is returned
+	//
+	// possible errors:
+	//   - the context error if the context is done before the reply is received
+	//   - and those of the getters above
+	PowerContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (float64, error)
+	PowerDetailsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (api.MeasurementResult, error)
+	PowerPerPhaseContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]float64, error)
+	PowerPerPhaseDetailsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]api.MeasurementResult, error)
+	EnergyConsumedContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (float64, error)
+	EnergyProducedContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (float64, error)
+	CurrentPerPhaseContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]float64, error)
+	CurrentPerPhaseDetailsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]api.MeasurementResult, error)
+	VoltagePerPhaseContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]float64, error)
+	VoltagePerPhaseDetailsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]api.MeasurementResult, error)
+	FrequencyContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (float64, error)
 }
@@ -0,0 +1,53 @@
+package ucmpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cemtest"
+	"github.com/enbility/cemd/conformance"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestConformanceSuite(t *testing.T) {
+	suite.Run(t, new(ConformanceSuite))
+}
+
+type ConformanceSuite struct {
+	suite.Suite
+}
+
+const specification = "EEBus_UC_TS_MonitoringOfPowerConsumption"
+
+var requirements = []conformance.Requirement{
+	conformance.UseCaseAnnounced("MPC-G-01", specification, model.UseCaseActorTypeMonitoringAppliance, 1, 2, 3, 4, 5),
+	conformance.RemoteUseCase("MPC-G-02", specification, model.UseCaseActorTypeMonitoredUnit, 1),
+	conformance.EntityType("MPC-G-03", specification, model.EntityTypeTypeHeatPumpAppliance),
+	conformance.ClientFeature("MPC-G-04", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.ClientFeature("MPC-G-05", specification, model.FeatureTypeTypeMeasurement),
+	conformance.ServerFeature("MPC-G-06", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.ServerFeature("MPC-G-07", specification, model.FeatureTypeTypeMeasurement),
+	conformance.Subscription("MPC-G-08", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.Subscription("MPC-G-09", specification, model.FeatureTypeTypeMeasurement),
+	conformance.Read("MPC-G-10", specification, model.FeatureTypeTypeElectricalConnection, model.FunctionTypeElectricalConnectionDescriptionListData),
+	conformance.Read("MPC-G-11", specification, model.FeatureTypeTypeElectricalConnection, model.FunctionTypeElectricalConnectionParameterDescriptionListData),
+	conformance.Read("MPC-G-12", specification, model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData),
+	conformance.Read("MPC-G-13", specification, model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData),
+	conformance.ResponseTime("MPC-G-14", conformance.ImplementationReference,
+		conformance.Notification(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData),
+		conformance.ReadResponse(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData), time.Second),
+}
+
+func (s *ConformanceSuite) Test_Requirements() {
+	conformance.Verify(s.T(), conformance.Setup{
+		UseCase: func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) api.UseCaseInterface {
+			return NewUCMPC(service, eventCB)
+		},
+		Remote: func(device *cemtest.DeviceBuilder) *cemtest.EntityBuilder {
+			return cemtest.HeatPump(device)
+		},
+	}, requirements)
+}
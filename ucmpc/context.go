@@ -0,0 +1,141 @@
+package ucmpc
+
+import (
+	"context"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// context aware variant of Power
+func (e *UCMPC) PowerContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.Power(entity)
+	})
+}
+
+// context aware variant of PowerDetails
+func (e *UCMPC) PowerDetailsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (api.MeasurementResult, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (api.MeasurementResult, error) {
+		return e.PowerDetails(entity)
+	})
+}
+
+// context aware variant of PowerPerPhase
+func (e *UCMPC) PowerPerPhaseContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]float64, error) {
+		return e.PowerPerPhase(entity)
+	})
+}
+
+// context aware variant of PowerPerPhaseDetails
+func (e *UCMPC) PowerPerPhaseDetailsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]api.MeasurementResult, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]api.MeasurementResult, error) {
+		return e.PowerPerPhaseDetails(entity)
+	})
+}
+
+// context aware variant of EnergyConsumed
+func (e *UCMPC) EnergyConsumedContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.EnergyConsumed(entity)
+	})
+}
+
+// context aware variant of EnergyProduced
+func (e *UCMPC) EnergyProducedContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.EnergyProduced(entity)
+	})
+}
+
+// context aware variant of CurrentPerPhase
+func (e *UCMPC) CurrentPerPhaseContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]float64, error) {
+		return e.CurrentPerPhase(entity)
+	})
+}
+
+// context aware variant of CurrentPerPhaseDetails
+func (e *UCMPC) CurrentPerPhaseDetailsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]api.MeasurementResult, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]api.MeasurementResult, error) {
+		return e.CurrentPerPhaseDetails(entity)
+	})
+}
+
+// context aware variant of VoltagePerPhase
+func (e *UCMPC) VoltagePerPhaseContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]float64, error) {
+		return e.VoltagePerPhase(entity)
+	})
+}
+
+// context aware variant of VoltagePerPhaseDetails
+func (e *UCMPC) VoltagePerPhaseDetailsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]api.MeasurementResult, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]api.MeasurementResult, error) {
+		return e.VoltagePerPhaseDetails(entity)
+	})
+}
+
+// context aware variant of Frequency
+func (e *UCMPC) FrequencyContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.Frequency(entity)
+	})
+}
@@ -1,6 +1,8 @@
 package ucmpc
 
 import (
+	"time"
+
 	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	eebusapi "github.com/enbility/eebus-go/api"
@@ -8,6 +10,13 @@ import (
 	"github.com/enbility/spine-go/model"
 )
 
+// set the age after which measurement values are reported as stale
+//
+// the default is api.DefaultStaleThreshold, 0 disables the staleness detection
+func (e *UCMPC) SetStaleThreshold(threshold time.Duration) {
+	e.staleThreshold.Store(int64(threshold))
+}
+
 // Scenario 1
 
 // return the momentary active power consumption or production
@@ -16,11 +25,30 @@ import (
 //   - ErrDataNotAvailable if no such limit is (yet) available
 //   - and others
 func (e *UCMPC) Power(entity spineapi.EntityRemoteInterface) (float64, error) {
+	result, err := e.PowerDetails(entity)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Value, nil
+}
+
+// return the momentary active power consumption or production
+// including the timestamp, state and source of the value
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such limit is (yet) available
+//   - and others
+func (e *UCMPC) PowerDetails(entity spineapi.EntityRemoteInterface) (api.MeasurementResult, error) {
 	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
-		return 0, api.ErrNoCompatibleEntity
+		return api.MeasurementResult{}, api.ErrNoCompatibleEntity
+	}
+
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 1); err != nil {
+		return api.MeasurementResult{}, err
 	}
 
-	values, err := util.MeasurementValuesForTypeCommodityScope(
+	data, err := util.MeasurementDataForTypeCommodityScope(
 		e.service,
 		entity,
 		model.MeasurementTypeTypePower,
@@ -30,12 +58,12 @@ func (e *UCMPC) Power(entity spineapi.EntityRemoteInterface) (float64, error) {
 		nil,
 	)
 	if err != nil {
-		return 0, err
+		return api.MeasurementResult{}, err
 	}
-	if len(values) != 1 {
-		return 0, eebusapi.ErrDataNotAvailable
+	if len(data) != 1 {
+		return api.MeasurementResult{}, eebusapi.ErrDataNotAvailable
 	}
-	return values[0], nil
+	return util.MeasurementResultForData(data[0], time.Duration(e.staleThreshold.Load())), nil
 }
 
 // return the momentary active phase specific power consumption or production per phase
@@ -44,18 +72,37 @@ func (e *UCMPC) Power(entity spineapi.EntityRemoteInterface) (float64, error) {
 //   - ErrDataNotAvailable if no such limit is (yet) available
 //   - and others
 func (e *UCMPC) PowerPerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error) {
+	data, err := e.PowerPerPhaseDetails(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.MeasurementResultValues(data), nil
+}
+
+// return the momentary active phase specific power consumption or production per phase
+// including the phase, timestamp, state and source of each value
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such limit is (yet) available
+//   - and others
+func (e *UCMPC) PowerPerPhaseDetails(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error) {
 	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
 		return nil, api.ErrNoCompatibleEntity
 	}
 
-	return util.MeasurementValuesForTypeCommodityScope(
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 1); err != nil {
+		return nil, err
+	}
+
+	return util.MeasurementPhaseResultsForTypeCommodityScope(
 		e.service,
 		entity,
 		model.MeasurementTypeTypePower,
 		model.CommodityTypeTypeElectricity,
 		model.ScopeTypeTypeACPower,
 		model.EnergyDirectionTypeConsume,
-		util.PhaseNameMapping,
+		time.Duration(e.staleThreshold.Load()),
 	)
 }
 
@@ -69,6 +116,10 @@ func (e *UCMPC) EnergyConsumed(entity spineapi.EntityRemoteInterface) (float64,
 		return 0, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 2); err != nil {
+		return 0, err
+	}
+
 	measurement := model.MeasurementTypeTypeEnergy
 	commodity := model.CommodityTypeTypeElectricity
 	scope := model.ScopeTypeTypeACEnergyConsumed
@@ -97,6 +148,10 @@ func (e *UCMPC) EnergyProduced(entity spineapi.EntityRemoteInterface) (float64,
 		return 0, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 2); err != nil {
+		return 0, err
+	}
+
 	measurement := model.MeasurementTypeTypeEnergy
 	commodity := model.CommodityTypeTypeElectricity
 	scope := model.ScopeTypeTypeACEnergyProduced
@@ -124,18 +179,36 @@ func (e *UCMPC) EnergyProduced(entity spineapi.EntityRemoteInterface) (float64,
 //   - positive values are used for consumption
 //   - negative values are used for production
 func (e *UCMPC) CurrentPerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error) {
+	data, err := e.CurrentPerPhaseDetails(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.MeasurementResultValues(data), nil
+}
+
+// return the momentary phase specific current consumption or production
+// including the phase, timestamp, state and source of each value
+//
+//   - positive values are used for consumption
+//   - negative values are used for production
+func (e *UCMPC) CurrentPerPhaseDetails(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error) {
 	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
 		return nil, api.ErrNoCompatibleEntity
 	}
 
-	return util.MeasurementValuesForTypeCommodityScope(
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 3); err != nil {
+		return nil, err
+	}
+
+	return util.MeasurementPhaseResultsForTypeCommodityScope(
 		e.service,
 		entity,
 		model.MeasurementTypeTypeCurrent,
 		model.CommodityTypeTypeElectricity,
 		model.ScopeTypeTypeACCurrent,
 		model.EnergyDirectionTypeConsume,
-		util.PhaseNameMapping,
+		time.Duration(e.staleThreshold.Load()),
 	)
 }
 
@@ -143,18 +216,33 @@ func (e *UCMPC) CurrentPerPhase(entity spineapi.EntityRemoteInterface) ([]float6
 
 // return the phase specific voltage details
 func (e *UCMPC) VoltagePerPhase(entity spineapi.EntityRemoteInterface) ([]float64, error) {
+	data, err := e.VoltagePerPhaseDetails(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.MeasurementResultValues(data), nil
+}
+
+// return the phase specific voltage
+// including the phase, timestamp, state and source of each value
+func (e *UCMPC) VoltagePerPhaseDetails(entity spineapi.EntityRemoteInterface) ([]api.MeasurementResult, error) {
 	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
 		return nil, api.ErrNoCompatibleEntity
 	}
 
-	return util.MeasurementValuesForTypeCommodityScope(
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 4); err != nil {
+		return nil, err
+	}
+
+	return util.MeasurementPhaseResultsForTypeCommodityScope(
 		e.service,
 		entity,
 		model.MeasurementTypeTypeVoltage,
 		model.CommodityTypeTypeElectricity,
 		model.ScopeTypeTypeACVoltage,
 		"",
-		util.PhaseNameMapping,
+		time.Duration(e.staleThreshold.Load()),
 	)
 }
 
@@ -166,6 +254,10 @@ func (e *UCMPC) Frequency(entity spineapi.EntityRemoteInterface) (float64, error
 		return 0, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 5); err != nil {
+		return 0, err
+	}
+
 	measurement := model.MeasurementTypeTypeFrequency
 	commodity := model.CommodityTypeTypeElectricity
 	scope := model.ScopeTypeTypeACFrequency
@@ -1,12 +1,13 @@
 package ucmpc
 
 import (
+	"sync/atomic"
+
 	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	eebusapi "github.com/enbility/eebus-go/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
-	"github.com/enbility/spine-go/spine"
 )
 
 type UCMPC struct {
@@ -15,6 +16,15 @@ type UCMPC struct {
 	eventCB api.EventHandlerCB
 
 	validEntityTypes []model.EntityTypeType
+
+	// the client features for the remote server features the usecase uses
+	clientFeatures []model.FeatureTypeType
+
+	// the known compatible remote entities
+	entities util.KnownEntities
+
+	// the age after which measurement values are reported as stale, as a time.Duration
+	staleThreshold atomic.Int64
 }
 
 var _ UCMCPInterface = (*UCMPC)(nil)
@@ -24,6 +34,7 @@ func NewUCMPC(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) *UC
 		service: service,
 		eventCB: eventCB,
 	}
+	uc.staleThreshold.Store(int64(api.DefaultStaleThreshold))
 
 	uc.validEntityTypes = []model.EntityTypeType{
 		model.EntityTypeTypeCompressor,
@@ -35,7 +46,10 @@ func NewUCMPC(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) *UC
 		model.EntityTypeTypeSubMeterElectricity,
 	}
 
-	_ = spine.Events.Subscribe(uc)
+	uc.clientFeatures = []model.FeatureTypeType{
+		model.FeatureTypeTypeElectricalConnection,
+		model.FeatureTypeTypeMeasurement,
+	}
 
 	return uc
 }
@@ -48,11 +62,7 @@ func (e *UCMPC) AddFeatures() {
 	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
 
 	// client features
-	var clientFeatures = []model.FeatureTypeType{
-		model.FeatureTypeTypeElectricalConnection,
-		model.FeatureTypeTypeMeasurement,
-	}
-	for _, feature := range clientFeatures {
+	for _, feature := range e.clientFeatures {
 		f := localEntity.GetOrAddFeature(feature, model.RoleTypeClient)
 		f.AddResultHandler(e)
 	}
@@ -70,6 +80,36 @@ func (e *UCMPC) AddUseCase() {
 		[]model.UseCaseScenarioSupportType{1, 2, 3, 4, 5})
 }
 
+func (e *UCMPC) RemoveUseCase() {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.RemoveUseCaseSupport(model.UseCaseActorTypeMonitoringAppliance, e.UseCaseName())
+}
+
+func (e *UCMPC) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeMonitoringAppliance, e.UseCaseName(), available)
+}
+
+// returns the client features for the remote server features the usecase uses
+func (e *UCMPC) ClientFeatures() []model.FeatureTypeType {
+	return e.clientFeatures
+}
+
+// returns what a remote entity has to provide to support the usecase
+func (e *UCMPC) RemoteRequirements() api.UseCaseRequirements {
+	return api.UseCaseRequirements{
+		EntityTypes: e.validEntityTypes,
+		Actors:      []model.UseCaseActorType{model.UseCaseActorTypeMonitoredUnit},
+		Scenarios:   []model.UseCaseScenarioSupportType{1},
+		ServerFeatures: []model.FeatureTypeType{
+			model.FeatureTypeTypeElectricalConnection,
+			model.FeatureTypeTypeMeasurement,
+		},
+	}
+}
+
 // returns if the entity supports the usecase
 //
 // possible errors:
@@ -82,15 +122,7 @@ func (e *UCMPC) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool,
 
 	// check if the usecase and mandatory scenarios are supported and
 	// if the required server features are available
-	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
-		model.UseCaseActorTypeMonitoredUnit,
-		e.UseCaseName(),
-		[]model.UseCaseScenarioSupportType{1},
-		[]model.FeatureTypeType{
-			model.FeatureTypeTypeElectricalConnection,
-			model.FeatureTypeTypeMeasurement,
-		},
-	) {
+	if !util.VerifyUseCaseRequirements(entity, e.UseCaseName(), e.RemoteRequirements()) {
 		return false, nil
 	}
 
@@ -120,3 +152,58 @@ func (e *UCMPC) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool,
 
 	return true, nil
 }
+
+// returns the scenarios the remote entity announces and for which data is available
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCMPC) SupportedScenarios(entity spineapi.EntityRemoteInterface) ([]model.UseCaseScenarioSupportType, error) {
+	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	checks := map[model.UseCaseScenarioSupportType]func() error{
+		1: func() error {
+			_, err := e.Power(entity)
+			return err
+		},
+		2: func() error {
+			// either value is sufficient, depending on the monitored unit
+			if _, err := e.EnergyConsumed(entity); err == nil {
+				return nil
+			}
+			_, err := e.EnergyProduced(entity)
+			return err
+		},
+		3: func() error {
+			_, err := e.CurrentPerPhase(entity)
+			return err
+		},
+		4: func() error {
+			_, err := e.VoltagePerPhase(entity)
+			return err
+		},
+		5: func() error {
+			_, err := e.Frequency(entity)
+			return err
+		},
+	}
+
+	return util.SupportedScenarios(entity, e.UseCaseName(), e.RemoteRequirements(), checks), nil
+}
+
+// request the descriptions, constraints and values of all features the usecase uses from a remote entity
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCMPC) Refresh(entity spineapi.EntityRemoteInterface) ([]api.RefreshResult, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	return util.RefreshFeatures(e.service, entity, e.clientFeatures), nil
+}
+
+func (e *UCMPC) CompatibleEntities() []spineapi.EntityRemoteInterface {
+	return e.entities.Entities()
+}
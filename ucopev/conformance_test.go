@@ -0,0 +1,56 @@
+package ucopev
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cemtest"
+	"github.com/enbility/cemd/conformance"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestConformanceSuite(t *testing.T) {
+	suite.Run(t, new(ConformanceSuite))
+}
+
+type ConformanceSuite struct {
+	suite.Suite
+}
+
+const specification = "EEBus_UC_TS_OverloadProtectionByEvChargingCurrentCurtailment V1.01b"
+
+var requirements = []conformance.Requirement{
+	conformance.UseCaseAnnounced("OPEV-G-01", specification, model.UseCaseActorTypeCEM, 1, 2, 3),
+	conformance.RemoteUseCase("OPEV-G-02", specification, model.UseCaseActorTypeEV, 1, 2, 3),
+	conformance.EntityType("OPEV-G-03", specification, model.EntityTypeTypeEV),
+	conformance.ClientFeature("OPEV-S1-01", specification, model.FeatureTypeTypeLoadControl),
+	conformance.ServerFeature("OPEV-S1-02", specification, model.FeatureTypeTypeLoadControl),
+	conformance.Subscription("OPEV-S1-03", specification, model.FeatureTypeTypeLoadControl),
+	conformance.Binding("OPEV-S1-04", specification, model.FeatureTypeTypeLoadControl),
+	conformance.Read("OPEV-S1-05", specification, model.FeatureTypeTypeLoadControl, model.FunctionTypeLoadControlLimitDescriptionListData),
+	conformance.Read("OPEV-S1-06", specification, model.FeatureTypeTypeLoadControl, model.FunctionTypeLoadControlLimitListData),
+	conformance.Write("OPEV-S1-07", specification+" 3.2.1.2.2.2", model.FeatureTypeTypeLoadControl, model.FunctionTypeLoadControlLimitListData,
+		func(c *conformance.Context) error {
+			_, err := c.UseCase.(*UCOPEV).WriteLoadControlLimits(c.Entity, []api.LoadLimitsPhase{
+				{Phase: model.ElectricalConnectionPhaseNameTypeA, IsActive: true, Value: cemtest.EVMinCurrent},
+			})
+			return err
+		}),
+	conformance.ResponseTime("OPEV-S1-08", conformance.ImplementationReference,
+		conformance.Notification(model.FeatureTypeTypeLoadControl, model.FunctionTypeLoadControlLimitDescriptionListData),
+		conformance.ReadResponse(model.FeatureTypeTypeLoadControl, model.FunctionTypeLoadControlLimitListData), time.Second),
+}
+
+func (s *ConformanceSuite) Test_Requirements() {
+	conformance.Verify(s.T(), conformance.Setup{
+		UseCase: func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) api.UseCaseInterface {
+			return NewUCOPEV(service, eventCB)
+		},
+		Remote: func(device *cemtest.DeviceBuilder) *cemtest.EntityBuilder {
+			return cemtest.EVISO15118WithSoC(cemtest.EVSE3Phase(device), 50)
+		},
+	}, requirements)
+}
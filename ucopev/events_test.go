@@ -1,6 +1,8 @@
 package ucopev
 
 import (
+	"strings"
+
 	eebusutil "github.com/enbility/eebus-go/util"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
@@ -16,10 +18,19 @@ func (s *UCOPEVSuite) Test_Events() {
 	payload.Entity = s.evEntity
 	s.sut.HandleEvent(payload)
 
+	s.popSentMessages()
+
 	payload.EventType = spineapi.EventTypeEntityChange
 	payload.ChangeType = spineapi.ElementChangeAdd
 	s.sut.HandleEvent(payload)
 
+	// writing limits requires a binding to the load control feature
+	assert.Equal(s.T(), 1, countBindingRequests(s.popSentMessages()))
+
+	// an existing binding is not requested again
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), 0, countBindingRequests(s.popSentMessages()))
+
 	payload.EventType = spineapi.EventTypeDataChange
 	payload.ChangeType = spineapi.ElementChangeAdd
 	s.sut.HandleEvent(payload)
@@ -67,3 +78,15 @@ func (s *UCOPEVSuite) Test_evLoadControlLimitDataUpdate() {
 
 	s.sut.evLoadControlLimitDataUpdate(remoteSki, s.evEntity)
 }
+
+// returns the number of binding requests in the sent messages
+func countBindingRequests(messages [][]byte) int {
+	count := 0
+	for _, message := range messages {
+		if strings.Contains(string(message), "nodeManagementBindingRequestCall") {
+			count++
+		}
+	}
+
+	return count
+}
@@ -13,7 +13,16 @@ import (
 //   - ErrDataNotAvailable if no such measurement is (yet) available
 //   - and others
 func (e *UCOPEV) LoadControlLimits(entity spineapi.EntityRemoteInterface) ([]float64, error) {
-	return util.LoadControlLimits(e.service, entity, e.validEntityTypes, model.LoadControlCategoryTypeObligation)
+	return util.LoadControlLimits(e.service, entity, e.validEntityTypes, model.LoadControlCategoryTypeObligation, e.limitTimes)
+}
+
+// return the current loadcontrol obligation limits with all phase specific details
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such limit is (yet) available
+//   - and others
+func (e *UCOPEV) LoadControlLimitDetails(entity spineapi.EntityRemoteInterface) ([]api.LoadLimitsPhaseDetails, error) {
+	return util.LoadControlLimitDetails(e.service, entity, e.validEntityTypes, model.LoadControlCategoryTypeObligation, e.limitTimes)
 }
 
 // send new LoadControlLimits to the remote EV
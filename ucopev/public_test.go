@@ -1,6 +1,8 @@
 package ucopev
 
 import (
+	"context"
+
 	"github.com/enbility/cemd/api"
 	"github.com/stretchr/testify/assert"
 )
@@ -14,9 +16,39 @@ func (s *UCOPEVSuite) Test_Public() {
 	_, err = s.sut.LoadControlLimits(s.evEntity)
 	assert.NotNil(s.T(), err)
 
+	_, err = s.sut.LoadControlLimitDetails(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+
+	_, err = s.sut.LoadControlLimitDetails(s.evEntity)
+	assert.NotNil(s.T(), err)
+
 	_, err = s.sut.WriteLoadControlLimits(s.mockRemoteEntity, []api.LoadLimitsPhase{})
 	assert.NotNil(s.T(), err)
 
 	_, err = s.sut.WriteLoadControlLimits(s.evEntity, []api.LoadLimitsPhase{})
 	assert.NotNil(s.T(), err)
 }
+
+func (s *UCOPEVSuite) Test_PublicContext() {
+	// The actual tests of the functionality is located in the util package
+	ctx := context.Background()
+
+	_, err := s.sut.LoadControlLimitsContext(ctx, s.mockRemoteEntity, api.ReadOptions{})
+	assert.NotNil(s.T(), err)
+
+	_, err = s.sut.LoadControlLimitsContext(ctx, s.evEntity, api.ReadOptions{Fresh: true})
+	assert.NotNil(s.T(), err)
+
+	_, err = s.sut.LoadControlLimitDetailsContext(ctx, s.evEntity, api.ReadOptions{})
+	assert.NotNil(s.T(), err)
+
+	options := api.WriteOptions{WaitForResult: true}
+	_, err = s.sut.WriteLoadControlLimitsContext(ctx, s.evEntity, []api.LoadLimitsPhase{}, options)
+	assert.NotNil(s.T(), err)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, err = s.sut.WriteLoadControlLimitsContext(cancelled, s.evEntity, []api.LoadLimitsPhase{}, options)
+	assert.ErrorIs(s.T(), err, context.Canceled)
+}
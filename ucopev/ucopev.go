@@ -6,7 +6,6 @@ import (
 	eebusapi "github.com/enbility/eebus-go/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
-	"github.com/enbility/spine-go/spine"
 )
 
 type UCOPEV struct {
@@ -15,6 +14,18 @@ type UCOPEV struct {
 	eventCB api.EventHandlerCB
 
 	validEntityTypes []model.EntityTypeType
+
+	// the client features for the remote server features the usecase uses
+	clientFeatures []model.FeatureTypeType
+
+	// the known compatible remote entities
+	entities util.KnownEntities
+
+	// the receive times of the load control limits with a relative end time
+	limitTimes *util.LoadControlLimitTimes
+
+	// the writes waiting for their result
+	results *util.ResultWaiters
 }
 
 var _ UCOPEVInterface = (*UCOPEV)(nil)
@@ -23,13 +34,19 @@ func NewUCOPEV(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) *U
 	uc := &UCOPEV{
 		service: service,
 		eventCB: eventCB,
+
+		limitTimes: util.NewLoadControlLimitTimes(nil),
+		results:    util.NewResultWaiters(),
 	}
 
 	uc.validEntityTypes = []model.EntityTypeType{
 		model.EntityTypeTypeEV,
 	}
 
-	_ = spine.Events.Subscribe(uc)
+	uc.clientFeatures = []model.FeatureTypeType{
+		model.FeatureTypeTypeLoadControl,
+		model.FeatureTypeTypeElectricalConnection,
+	}
 
 	return uc
 }
@@ -42,13 +59,10 @@ func (e *UCOPEV) AddFeatures() {
 	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
 
 	// client features
-	var clientFeatures = []model.FeatureTypeType{
-		model.FeatureTypeTypeLoadControl,
-		model.FeatureTypeTypeElectricalConnection,
-	}
-	for _, feature := range clientFeatures {
+	for _, feature := range e.clientFeatures {
 		f := localEntity.GetOrAddFeature(feature, model.RoleTypeClient)
 		f.AddResultHandler(e)
+		e.results.AddResultHandler(f)
 	}
 
 	// server features
@@ -69,6 +83,33 @@ func (e *UCOPEV) AddUseCase() {
 		[]model.UseCaseScenarioSupportType{1, 2, 3})
 }
 
+func (e *UCOPEV) RemoveUseCase() {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.RemoveUseCaseSupport(model.UseCaseActorTypeCEM, e.UseCaseName())
+}
+
+func (e *UCOPEV) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeCEM, e.UseCaseName(), available)
+}
+
+// returns the client features for the remote server features the usecase uses
+func (e *UCOPEV) ClientFeatures() []model.FeatureTypeType {
+	return e.clientFeatures
+}
+
+// returns what a remote entity has to provide to support the usecase
+func (e *UCOPEV) RemoteRequirements() api.UseCaseRequirements {
+	return api.UseCaseRequirements{
+		EntityTypes:    e.validEntityTypes,
+		Actors:         []model.UseCaseActorType{model.UseCaseActorTypeEV},
+		Scenarios:      []model.UseCaseScenarioSupportType{1, 2, 3},
+		ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeLoadControl},
+	}
+}
+
 // returns if the entity supports the usecase
 //
 // possible errors:
@@ -81,12 +122,7 @@ func (e *UCOPEV) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool
 
 	// check if the usecase and mandatory scenarios are supported and
 	// if the required server features are available
-	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
-		model.UseCaseActorTypeEV,
-		e.UseCaseName(),
-		[]model.UseCaseScenarioSupportType{1, 2, 3},
-		[]model.FeatureTypeType{model.FeatureTypeTypeLoadControl},
-	) {
+	if !util.VerifyUseCaseRequirements(entity, e.UseCaseName(), e.RemoteRequirements()) {
 		return false, nil
 	}
 
@@ -103,3 +139,38 @@ func (e *UCOPEV) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool
 
 	return true, nil
 }
+
+// returns the scenarios the remote entity announces and for which data is available
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCOPEV) SupportedScenarios(entity spineapi.EntityRemoteInterface) ([]model.UseCaseScenarioSupportType, error) {
+	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	checks := map[model.UseCaseScenarioSupportType]func() error{
+		1: func() error {
+			_, err := e.LoadControlLimits(entity)
+			return err
+		},
+	}
+
+	return util.SupportedScenarios(entity, e.UseCaseName(), e.RemoteRequirements(), checks), nil
+}
+
+// request the descriptions, constraints and values of all features the usecase uses from a remote entity
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCOPEV) Refresh(entity spineapi.EntityRemoteInterface) ([]api.RefreshResult, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	return util.RefreshFeatures(e.service, entity, e.clientFeatures), nil
+}
+
+func (e *UCOPEV) CompatibleEntities() []spineapi.EntityRemoteInterface {
+	return e.entities.Entities()
+}
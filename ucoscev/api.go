@@ -1,6 +1,8 @@
 package ucoscev
 
 import (
+	"context"
+
 	"github.com/enbility/cemd/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
@@ -24,6 +26,23 @@ type UCOSCEVInterface interface {
 	//   - and others
 	LoadControlLimits(entity spineapi.EntityRemoteInterface) ([]float64, error)
 
+	// return the current loadcontrol recommendation limits with all phase specific details
+	//
+	// parameters:
+	//   - entity: the entity of the EV
+	//
+	// return values:
+	//   - per phase: if the limit is active and changeable, its value,
+	//     the permitted min, max and default values, and the time period
+	//
+	// in contrast to LoadControlLimits, inactive limits are not replaced by the
+	// maximum permitted value
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no such limit is (yet) available
+	//   - and others
+	LoadControlLimitDetails(entity spineapi.EntityRemoteInterface) ([]api.LoadLimitsPhaseDetails, error)
+
 	// send new LoadControlLimits to the remote EV
 	//
 	// parameters:
@@ -46,4 +65,30 @@ type UCOSCEVInterface interface {
 
 	// this is covered by the central CEM interface implementation
 	// use that one to set the CEM's operation state which will inform all remote devices
+
+	// context aware variants
+
+	// the getters above with a context
+	//
+	// parameters:
+	//   - ctx: the context, its deadline limits the wait for a fresh value
+	//   - entity: the entity of the device
+	//   - options: if Fresh is set, the data is requested from the remote entity and the reply is awaited before the value is returned
+	//
+	// possible errors:
+	//   - the context error if the context is done before the reply is received
+	//   - and those of the getters above
+	LoadControlLimitsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]float64, error)
+	LoadControlLimitDetailsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) ([]api.LoadLimitsPhaseDetails, error)
+
+	// WriteLoadControlLimits with a context
+	//
+	// parameters:
+	//   - options: if WaitForResult is set, the result of the remote entity is awaited within the context deadline
+	//
+	// possible errors:
+	//   - ErrWriteRejected if the remote entity responded with an error result
+	//   - the context error if the context is done before the result is received
+	//   - and those of WriteLoadControlLimits
+	WriteLoadControlLimitsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, limits []api.LoadLimitsPhase, options api.WriteOptions) (*model.MsgCounterType, error)
 }
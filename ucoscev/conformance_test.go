@@ -0,0 +1,58 @@
+package ucoscev
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cemtest"
+	"github.com/enbility/cemd/conformance"
+	"github.com/enbility/cemd/ucopev"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestConformanceSuite(t *testing.T) {
+	suite.Run(t, new(ConformanceSuite))
+}
+
+type ConformanceSuite struct {
+	suite.Suite
+}
+
+const specification = "EEBus_UC_TS_OptimizationOfSelfConsumptionDuringEVCharging"
+
+// the subscription, the binding and the reads of the load control feature are done by OPEV,
+// which is required to be used along with OSCEV
+var requirements = []conformance.Requirement{
+	conformance.UseCaseAnnounced("OSCEV-G-01", specification, model.UseCaseActorTypeCEM, 1, 2, 3),
+	conformance.RemoteUseCase("OSCEV-G-02", specification, model.UseCaseActorTypeEV, 1, 2, 3),
+	conformance.EntityType("OSCEV-G-03", specification, model.EntityTypeTypeEV),
+	conformance.ClientFeature("OSCEV-S1-01", specification, model.FeatureTypeTypeLoadControl),
+	conformance.ServerFeature("OSCEV-S1-02", specification, model.FeatureTypeTypeLoadControl),
+	conformance.Write("OSCEV-S1-03", specification, model.FeatureTypeTypeLoadControl, model.FunctionTypeLoadControlLimitListData,
+		func(c *conformance.Context) error {
+			_, err := c.UseCase.(*UCOSCEV).WriteLoadControlLimits(c.Entity, []api.LoadLimitsPhase{
+				{Phase: model.ElectricalConnectionPhaseNameTypeA, IsActive: true, Value: cemtest.EVMinCurrent},
+			})
+			return err
+		}),
+	conformance.ResponseTime("OSCEV-S1-04", conformance.ImplementationReference,
+		conformance.Announcement(),
+		conformance.BindingResponse(model.FeatureTypeTypeLoadControl), time.Second),
+}
+
+func (s *ConformanceSuite) Test_Requirements() {
+	conformance.Verify(s.T(), conformance.Setup{
+		UseCase: func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) api.UseCaseInterface {
+			return NewUCOSCEV(service, eventCB)
+		},
+		Requires: func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) []api.UseCaseInterface {
+			return []api.UseCaseInterface{ucopev.NewUCOPEV(service, eventCB)}
+		},
+		Remote: func(device *cemtest.DeviceBuilder) *cemtest.EntityBuilder {
+			return cemtest.EVISO15118WithSoC(cemtest.EVSE3Phase(device), 50)
+		},
+	}, requirements)
+}
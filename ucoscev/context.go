@@ -0,0 +1,47 @@
+package ucoscev
+
+import (
+	"context"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// context aware variant of LoadControlLimits
+func (e *UCOSCEV) LoadControlLimitsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]float64, error) {
+	requests := []util.DataRequest{util.LoadControlLimitValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]float64, error) {
+		return e.LoadControlLimits(entity)
+	})
+}
+
+// context aware variant of LoadControlLimitDetails
+func (e *UCOSCEV) LoadControlLimitDetailsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) ([]api.LoadLimitsPhaseDetails, error) {
+	requests := []util.DataRequest{util.LoadControlLimitValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() ([]api.LoadLimitsPhaseDetails, error) {
+		return e.LoadControlLimitDetails(entity)
+	})
+}
+
+// context aware variant of WriteLoadControlLimits
+//
+// with options.WaitForResult set, the result of the remote entity is awaited within the context deadline
+func (e *UCOSCEV) WriteLoadControlLimitsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	limits []api.LoadLimitsPhase,
+	options api.WriteOptions) (*model.MsgCounterType, error) {
+	return util.WriteWithContext(ctx, e.service, entity, model.FeatureTypeTypeLoadControl, options, e.results, func() (*model.MsgCounterType, error) {
+		return e.WriteLoadControlLimits(entity, limits)
+	})
+}
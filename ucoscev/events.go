@@ -1,20 +1,55 @@
 package ucoscev
 
 import (
+	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
+	"github.com/enbility/ship-go/logging"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
 )
 
+// returns the SPINE events the usecase handles
+func (e *UCOSCEV) EventInterest() api.EventInterest {
+	return api.EventInterest{
+		EntityTypes:  e.validEntityTypes,
+		FeatureTypes: e.clientFeatures,
+		Functions: []model.FunctionType{
+			model.FunctionTypeLoadControlLimitListData,
+		},
+		DeviceEvents: true,
+	}
+}
+
 // handle SPINE events
 func (e *UCOSCEV) HandleEvent(payload spineapi.EventPayload) {
 	// most of the events are identical to OPEV, and OPEV is required to be used,
 	// we don't handle the same events in here
 
+	// the remaining duration of limits with a relative end time counts from their receive time
+	e.limitTimes.HandleEvent(payload)
+
+	if util.IsDeviceDisconnected(payload) {
+		e.deviceDisconnected(payload.Ski, payload.Device)
+		return
+	}
+
 	if !util.IsCompatibleEntity(payload.Entity, e.validEntityTypes) {
 		return
 	}
 
+	if util.IsEntityDisconnected(payload) {
+		e.entityRemoved(payload.Ski, payload.Entity)
+		return
+	}
+
+	if util.IsEntityConnected(payload) {
+		e.evConnected(payload.Entity)
+	}
+
+	if util.IsEntityConnected(payload) || payload.EventType == spineapi.EventTypeDataChange {
+		e.entityAdded(payload.Ski, payload.Entity)
+	}
+
 	if payload.EventType != spineapi.EventTypeDataChange ||
 		payload.ChangeType != spineapi.ElementChangeUpdate {
 		return
@@ -28,6 +63,36 @@ func (e *UCOSCEV) HandleEvent(payload spineapi.EventPayload) {
 	}
 }
 
+// an EV was connected
+func (e *UCOSCEV) evConnected(entity spineapi.EntityRemoteInterface) {
+	// OPEV requests the load control data, but writing limits requires a binding
+	// which OPEV may not have requested, e.g. if it is not added
+	if err := util.Bind(e.service, entity, model.FeatureTypeTypeLoadControl); err != nil {
+		logging.Log().Debug(err)
+	}
+}
+
+// a compatible entity was connected or sent data
+func (e *UCOSCEV) entityAdded(ski string, entity spineapi.EntityRemoteInterface) {
+	if e.entities.Add(entity) {
+		e.eventCB(ski, entity.Device(), entity, EntityAdded)
+	}
+}
+
+// a compatible entity was disconnected
+func (e *UCOSCEV) entityRemoved(ski string, entity spineapi.EntityRemoteInterface) {
+	if e.entities.Remove(entity) {
+		e.eventCB(ski, entity.Device(), entity, EntityRemoved)
+	}
+}
+
+// a remote device was disconnected, so all of its known entities are removed
+func (e *UCOSCEV) deviceDisconnected(ski string, device spineapi.DeviceRemoteInterface) {
+	for _, entity := range e.entities.RemoveDevice(device) {
+		e.eventCB(ski, device, entity, EntityRemoved)
+	}
+}
+
 // the load control limit data of an EV was updated
 func (e *UCOSCEV) evLoadControlLimitDataUpdate(ski string, entity spineapi.EntityRemoteInterface) {
 	evLoadControl, err := util.LoadControl(e.service, entity)
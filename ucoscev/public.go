@@ -13,7 +13,16 @@ import (
 //   - ErrDataNotAvailable if no such measurement is (yet) available
 //   - and others
 func (e *UCOSCEV) LoadControlLimits(entity spineapi.EntityRemoteInterface) ([]float64, error) {
-	return util.LoadControlLimits(e.service, entity, e.validEntityTypes, model.LoadControlCategoryTypeRecommendation)
+	return util.LoadControlLimits(e.service, entity, e.validEntityTypes, model.LoadControlCategoryTypeRecommendation, e.limitTimes)
+}
+
+// return the current loadcontrol recommendation limits with all phase specific details
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such limit is (yet) available
+//   - and others
+func (e *UCOSCEV) LoadControlLimitDetails(entity spineapi.EntityRemoteInterface) ([]api.LoadLimitsPhaseDetails, error) {
+	return util.LoadControlLimitDetails(e.service, entity, e.validEntityTypes, model.LoadControlCategoryTypeRecommendation, e.limitTimes)
 }
 
 // send new LoadControlLimits to the remote EV
@@ -14,6 +14,12 @@ func (s *UCOSCEVSuite) Test_Public() {
 	_, err = s.sut.LoadControlLimits(s.evEntity)
 	assert.NotNil(s.T(), err)
 
+	_, err = s.sut.LoadControlLimitDetails(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+
+	_, err = s.sut.LoadControlLimitDetails(s.evEntity)
+	assert.NotNil(s.T(), err)
+
 	_, err = s.sut.WriteLoadControlLimits(s.mockRemoteEntity, []api.LoadLimitsPhase{})
 	assert.NotNil(s.T(), err)
 
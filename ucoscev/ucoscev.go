@@ -6,7 +6,6 @@ import (
 	eebusapi "github.com/enbility/eebus-go/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
-	"github.com/enbility/spine-go/spine"
 )
 
 type UCOSCEV struct {
@@ -15,6 +14,18 @@ type UCOSCEV struct {
 	eventCB api.EventHandlerCB
 
 	validEntityTypes []model.EntityTypeType
+
+	// the client features for the remote server features the usecase uses
+	clientFeatures []model.FeatureTypeType
+
+	// the known compatible remote entities
+	entities util.KnownEntities
+
+	// the receive times of the load control limits with a relative end time
+	limitTimes *util.LoadControlLimitTimes
+
+	// the writes waiting for their result
+	results *util.ResultWaiters
 }
 
 var _ UCOSCEVInterface = (*UCOSCEV)(nil)
@@ -23,19 +34,19 @@ func NewUCOSCEV(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) *
 	uc := &UCOSCEV{
 		service: service,
 		eventCB: eventCB,
+
+		limitTimes: util.NewLoadControlLimitTimes(nil),
+		results:    util.NewResultWaiters(),
 	}
 
 	uc.validEntityTypes = []model.EntityTypeType{
-		model.EntityTypeTypeCompressor,
-		model.EntityTypeTypeElectricalImmersionHeater,
-		model.EntityTypeTypeEVSE,
-		model.EntityTypeTypeHeatPumpAppliance,
-		model.EntityTypeTypeInverter,
-		model.EntityTypeTypeSmartEnergyAppliance,
-		model.EntityTypeTypeSubMeterElectricity,
+		model.EntityTypeTypeEV,
 	}
 
-	_ = spine.Events.Subscribe(uc)
+	uc.clientFeatures = []model.FeatureTypeType{
+		model.FeatureTypeTypeLoadControl,
+		model.FeatureTypeTypeElectricalConnection,
+	}
 
 	return uc
 }
@@ -48,13 +59,10 @@ func (e *UCOSCEV) AddFeatures() {
 	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
 
 	// client features
-	var clientFeatures = []model.FeatureTypeType{
-		model.FeatureTypeTypeLoadControl,
-		model.FeatureTypeTypeElectricalConnection,
-	}
-	for _, feature := range clientFeatures {
+	for _, feature := range e.clientFeatures {
 		f := localEntity.GetOrAddFeature(feature, model.RoleTypeClient)
 		f.AddResultHandler(e)
+		e.results.AddResultHandler(f)
 	}
 
 	// server features
@@ -75,24 +83,46 @@ func (e *UCOSCEV) AddUseCase() {
 		[]model.UseCaseScenarioSupportType{1, 2, 3})
 }
 
+func (e *UCOSCEV) RemoveUseCase() {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.RemoveUseCaseSupport(model.UseCaseActorTypeCEM, e.UseCaseName())
+}
+
+func (e *UCOSCEV) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeCEM, e.UseCaseName(), available)
+}
+
+// returns the client features for the remote server features the usecase uses
+func (e *UCOSCEV) ClientFeatures() []model.FeatureTypeType {
+	return e.clientFeatures
+}
+
+// returns what a remote entity has to provide to support the usecase
+func (e *UCOSCEV) RemoteRequirements() api.UseCaseRequirements {
+	return api.UseCaseRequirements{
+		EntityTypes:    e.validEntityTypes,
+		Actors:         []model.UseCaseActorType{model.UseCaseActorTypeEV},
+		Scenarios:      []model.UseCaseScenarioSupportType{1, 2, 3},
+		ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeLoadControl},
+	}
+}
+
 // returns if the entity supports the usecase
 //
 // possible errors:
 //   - ErrDataNotAvailable if that information is not (yet) available
 //   - and others
 func (e *UCOSCEV) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool, error) {
-	if entity == nil || entity.EntityType() != model.EntityTypeTypeEV {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
 		return false, api.ErrNoCompatibleEntity
 	}
 
 	// check if the usecase and mandatory scenarios are supported and
 	// if the required server features are available
-	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
-		model.UseCaseActorTypeEV,
-		e.UseCaseName(),
-		[]model.UseCaseScenarioSupportType{1, 2, 3},
-		[]model.FeatureTypeType{model.FeatureTypeTypeLoadControl},
-	) {
+	if !util.VerifyUseCaseRequirements(entity, e.UseCaseName(), e.RemoteRequirements()) {
 		return false, nil
 	}
 
@@ -108,3 +138,38 @@ func (e *UCOSCEV) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (boo
 
 	return true, nil
 }
+
+// returns the scenarios the remote entity announces and for which data is available
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCOSCEV) SupportedScenarios(entity spineapi.EntityRemoteInterface) ([]model.UseCaseScenarioSupportType, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	checks := map[model.UseCaseScenarioSupportType]func() error{
+		1: func() error {
+			_, err := e.LoadControlLimits(entity)
+			return err
+		},
+	}
+
+	return util.SupportedScenarios(entity, e.UseCaseName(), e.RemoteRequirements(), checks), nil
+}
+
+// request the descriptions, constraints and values of all features the usecase uses from a remote entity
+//
+// possible errors:
+//   - ErrNoCompatibleEntity if the entity is not compatible with the usecase
+func (e *UCOSCEV) Refresh(entity spineapi.EntityRemoteInterface) ([]api.RefreshResult, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	return util.RefreshFeatures(e.service, entity, e.clientFeatures), nil
+}
+
+func (e *UCOSCEV) CompatibleEntities() []spineapi.EntityRemoteInterface {
+	return e.entities.Entities()
+}
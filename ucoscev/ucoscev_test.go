@@ -56,3 +56,9 @@ func (s *UCOSCEVSuite) Test_IsUseCaseSupported() {
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), true, data)
 }
+
+func (s *UCOSCEVSuite) Test_EntityTypes() {
+	// the remote actor of OSCEV is the EV, not the entity types of MPC
+	assert.Equal(s.T(), []model.EntityTypeType{model.EntityTypeTypeEV}, s.sut.EventInterest().EntityTypes)
+	assert.Equal(s.T(), []model.EntityTypeType{model.EntityTypeTypeEV}, s.sut.RemoteRequirements().EntityTypes)
+}
@@ -0,0 +1,53 @@
+package ucvabd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cemtest"
+	"github.com/enbility/cemd/conformance"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestConformanceSuite(t *testing.T) {
+	suite.Run(t, new(ConformanceSuite))
+}
+
+type ConformanceSuite struct {
+	suite.Suite
+}
+
+const specification = "EEBus_UC_TS_VisualizationOfAggregatedBatteryData"
+
+var requirements = []conformance.Requirement{
+	conformance.UseCaseAnnounced("VABD-G-01", specification, model.UseCaseActorTypeCEM, 1, 2, 3),
+	conformance.RemoteUseCase("VABD-G-02", specification, model.UseCaseActorTypeBatterySystem, 1, 4),
+	conformance.EntityType("VABD-G-03", specification, model.EntityTypeTypeElectricityStorageSystem),
+	conformance.ClientFeature("VABD-G-04", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.ClientFeature("VABD-G-05", specification, model.FeatureTypeTypeMeasurement),
+	conformance.ServerFeature("VABD-G-06", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.ServerFeature("VABD-G-07", specification, model.FeatureTypeTypeMeasurement),
+	conformance.Subscription("VABD-G-08", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.Subscription("VABD-G-09", specification, model.FeatureTypeTypeMeasurement),
+	conformance.Read("VABD-G-10", specification, model.FeatureTypeTypeElectricalConnection, model.FunctionTypeElectricalConnectionDescriptionListData),
+	conformance.Read("VABD-G-11", specification, model.FeatureTypeTypeElectricalConnection, model.FunctionTypeElectricalConnectionParameterDescriptionListData),
+	conformance.Read("VABD-G-12", specification, model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData),
+	conformance.Read("VABD-G-13", specification, model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData),
+	conformance.ResponseTime("VABD-G-14", conformance.ImplementationReference,
+		conformance.Notification(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData),
+		conformance.ReadResponse(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData), time.Second),
+}
+
+func (s *ConformanceSuite) Test_Requirements() {
+	conformance.Verify(s.T(), conformance.Setup{
+		UseCase: func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) api.UseCaseInterface {
+			return NewUCVABD(service, eventCB)
+		},
+		Remote: func(device *cemtest.DeviceBuilder) *cemtest.EntityBuilder {
+			return cemtest.BatterySystem(device)
+		},
+	}, requirements)
+}
@@ -0,0 +1,69 @@
+package ucvabd
+
+import (
+	"context"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// context aware variant of Power
+func (e *UCVABD) PowerContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.Power(entity)
+	})
+}
+
+// context aware variant of PowerDetails
+func (e *UCVABD) PowerDetailsContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (api.MeasurementResult, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (api.MeasurementResult, error) {
+		return e.PowerDetails(entity)
+	})
+}
+
+// context aware variant of EnergyCharged
+func (e *UCVABD) EnergyChargedContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.EnergyCharged(entity)
+	})
+}
+
+// context aware variant of EnergyDischarged
+func (e *UCVABD) EnergyDischargedContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.EnergyDischarged(entity)
+	})
+}
+
+// context aware variant of StateOfCharge
+func (e *UCVABD) StateOfChargeContext(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions) (float64, error) {
+	requests := []util.DataRequest{util.MeasurementValuesRequest}
+
+	return util.ReadWithContext(ctx, e.service, entity, options, requests, func() (float64, error) {
+		return e.StateOfCharge(entity)
+	})
+}
@@ -1,6 +1,8 @@
 package ucvabd
 
 import (
+	"time"
+
 	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	eebusapi "github.com/enbility/eebus-go/api"
@@ -8,6 +10,13 @@ import (
 	"github.com/enbility/spine-go/model"
 )
 
+// set the age after which measurement values are reported as stale
+//
+// the default is api.DefaultStaleThreshold, 0 disables the staleness detection
+func (e *UCVABD) SetStaleThreshold(threshold time.Duration) {
+	e.staleThreshold.Store(int64(threshold))
+}
+
 // return the current battery (dis-)charge power (W)
 //
 //   - positive values charge power
@@ -17,8 +26,30 @@ import (
 //   - ErrDataNotAvailable if no such measurement is (yet) available
 //   - and others
 func (e *UCVABD) Power(entity spineapi.EntityRemoteInterface) (float64, error) {
+	result, err := e.PowerDetails(entity)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Value, nil
+}
+
+// return the current battery (dis-)charge power (W)
+// including the timestamp, state and source of the value
+//
+//   - positive values charge power
+//   - negative values discharge power
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such measurement is (yet) available
+//   - and others
+func (e *UCVABD) PowerDetails(entity spineapi.EntityRemoteInterface) (api.MeasurementResult, error) {
 	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
-		return 0, api.ErrNoCompatibleEntity
+		return api.MeasurementResult{}, api.ErrNoCompatibleEntity
+	}
+
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 1); err != nil {
+		return api.MeasurementResult{}, err
 	}
 
 	measurement := model.MeasurementTypeTypePower
@@ -27,17 +58,17 @@ func (e *UCVABD) Power(entity spineapi.EntityRemoteInterface) (float64, error) {
 
 	data, err := e.getValuesForTypeCommodityScope(entity, measurement, commodity, scope)
 	if err != nil {
-		return 0, err
+		return api.MeasurementResult{}, err
 	}
 
 	// we assume there is only one value
 	mId := data[0].MeasurementId
 	value := data[0].Value
 	if mId == nil || value == nil {
-		return 0, eebusapi.ErrDataNotAvailable
+		return api.MeasurementResult{}, eebusapi.ErrDataNotAvailable
 	}
 
-	return value.GetValue(), nil
+	return util.MeasurementResultForData(data[0], time.Duration(e.staleThreshold.Load())), nil
 }
 
 // return the total charge energy (Wh)
@@ -50,6 +81,10 @@ func (e *UCVABD) EnergyCharged(entity spineapi.EntityRemoteInterface) (float64,
 		return 0, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 2); err != nil {
+		return 0, err
+	}
+
 	measurement := model.MeasurementTypeTypeEnergy
 	commodity := model.CommodityTypeTypeElectricity
 	scope := model.ScopeTypeTypeCharge
@@ -77,6 +112,10 @@ func (e *UCVABD) EnergyDischarged(entity spineapi.EntityRemoteInterface) (float6
 		return 0, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 3); err != nil {
+		return 0, err
+	}
+
 	measurement := model.MeasurementTypeTypeEnergy
 	commodity := model.CommodityTypeTypeElectricity
 	scope := model.ScopeTypeTypeDischarge
@@ -104,6 +143,10 @@ func (e *UCVABD) StateOfCharge(entity spineapi.EntityRemoteInterface) (float64,
 		return 0, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 4); err != nil {
+		return 0, err
+	}
+
 	measurement := model.MeasurementTypeTypePercentage
 	commodity := model.CommodityTypeTypeElectricity
 	scope := model.ScopeTypeTypeStateOfCharge
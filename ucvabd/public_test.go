@@ -1,6 +1,8 @@
 package ucvabd
 
 import (
+	"time"
+
 	eebusutil "github.com/enbility/eebus-go/util"
 	"github.com/enbility/spine-go/model"
 	"github.com/stretchr/testify/assert"
@@ -51,6 +53,64 @@ func (s *UCVABDSuite) Test_CurrentChargePower() {
 	assert.Equal(s.T(), 10.0, data)
 }
 
+func (s *UCVABDSuite) Test_CurrentChargePowerDetails() {
+	timestamp := time.Now().Add(-2 * time.Minute).UTC()
+
+	data, err := s.sut.PowerDetails(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	data, err = s.sut.PowerDetails(s.batteryEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	descData := &model.MeasurementDescriptionListDataType{
+		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(0)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypePower),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACPowerTotal),
+			},
+		},
+	}
+
+	measurementFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.batteryEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	fErr := measurementFeature.UpdateData(model.FunctionTypeMeasurementDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.PowerDetails(s.batteryEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	measData := &model.MeasurementListDataType{
+		MeasurementData: []model.MeasurementDataType{
+			{
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+				Value:         model.NewScaledNumberType(10),
+				Timestamp:     model.NewAbsoluteOrRelativeTimeTypeFromTime(timestamp),
+				ValueState:    eebusutil.Ptr(model.MeasurementValueStateTypeOutofrange),
+			},
+		},
+	}
+
+	fErr = measurementFeature.UpdateData(model.FunctionTypeMeasurementListData, measData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.PowerDetails(s.batteryEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 10.0, data.Value)
+	assert.Equal(s.T(), timestamp.Unix(), data.Timestamp.Unix())
+	assert.Equal(s.T(), model.MeasurementValueStateTypeOutofrange, data.ValueState)
+	assert.True(s.T(), data.IsStale)
+
+	s.sut.SetStaleThreshold(0)
+
+	data, err = s.sut.PowerDetails(s.batteryEntity)
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), data.IsStale)
+}
+
 func (s *UCVABDSuite) Test_TotalChargeEnergy() {
 	data, err := s.sut.EnergyCharged(s.mockRemoteEntity)
 	assert.NotNil(s.T(), err)
@@ -1,6 +1,7 @@
 package ucvabd
 
 import (
+	"github.com/enbility/cemd/util"
 	eebusutil "github.com/enbility/eebus-go/util"
 	"github.com/enbility/spine-go/model"
 	"github.com/stretchr/testify/assert"
@@ -18,7 +19,7 @@ func (s *UCVABDSuite) Test_IsUseCaseSupported() {
 	ucData := &model.NodeManagementUseCaseDataType{
 		UseCaseInformation: []model.UseCaseInformationDataType{
 			{
-				Actor: eebusutil.Ptr(model.UseCaseActorTypePVSystem),
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeBatterySystem),
 				UseCaseSupport: []model.UseCaseSupportType{
 					{
 						UseCaseName:      eebusutil.Ptr(model.UseCaseNameTypeVisualizationOfAggregatedBatteryData),
@@ -92,3 +93,34 @@ func (s *UCVABDSuite) Test_IsUseCaseSupported() {
 	assert.Equal(s.T(), true, data)
 
 }
+
+func (s *UCVABDSuite) Test_RemoteRequirementsActor() {
+	setActor := func(actor model.UseCaseActorType) {
+		ucData := &model.NodeManagementUseCaseDataType{
+			UseCaseInformation: []model.UseCaseInformationDataType{
+				{
+					Actor: eebusutil.Ptr(actor),
+					UseCaseSupport: []model.UseCaseSupportType{
+						{
+							UseCaseName:      eebusutil.Ptr(model.UseCaseNameTypeVisualizationOfAggregatedBatteryData),
+							UseCaseAvailable: eebusutil.Ptr(true),
+							ScenarioSupport:  []model.UseCaseScenarioSupportType{1, 4},
+						},
+					},
+				},
+			},
+		}
+
+		nodemgmtEntity := s.remoteDevice.Entity([]model.AddressEntityType{0})
+		nodeFeature := s.remoteDevice.FeatureByEntityTypeAndRole(nodemgmtEntity, model.FeatureTypeTypeNodeManagement, model.RoleTypeSpecial)
+		fErr := nodeFeature.UpdateData(model.FunctionTypeNodeManagementUseCaseData, ucData, nil, nil)
+		assert.Nil(s.T(), fErr)
+	}
+
+	// the remote actor of VABD is the battery system
+	setActor(model.UseCaseActorTypePVSystem)
+	assert.False(s.T(), util.VerifyUseCaseRequirements(s.batteryEntity, s.sut.UseCaseName(), s.sut.RemoteRequirements()))
+
+	setActor(model.UseCaseActorTypeBatterySystem)
+	assert.True(s.T(), util.VerifyUseCaseRequirements(s.batteryEntity, s.sut.UseCaseName(), s.sut.RemoteRequirements()))
+}
@@ -1,6 +1,9 @@
 package ucvapd
 
 import (
+	"context"
+	"time"
+
 	"github.com/enbility/cemd/api"
 	spineapi "github.com/enbility/spine-go/api"
 )
@@ -11,6 +14,12 @@ import (
 type UCVAPDInterface interface {
 	api.UseCaseInterface
 
+	// set the age after which measurement values are reported as stale
+	//
+	// parameters:
+	//   - threshold: the maximum age, 0 disables the staleness detection
+	SetStaleThreshold(threshold time.Duration)
+
 	// Scenario 1
 
 	// return the current production power
@@ -19,6 +28,12 @@ type UCVAPDInterface interface {
 	//   - entity: the entity of the inverter
 	Power(entity spineapi.EntityRemoteInterface) (float64, error)
 
+	// return the current production power including the timestamp, state and source of the value
+	//
+	// parameters:
+	//   - entity: the entity of the inverter
+	PowerDetails(entity spineapi.EntityRemoteInterface) (api.MeasurementResult, error)
+
 	// Scenario 2
 
 	// return the nominal peak power
@@ -34,4 +49,21 @@ type UCVAPDInterface interface {
 	// parameters:
 	//   - entity: the entity of the inverter
 	PVYieldTotal(entity spineapi.EntityRemoteInterface) (float64, error)
+
+	// context aware variants
+
+	// the getters above with a context
+	//
+	// parameters:
+	//   - ctx: the context, its deadline limits the wait for a fresh value
+	//   - entity: the entity of the device
+	//   - options: if Fresh is set, the data is requested from the remote entity and the reply is awaited before the value is returned
+	//
+	// possible errors:
+	//   - the context error if the context is done before the reply is received
+	//   - and those of the getters above
+	PowerContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (float64, error)
+	PowerDetailsContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (api.MeasurementResult, error)
+	PowerNominalPeakContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (float64, error)
+	PVYieldTotalContext(ctx context.Context, entity spineapi.EntityRemoteInterface, options api.ReadOptions) (float64, error)
 }
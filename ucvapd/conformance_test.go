@@ -0,0 +1,58 @@
+package ucvapd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cemtest"
+	"github.com/enbility/cemd/conformance"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestConformanceSuite(t *testing.T) {
+	suite.Run(t, new(ConformanceSuite))
+}
+
+type ConformanceSuite struct {
+	suite.Suite
+}
+
+const specification = "EEBus_UC_TS_VisualizationOfAggregatedPhotovoltaicData"
+
+var requirements = []conformance.Requirement{
+	conformance.UseCaseAnnounced("VAPD-G-01", specification, model.UseCaseActorTypeCEM, 1, 2, 3),
+	conformance.RemoteUseCase("VAPD-G-02", specification, model.UseCaseActorTypePVSystem, 1, 2, 3),
+	conformance.EntityType("VAPD-G-03", specification, model.EntityTypeTypePVSystem),
+	conformance.ClientFeature("VAPD-G-04", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.ClientFeature("VAPD-G-05", specification, model.FeatureTypeTypeMeasurement),
+	conformance.ServerFeature("VAPD-G-06", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.ServerFeature("VAPD-G-07", specification, model.FeatureTypeTypeMeasurement),
+	conformance.Subscription("VAPD-G-08", specification, model.FeatureTypeTypeElectricalConnection),
+	conformance.Subscription("VAPD-G-09", specification, model.FeatureTypeTypeMeasurement),
+	conformance.Read("VAPD-G-10", specification, model.FeatureTypeTypeElectricalConnection, model.FunctionTypeElectricalConnectionDescriptionListData),
+	conformance.Read("VAPD-G-11", specification, model.FeatureTypeTypeElectricalConnection, model.FunctionTypeElectricalConnectionParameterDescriptionListData),
+	conformance.Read("VAPD-G-12", specification, model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData),
+	conformance.Read("VAPD-G-13", specification, model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData),
+	conformance.ResponseTime("VAPD-G-14", conformance.ImplementationReference,
+		conformance.Notification(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementDescriptionListData),
+		conformance.ReadResponse(model.FeatureTypeTypeMeasurement, model.FunctionTypeMeasurementListData), time.Second),
+	conformance.ClientFeature("VAPD-S2-01", specification, model.FeatureTypeTypeDeviceConfiguration),
+	conformance.ServerFeature("VAPD-S2-02", specification, model.FeatureTypeTypeDeviceConfiguration),
+	conformance.Subscription("VAPD-S2-03", specification, model.FeatureTypeTypeDeviceConfiguration),
+	conformance.Read("VAPD-S2-04", specification, model.FeatureTypeTypeDeviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData),
+	conformance.Read("VAPD-S2-05", specification, model.FeatureTypeTypeDeviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueListData),
+}
+
+func (s *ConformanceSuite) Test_Requirements() {
+	conformance.Verify(s.T(), conformance.Setup{
+		UseCase: func(service eebusapi.ServiceInterface, eventCB api.EventHandlerCB) api.UseCaseInterface {
+			return NewUCVAPD(service, eventCB)
+		},
+		Remote: func(device *cemtest.DeviceBuilder) *cemtest.EntityBuilder {
+			return cemtest.PVSystem(device)
+		},
+	}, requirements)
+}
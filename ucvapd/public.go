@@ -1,6 +1,8 @@
 package ucvapd
 
 import (
+	"time"
+
 	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	eebusapi "github.com/enbility/eebus-go/api"
@@ -8,14 +10,40 @@ import (
 	"github.com/enbility/spine-go/model"
 )
 
+// set the age after which measurement values are reported as stale
+//
+// the default is api.DefaultStaleThreshold, 0 disables the staleness detection
+func (e *UCVAPD) SetStaleThreshold(threshold time.Duration) {
+	e.staleThreshold.Store(int64(threshold))
+}
+
 // return the current photovoltaic production power (W)
 //
 // possible errors:
 //   - ErrDataNotAvailable if no such measurement is (yet) available
 //   - and others
 func (e *UCVAPD) Power(entity spineapi.EntityRemoteInterface) (float64, error) {
+	result, err := e.PowerDetails(entity)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Value, nil
+}
+
+// return the current photovoltaic production power (W)
+// including the timestamp, state and source of the value
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such measurement is (yet) available
+//   - and others
+func (e *UCVAPD) PowerDetails(entity spineapi.EntityRemoteInterface) (api.MeasurementResult, error) {
 	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
-		return 0, api.ErrNoCompatibleEntity
+		return api.MeasurementResult{}, api.ErrNoCompatibleEntity
+	}
+
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 1); err != nil {
+		return api.MeasurementResult{}, err
 	}
 
 	measurement := model.MeasurementTypeTypePower
@@ -24,17 +52,17 @@ func (e *UCVAPD) Power(entity spineapi.EntityRemoteInterface) (float64, error) {
 
 	data, err := e.getValuesForTypeCommodityScope(entity, measurement, commodity, scope)
 	if err != nil {
-		return 0, err
+		return api.MeasurementResult{}, err
 	}
 
 	// we assume there is only one value
 	mId := data[0].MeasurementId
 	value := data[0].Value
 	if mId == nil || value == nil {
-		return 0, eebusapi.ErrDataNotAvailable
+		return api.MeasurementResult{}, eebusapi.ErrDataNotAvailable
 	}
 
-	return value.GetValue(), nil
+	return util.MeasurementResultForData(data[0], time.Duration(e.staleThreshold.Load())), nil
 }
 
 // return the nominal photovoltaic peak power (W)
@@ -47,6 +75,10 @@ func (e *UCVAPD) PowerNominalPeak(entity spineapi.EntityRemoteInterface) (float6
 		return 0, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 2); err != nil {
+		return 0, err
+	}
+
 	deviceConfiguration, err := util.DeviceConfiguration(e.service, entity)
 	if err != nil {
 		return 0, eebusapi.ErrFunctionNotSupported
@@ -84,6 +116,10 @@ func (e *UCVAPD) PVYieldTotal(entity spineapi.EntityRemoteInterface) (float64, e
 		return 0, api.ErrNoCompatibleEntity
 	}
 
+	if err := util.CheckScenarioSupport(entity, e.UseCaseName(), e.RemoteRequirements(), 3); err != nil {
+		return 0, err
+	}
+
 	measurement := model.MeasurementTypeTypeEnergy
 	commodity := model.CommodityTypeTypeElectricity
 	scope := model.ScopeTypeTypeACYieldTotal
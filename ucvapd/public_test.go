@@ -1,6 +1,8 @@
 package ucvapd
 
 import (
+	"time"
+
 	eebusutil "github.com/enbility/eebus-go/util"
 	"github.com/enbility/spine-go/model"
 	"github.com/stretchr/testify/assert"
@@ -51,6 +53,64 @@ func (s *UCVAPDSuite) Test_CurrentProductionPower() {
 	assert.Equal(s.T(), 10.0, data)
 }
 
+func (s *UCVAPDSuite) Test_CurrentProductionPowerDetails() {
+	timestamp := time.Now().Add(-2 * time.Minute).UTC()
+
+	data, err := s.sut.PowerDetails(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	data, err = s.sut.PowerDetails(s.pvEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	descData := &model.MeasurementDescriptionListDataType{
+		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(0)),
+				MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypePower),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACPowerTotal),
+			},
+		},
+	}
+
+	measurementFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.pvEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	fErr := measurementFeature.UpdateData(model.FunctionTypeMeasurementDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.PowerDetails(s.pvEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data.Value)
+
+	measData := &model.MeasurementListDataType{
+		MeasurementData: []model.MeasurementDataType{
+			{
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+				Value:         model.NewScaledNumberType(10),
+				Timestamp:     model.NewAbsoluteOrRelativeTimeTypeFromTime(timestamp),
+				ValueState:    eebusutil.Ptr(model.MeasurementValueStateTypeOutofrange),
+			},
+		},
+	}
+
+	fErr = measurementFeature.UpdateData(model.FunctionTypeMeasurementListData, measData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.PowerDetails(s.pvEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 10.0, data.Value)
+	assert.Equal(s.T(), timestamp.Unix(), data.Timestamp.Unix())
+	assert.Equal(s.T(), model.MeasurementValueStateTypeOutofrange, data.ValueState)
+	assert.True(s.T(), data.IsStale)
+
+	s.sut.SetStaleThreshold(0)
+
+	data, err = s.sut.PowerDetails(s.pvEntity)
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), data.IsStale)
+}
+
 func (s *UCVAPDSuite) Test_NominalPeakPower() {
 	data, err := s.sut.PowerNominalPeak(s.mockRemoteEntity)
 	assert.NotNil(s.T(), err)
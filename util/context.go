@@ -0,0 +1,97 @@
+package util
+
+import (
+	"context"
+	"errors"
+
+	"github.com/enbility/cemd/api"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// read a value with a context
+//
+// if options.Fresh is set, the data is requested from the remote entity and
+// the replies are awaited within the context deadline before the value is read
+func ReadWithContext[T any](
+	ctx context.Context,
+	service eebusapi.ServiceInterface,
+	entity spineapi.EntityRemoteInterface,
+	options api.ReadOptions,
+	requests []DataRequest,
+	read func() (T, error)) (T, error) {
+	var zero T
+
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
+
+	value, err := read()
+	if !options.Fresh ||
+		errors.Is(err, api.ErrNoCompatibleEntity) ||
+		errors.Is(err, api.ErrScenarioNotSupported) {
+		return value, err
+	}
+
+	var msgCounters []model.MsgCounterType
+	for _, request := range requests {
+		msgCounter, err := RequestData(service, entity, request)
+		if err != nil {
+			return zero, err
+		}
+
+		msgCounters = append(msgCounters, *msgCounter)
+	}
+
+	for index, request := range requests {
+		if err := WaitForReply(ctx, service, entity, request, msgCounters[index]); err != nil {
+			return zero, err
+		}
+	}
+
+	return read()
+}
+
+// write data with a context
+//
+// if options.WaitForResult is set, the result of the remote entity is awaited within the context deadline.
+// The result is handed over even if it is received before write returns.
+//
+// parameters:
+//   - results: the result waiters of the caller, added as result handler to the local feature of featureType
+func WriteWithContext(
+	ctx context.Context,
+	service eebusapi.ServiceInterface,
+	entity spineapi.EntityRemoteInterface,
+	featureType model.FeatureTypeType,
+	options api.WriteOptions,
+	results *ResultWaiters,
+	write func() (*model.MsgCounterType, error)) (*model.MsgCounterType, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !options.WaitForResult {
+		return write()
+	}
+
+	if _, _, err := localAndRemoteFeatures(service, entity, featureType); err != nil {
+		return nil, err
+	}
+
+	// the write is announced before sending, so an early result is not lost
+	results.send()
+
+	msgCounter, err := write()
+	if err != nil || msgCounter == nil {
+		results.sent("", nil)
+		return msgCounter, err
+	}
+
+	result := results.sent(entity.Device().Ski(), msgCounter)
+
+	key := resultKey{ski: entity.Device().Ski(), msgCounter: *msgCounter}
+
+	return msgCounter, results.wait(ctx, key, result)
+}
@@ -0,0 +1,77 @@
+package util
+
+import (
+	"slices"
+	"sync"
+
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// The compatible remote entities a use case knows about
+//
+// The zero value is ready to use.
+type KnownEntities struct {
+	entities []spineapi.EntityRemoteInterface
+
+	mux sync.Mutex
+}
+
+// add an entity, returns false if it is already known
+func (k *KnownEntities) Add(entity spineapi.EntityRemoteInterface) bool {
+	if entity == nil {
+		return false
+	}
+
+	k.mux.Lock()
+	defer k.mux.Unlock()
+
+	if slices.Contains(k.entities, entity) {
+		return false
+	}
+
+	k.entities = append(k.entities, entity)
+
+	return true
+}
+
+// remove an entity, returns false if it is not known
+func (k *KnownEntities) Remove(entity spineapi.EntityRemoteInterface) bool {
+	k.mux.Lock()
+	defer k.mux.Unlock()
+
+	if entity == nil || !slices.Contains(k.entities, entity) {
+		return false
+	}
+
+	k.entities = slices.DeleteFunc(k.entities, func(item spineapi.EntityRemoteInterface) bool {
+		return item == entity
+	})
+
+	return true
+}
+
+// remove all entities of a device, returns the removed entities
+func (k *KnownEntities) RemoveDevice(device spineapi.DeviceRemoteInterface) []spineapi.EntityRemoteInterface {
+	k.mux.Lock()
+	defer k.mux.Unlock()
+
+	var removed []spineapi.EntityRemoteInterface
+	k.entities = slices.DeleteFunc(k.entities, func(item spineapi.EntityRemoteInterface) bool {
+		if device == nil || item.Device() != device {
+			return false
+		}
+
+		removed = append(removed, item)
+		return true
+	})
+
+	return removed
+}
+
+// returns the known entities
+func (k *KnownEntities) Entities() []spineapi.EntityRemoteInterface {
+	k.mux.Lock()
+	defer k.mux.Unlock()
+
+	return slices.Clone(k.entities)
+}
@@ -0,0 +1,29 @@
+package util
+
+import (
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UtilSuite) Test_KnownEntities() {
+	var sut KnownEntities
+	assert.Equal(s.T(), 0, len(sut.Entities()))
+
+	assert.False(s.T(), sut.Add(nil))
+	assert.True(s.T(), sut.Add(s.evseEntity))
+	assert.False(s.T(), sut.Add(s.evseEntity))
+	assert.True(s.T(), sut.Add(s.monitoredEntity))
+	assert.Equal(s.T(), []spineapi.EntityRemoteInterface{s.evseEntity, s.monitoredEntity}, sut.Entities())
+
+	assert.True(s.T(), sut.Remove(s.evseEntity))
+	assert.False(s.T(), sut.Remove(s.evseEntity))
+	assert.False(s.T(), sut.Remove(nil))
+	assert.Equal(s.T(), []spineapi.EntityRemoteInterface{s.monitoredEntity}, sut.Entities())
+
+	assert.True(s.T(), sut.Add(s.evseEntity))
+	assert.Nil(s.T(), sut.RemoveDevice(nil))
+
+	removed := sut.RemoveDevice(s.remoteDevice)
+	assert.Equal(s.T(), []spineapi.EntityRemoteInterface{s.monitoredEntity, s.evseEntity}, removed)
+	assert.Equal(s.T(), 0, len(sut.Entities()))
+}
@@ -0,0 +1,152 @@
+package util
+
+import (
+	"slices"
+	"sync"
+
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/spine"
+)
+
+// SPINE publishes the events of all services in a process on one global event bus.
+// The router is subscribed to it once and passes each event only to the handlers
+// subscribed for the service the event belongs to.
+//
+// The router never unsubscribes from the bus, as spine.Events.Unsubscribe removes
+// all application handlers and not only the given one.
+type eventRouter struct {
+	services []*serviceEvents
+
+	subscribed bool
+
+	mux sync.Mutex
+}
+
+// the event handlers of a service
+type serviceEvents struct {
+	service  eebusapi.ServiceInterface
+	handlers []spineapi.EventHandlerInterface
+
+	// the remote devices of the service seen in events, as a disconnected device
+	// is removed from the local device before the disconnect event is published
+	devices map[spineapi.DeviceRemoteInterface]struct{}
+}
+
+var router = &eventRouter{}
+
+// subscribe a handler to the SPINE events of a service
+func SubscribeEvents(service eebusapi.ServiceInterface, handler spineapi.EventHandlerInterface) error {
+	router.mux.Lock()
+	defer router.mux.Unlock()
+
+	if !router.subscribed {
+		if err := spine.Events.Subscribe(router); err != nil {
+			return err
+		}
+		router.subscribed = true
+	}
+
+	events := router.serviceEvents(service)
+	if events == nil {
+		events = &serviceEvents{
+			service: service,
+			devices: make(map[spineapi.DeviceRemoteInterface]struct{}),
+		}
+		router.services = append(router.services, events)
+	}
+
+	if !slices.Contains(events.handlers, handler) {
+		events.handlers = append(events.handlers, handler)
+	}
+
+	return nil
+}
+
+// unsubscribe a handler from the SPINE events of a service
+func UnsubscribeEvents(service eebusapi.ServiceInterface, handler spineapi.EventHandlerInterface) {
+	router.mux.Lock()
+	defer router.mux.Unlock()
+
+	events := router.serviceEvents(service)
+	if events == nil {
+		return
+	}
+
+	events.handlers = slices.DeleteFunc(events.handlers, func(item spineapi.EventHandlerInterface) bool {
+		return item == handler
+	})
+}
+
+// unsubscribe all handlers from the SPINE events of a service
+func RemoveServiceEvents(service eebusapi.ServiceInterface) {
+	router.mux.Lock()
+	defer router.mux.Unlock()
+
+	router.services = slices.DeleteFunc(router.services, func(item *serviceEvents) bool {
+		return item.service == service
+	})
+}
+
+// returns the event handlers of a service, has to be called with the lock held
+func (r *eventRouter) serviceEvents(service eebusapi.ServiceInterface) *serviceEvents {
+	for _, item := range r.services {
+		if item.service == service {
+			return item
+		}
+	}
+
+	return nil
+}
+
+// pass a SPINE event to the handlers of the services it belongs to
+func (r *eventRouter) HandleEvent(payload spineapi.EventPayload) {
+	var handlers []spineapi.EventHandlerInterface
+
+	r.mux.Lock()
+	for _, item := range r.services {
+		if item.belongs(payload) {
+			handlers = append(handlers, item.handlers...)
+		}
+	}
+	r.mux.Unlock()
+
+	for _, handler := range handlers {
+		handler.HandleEvent(payload)
+	}
+}
+
+// returns if an event is about a remote device of the service, has to be called with the router lock held
+func (s *serviceEvents) belongs(payload spineapi.EventPayload) bool {
+	localDevice := s.service.LocalDevice()
+	if localDevice == nil {
+		return false
+	}
+
+	device := payload.Device
+	if device == nil && payload.Entity != nil {
+		device = payload.Entity.Device()
+	}
+	if device == nil && payload.Feature != nil {
+		device = payload.Feature.Device()
+	}
+
+	if device == nil {
+		return len(payload.Ski) > 0 && localDevice.RemoteDeviceForSki(payload.Ski) != nil
+	}
+
+	if localDevice.RemoteDeviceForSki(device.Ski()) == device {
+		s.devices[device] = struct{}{}
+		return true
+	}
+
+	if _, ok := s.devices[device]; !ok {
+		return false
+	}
+
+	if payload.EventType == spineapi.EventTypeDeviceChange && payload.ChangeType == spineapi.ElementChangeRemove {
+		delete(s.devices, device)
+	}
+
+	return true
+}
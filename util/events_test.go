@@ -0,0 +1,76 @@
+package util
+
+import (
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/spine"
+	"github.com/stretchr/testify/assert"
+)
+
+type eventRecorder struct {
+	payloads []spineapi.EventPayload
+}
+
+func (e *eventRecorder) HandleEvent(payload spineapi.EventPayload) {
+	e.payloads = append(e.payloads, payload)
+}
+
+func (s *UtilSuite) Test_SubscribeEvents() {
+	handler := &eventRecorder{}
+	err := SubscribeEvents(s.service, handler)
+	assert.Nil(s.T(), err)
+	defer RemoveServiceEvents(s.service)
+
+	// subscribing twice does not duplicate the events
+	err = SubscribeEvents(s.service, handler)
+	assert.Nil(s.T(), err)
+
+	payload := spineapi.EventPayload{
+		Ski:        remoteSki,
+		Device:     s.remoteDevice,
+		EventType:  spineapi.EventTypeDeviceChange,
+		ChangeType: spineapi.ElementChangeAdd,
+	}
+	spine.Events.Publish(payload)
+	assert.Equal(s.T(), 1, len(handler.payloads))
+
+	// the event of an entity is routed by the device of the entity
+	spine.Events.Publish(spineapi.EventPayload{
+		Ski:        remoteSki,
+		Entity:     s.monitoredEntity,
+		EventType:  spineapi.EventTypeEntityChange,
+		ChangeType: spineapi.ElementChangeUpdate,
+	})
+	assert.Equal(s.T(), 2, len(handler.payloads))
+
+	// an event of a device unknown to the service
+	spine.Events.Publish(spineapi.EventPayload{
+		Ski:        "unknownski",
+		EventType:  spineapi.EventTypeDeviceChange,
+		ChangeType: spineapi.ElementChangeAdd,
+	})
+	assert.Equal(s.T(), 2, len(handler.payloads))
+
+	// the disconnected device is still routed to the service
+	s.service.LocalDevice().RemoveRemoteDeviceConnection(remoteSki)
+	assert.Equal(s.T(), 3, len(handler.payloads))
+	assert.Equal(s.T(), spineapi.ElementChangeRemove, handler.payloads[2].ChangeType)
+
+	// but only once
+	spine.Events.Publish(payload)
+	assert.Equal(s.T(), 3, len(handler.payloads))
+
+	s.service.LocalDevice().AddRemoteDeviceForSki(remoteSki, s.remoteDevice)
+
+	UnsubscribeEvents(s.service, handler)
+	spine.Events.Publish(payload)
+	assert.Equal(s.T(), 3, len(handler.payloads))
+
+	err = SubscribeEvents(s.service, handler)
+	assert.Nil(s.T(), err)
+	spine.Events.Publish(payload)
+	assert.Equal(s.T(), 4, len(handler.payloads))
+
+	RemoveServiceEvents(s.service)
+	spine.Events.Publish(payload)
+	assert.Equal(s.T(), 4, len(handler.payloads))
+}
@@ -2,7 +2,10 @@ package util
 
 import (
 	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/enbility/cemd/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
 )
@@ -17,6 +20,30 @@ func IsCompatibleEntity(entity spineapi.EntityRemoteInterface, entityTypes []mod
 	return slices.Contains(entityTypes, entity.EntityType())
 }
 
+// returns if the device of an entity announces a usecase with one of the required actors
+// and all mandatory scenarios, and if the required server features are available
+func VerifyUseCaseRequirements(
+	entity spineapi.EntityRemoteInterface,
+	usecase model.UseCaseNameType,
+	requirements api.UseCaseRequirements) bool {
+	if entity == nil || entity.Device() == nil {
+		return false
+	}
+
+	for _, actor := range requirements.Actors {
+		if entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
+			actor,
+			usecase,
+			requirements.Scenarios,
+			requirements.ServerFeatures,
+		) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func IsDeviceConnected(payload spineapi.EventPayload) bool {
 	return payload.Device != nil &&
 		payload.EventType == spineapi.EventTypeDeviceChange &&
@@ -48,3 +75,17 @@ func IsEntityDisconnected(payload spineapi.EventPayload) bool {
 
 	return false
 }
+
+// return the address of an entity as a dot separated string, e.g. "1.1"
+func EntityAddressString(entity spineapi.EntityRemoteInterface) string {
+	if entity == nil || entity.Address() == nil {
+		return ""
+	}
+
+	var parts []string
+	for _, item := range entity.Address().Entity {
+		parts = append(parts, strconv.FormatUint(uint64(item), 10))
+	}
+
+	return strings.Join(parts, ".")
+}
@@ -83,3 +83,11 @@ func (s *UtilSuite) Test_IsEntityDisconnected() {
 	result = IsEntityDisconnected(payload)
 	assert.Equal(s.T(), true, result)
 }
+
+func (s *UtilSuite) Test_EntityAddressString() {
+	result := EntityAddressString(nil)
+	assert.Equal(s.T(), "", result)
+
+	result = EntityAddressString(s.monitoredEntity)
+	assert.Equal(s.T(), "1.1", result)
+}
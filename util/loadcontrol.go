@@ -1,6 +1,10 @@
 package util
 
 import (
+	"slices"
+	"sync"
+	"time"
+
 	"github.com/enbility/cemd/api"
 	eebusapi "github.com/enbility/eebus-go/api"
 	eebusutil "github.com/enbility/eebus-go/util"
@@ -10,6 +14,9 @@ import (
 
 // return the current loadcontrol limits for a categoriy
 //
+// limitTimes provides the receive times of limits with a relative end time,
+// expired limits are handled like inactive limits
+//
 // possible errors:
 //   - ErrDataNotAvailable if no such measurement is (yet) available
 //   - and others
@@ -17,7 +24,8 @@ func LoadControlLimits(
 	service eebusapi.ServiceInterface,
 	entity spineapi.EntityRemoteInterface,
 	entityTypes []model.EntityTypeType,
-	category model.LoadControlCategoryType) ([]float64, error) {
+	category model.LoadControlCategoryType,
+	limitTimes *LoadControlLimitTimes) ([]float64, error) {
 	if entity == nil || !IsCompatibleEntity(entity, entityTypes) {
 		return nil, api.ErrNoCompatibleEntity
 	}
@@ -48,16 +56,7 @@ func LoadControlLimits(
 			continue
 		}
 
-		var limitDesc *model.LoadControlLimitDescriptionDataType
-		for _, desc := range limitDescriptions {
-			if desc.MeasurementId != nil &&
-				elParamDesc.MeasurementId != nil &&
-				*desc.MeasurementId == *elParamDesc.MeasurementId {
-				safeDesc := desc
-				limitDesc = &safeDesc
-				break
-			}
-		}
+		limitDesc := limitDescriptionForMeasurementId(limitDescriptions, *elParamDesc.MeasurementId)
 
 		if limitDesc == nil || limitDesc.LimitId == nil {
 			return nil, eebusapi.ErrDataNotAvailable
@@ -68,9 +67,11 @@ func LoadControlLimits(
 			return nil, eebusapi.ErrDataNotAvailable
 		}
 
+		_, valid := limitTimes.remainingDuration(limitTimes.endTime(entity, *limitDesc.LimitId, limitIdData.TimePeriod))
+
 		var limitValue float64
-		if limitIdData.Value == nil || (limitIdData.IsLimitActive != nil && !*limitIdData.IsLimitActive) {
-			// report maximum possible if no limit is available or the limit is not active
+		if limitIdData.Value == nil || !valid || (limitIdData.IsLimitActive != nil && !*limitIdData.IsLimitActive) {
+			// report maximum possible if no limit is available, the limit is not active or expired
 			_, dataMax, _, err := evElectricalConnection.GetLimitsForParameterId(*elParamDesc.ParameterId)
 			if err != nil {
 				return nil, eebusapi.ErrDataNotAvailable
@@ -87,6 +88,260 @@ func LoadControlLimits(
 	return result, nil
 }
 
+// return the current loadcontrol limits for a category with phase specific details
+//
+// in contrast to LoadControlLimits, inactive or missing limit values are not
+// replaced with the maximum permitted value, and the remaining validity of
+// each limit is reported in the Duration field (0 if the limit does not expire).
+// limitTimes provides the receive times of limits with a relative end time.
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such limit is (yet) available
+//   - and others
+func LoadControlLimitDetails(
+	service eebusapi.ServiceInterface,
+	entity spineapi.EntityRemoteInterface,
+	entityTypes []model.EntityTypeType,
+	category model.LoadControlCategoryType,
+	limitTimes *LoadControlLimitTimes) ([]api.LoadLimitsPhaseDetails, error) {
+	if entity == nil || !IsCompatibleEntity(entity, entityTypes) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	loadControl, err := LoadControl(service, entity)
+	electricalConnection, err2 := ElectricalConnection(service, entity)
+	if err != nil || err2 != nil {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	limitDescriptions, err := loadControl.GetLimitDescriptionsForCategory(category)
+	if err != nil {
+		return nil, eebusapi.ErrDataNotAvailable
+	}
+
+	var result []api.LoadLimitsPhaseDetails
+
+	for _, phaseName := range PhaseNameMapping {
+		// electricalParameterDescription contains the measured phase for each measurementId
+		elParamDesc, err := electricalConnection.GetParameterDescriptionForMeasuredPhase(phaseName)
+		if err != nil || elParamDesc.MeasurementId == nil {
+			// there is no data for this phase, the phase may not exist
+			continue
+		}
+
+		limitDesc := limitDescriptionForMeasurementId(limitDescriptions, *elParamDesc.MeasurementId)
+		if limitDesc == nil || limitDesc.LimitId == nil {
+			return nil, eebusapi.ErrDataNotAvailable
+		}
+
+		limitIdData, err := loadControl.GetLimitValueForLimitId(*limitDesc.LimitId)
+		if err != nil {
+			return nil, eebusapi.ErrDataNotAvailable
+		}
+
+		// an expired limit is reported as inactive
+		endTime, hasEndTime := limitTimes.endTime(entity, *limitDesc.LimitId, limitIdData.TimePeriod)
+		remaining, valid := limitTimes.remainingDuration(endTime, hasEndTime)
+
+		limit := api.LoadLimitsPhaseDetails{
+			LoadLimitsPhase: api.LoadLimitsPhase{
+				Phase:    phaseName,
+				IsActive: valid && limitIdData.IsLimitActive != nil && *limitIdData.IsLimitActive,
+				Duration: remaining,
+			},
+			// EEBus_UC_TS_OverloadProtectionByEvChargingCurrentCurtailment V1.01b 3.2.1.2.2.2
+			// If omitted or set to "true", the timePeriod, value and isLimitActive element SHALL be writeable by a client.
+			IsChangeable: limitIdData.IsLimitChangeable == nil || *limitIdData.IsLimitChangeable,
+		}
+		if limitIdData.Value != nil {
+			limit.Value = limitIdData.Value.GetValue()
+		}
+
+		if limitIdData.TimePeriod != nil {
+			if limitIdData.TimePeriod.StartTime != nil {
+				if startTime, err := limitIdData.TimePeriod.StartTime.GetTime(); err == nil {
+					limit.StartTime = startTime
+				}
+			}
+			if hasEndTime {
+				limit.EndTime = endTime
+			}
+		}
+
+		// electricalPermittedValueSet contains the allowed min, max and the default values per phase
+		if elParamDesc.ParameterId != nil {
+			if dataMin, dataMax, dataDefault, err := electricalConnection.GetLimitsForParameterId(*elParamDesc.ParameterId); err == nil {
+				limit.Min = dataMin
+				limit.Max = dataMax
+				limit.Default = dataDefault
+			}
+		}
+
+		result = append(result, limit)
+	}
+
+	if len(result) == 0 {
+		return nil, eebusapi.ErrDataNotAvailable
+	}
+
+	return result, nil
+}
+
+// return the limit description referencing the given measurementId, nil if there is none
+func limitDescriptionForMeasurementId(
+	limitDescriptions []model.LoadControlLimitDescriptionDataType,
+	measurementId model.MeasurementIdType) *model.LoadControlLimitDescriptionDataType {
+	for _, desc := range limitDescriptions {
+		if desc.MeasurementId != nil && *desc.MeasurementId == measurementId {
+			safeDesc := desc
+			return &safeDesc
+		}
+	}
+
+	return nil
+}
+
+// LoadControlLimitTimes stores the times limits with a relative end time were received,
+// per remote entity and limit id
+//
+// A relative end time is relative to the time the data was received, so it
+// needs to be stored to calculate the remaining duration of the limit later.
+// Each use case reading load control limits owns an instance and passes
+// its SPINE events to HandleEvent.
+type LoadControlLimitTimes struct {
+	// returns the current time
+	now func() time.Time
+
+	times map[spineapi.EntityRemoteInterface]map[model.LoadControlLimitIdType]time.Time
+
+	mux sync.Mutex
+}
+
+// returns a new LoadControlLimitTimes, now returns the current time and time.Now is used if it is nil
+func NewLoadControlLimitTimes(now func() time.Time) *LoadControlLimitTimes {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &LoadControlLimitTimes{
+		now:   now,
+		times: make(map[spineapi.EntityRemoteInterface]map[model.LoadControlLimitIdType]time.Time),
+	}
+}
+
+// track the receive times of limits with a relative end time
+//
+// needs to be called with the SPINE events of the use case owning the instance,
+// so the remaining duration of a limit counts down from the time it was received
+func (l *LoadControlLimitTimes) HandleEvent(payload spineapi.EventPayload) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	if IsDeviceDisconnected(payload) {
+		for entity := range l.times {
+			if entity.Device() == payload.Device {
+				delete(l.times, entity)
+			}
+		}
+		return
+	}
+
+	if IsEntityDisconnected(payload) {
+		delete(l.times, payload.Entity)
+		return
+	}
+
+	data, ok := payload.Data.(*model.LoadControlLimitListDataType)
+	if !ok || data == nil || payload.Entity == nil ||
+		payload.EventType != spineapi.EventTypeDataChange ||
+		payload.ChangeType != spineapi.ElementChangeUpdate {
+		return
+	}
+
+	now := l.now()
+	for _, item := range data.LoadControlLimitData {
+		if item.LimitId == nil {
+			continue
+		}
+
+		times, ok := l.times[payload.Entity]
+		if !ok {
+			times = make(map[model.LoadControlLimitIdType]time.Time)
+			l.times[payload.Entity] = times
+		}
+
+		if _, relative := relativeEndTime(item.TimePeriod); relative {
+			times[*item.LimitId] = now
+		} else {
+			delete(times, *item.LimitId)
+		}
+	}
+}
+
+// returns the duration of a relative end time, false if there is none
+func relativeEndTime(timePeriod *model.TimePeriodType) (time.Duration, bool) {
+	if timePeriod == nil || timePeriod.EndTime == nil {
+		return 0, false
+	}
+
+	duration, err := timePeriod.EndTime.GetTimeDuration()
+	if err != nil {
+		return 0, false
+	}
+
+	return duration, true
+}
+
+// returns the end time of a limit time period, false if there is none
+//
+// a relative end time is relative to the time the limit was received,
+// if that is not known it is relative to the time it was read the first time
+func (l *LoadControlLimitTimes) endTime(entity spineapi.EntityRemoteInterface, limitId model.LoadControlLimitIdType, timePeriod *model.TimePeriodType) (time.Time, bool) {
+	if timePeriod == nil || timePeriod.EndTime == nil {
+		return time.Time{}, false
+	}
+
+	duration, relative := relativeEndTime(timePeriod)
+	if !relative {
+		endTime, err := timePeriod.EndTime.GetTime()
+		return endTime, err == nil
+	}
+
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	times, ok := l.times[entity]
+	if !ok {
+		times = make(map[model.LoadControlLimitIdType]time.Time)
+		l.times[entity] = times
+	}
+
+	received, ok := times[limitId]
+	if !ok {
+		received = l.now()
+		times[limitId] = received
+	}
+
+	return received.Add(duration), true
+}
+
+// return the remaining duration of a limit ending at endTime
+//
+// returns false if the end time of the limit has already passed,
+// a duration of 0 means the limit does not expire
+func (l *LoadControlLimitTimes) remainingDuration(endTime time.Time, hasEndTime bool) (time.Duration, bool) {
+	if !hasEndTime {
+		return 0, true
+	}
+
+	remaining := endTime.Sub(l.now())
+	if remaining <= 0 {
+		return 0, false
+	}
+
+	return remaining, true
+}
+
 // generic helper to be used in UCOPEV & UCOSCEV
 // send new LoadControlLimits to the remote EV
 //
@@ -113,6 +368,7 @@ func LoadControlLimits(
 //   - In ISO15118-2 the usecase is only supported via VAS extensions which are vendor specific and needs to have specific EVSE support for the specific EV brand.
 //   - In ISO15118-20 this is a standard feature which does not need special support on the EVSE.
 //   - Min power data is only provided via IEC61851 or using VAS in ISO15118-2.
+//   - If a limit has a Duration set, the limit expires automatically on the remote side after that duration.
 func WriteLoadControlLimits(
 	service eebusapi.ServiceInterface,
 	entity spineapi.EntityRemoteInterface,
@@ -145,16 +401,7 @@ func WriteLoadControlLimits(
 			continue
 		}
 
-		var limitDesc *model.LoadControlLimitDescriptionDataType
-		for _, desc := range limitDescriptions {
-			if desc.MeasurementId != nil &&
-				elParamDesc.MeasurementId != nil &&
-				*desc.MeasurementId == *elParamDesc.MeasurementId {
-				safeDesc := desc
-				limitDesc = &safeDesc
-				break
-			}
-		}
+		limitDesc := limitDescriptionForMeasurementId(limitDescriptions, *elParamDesc.MeasurementId)
 
 		if limitDesc == nil || limitDesc.LimitId == nil {
 			continue
@@ -179,6 +426,12 @@ func WriteLoadControlLimits(
 			IsLimitActive: eebusutil.Ptr(phaseLimit.IsActive),
 			Value:         model.NewScaledNumberType(limit),
 		}
+		if phaseLimit.Duration > 0 {
+			// the limit expires automatically on the remote side after the duration
+			newLimit.TimePeriod = &model.TimePeriodType{
+				EndTime: model.NewAbsoluteOrRelativeTimeTypeFromDuration(phaseLimit.Duration),
+			}
+		}
 		limitData = append(limitData, newLimit)
 	}
 
@@ -186,6 +439,8 @@ func WriteLoadControlLimits(
 	if err != nil {
 		return nil, eebusapi.ErrDataNotAvailable
 	}
+	// the list shares its items with the data of the remote feature
+	currentLimits = slices.Clone(currentLimits)
 
 	for index, limit := range currentLimits {
 		if limit.LimitId == nil {
@@ -193,7 +448,7 @@ func WriteLoadControlLimits(
 		}
 
 		for _, newLimit := range limitData {
-			if newLimit.LimitId != limit.LimitId {
+			if newLimit.LimitId == nil || *newLimit.LimitId != *limit.LimitId {
 				continue
 			}
 
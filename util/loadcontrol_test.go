@@ -2,24 +2,27 @@ package util
 
 import (
 	"testing"
+	"time"
 
 	"github.com/enbility/cemd/api"
 	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
 	"github.com/stretchr/testify/assert"
 )
 
 func (s *UtilSuite) Test_LoadControlLimits() {
+	limitTimes := NewLoadControlLimitTimes(nil)
 	var data []float64
 	var err error
 	category := model.LoadControlCategoryTypeObligation
 	entityTypes := []model.EntityTypeType{model.EntityTypeTypeEV}
 
-	data, err = LoadControlLimits(s.service, s.mockRemoteEntity, entityTypes, category)
+	data, err = LoadControlLimits(s.service, s.mockRemoteEntity, entityTypes, category, limitTimes)
 	assert.NotNil(s.T(), err)
 	assert.Nil(s.T(), data)
 
-	data, err = LoadControlLimits(s.service, s.monitoredEntity, entityTypes, category)
+	data, err = LoadControlLimits(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
 	assert.NotNil(s.T(), err)
 	assert.Nil(s.T(), data)
 
@@ -47,7 +50,7 @@ func (s *UtilSuite) Test_LoadControlLimits() {
 	fErr := rFeature.UpdateData(model.FunctionTypeLoadControlLimitDescriptionListData, descData, nil, nil)
 	assert.Nil(s.T(), fErr)
 
-	data, err = LoadControlLimits(s.service, s.monitoredEntity, entityTypes, category)
+	data, err = LoadControlLimits(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), []float64{0.0, 0.0, 0.0}, data)
 
@@ -78,7 +81,7 @@ func (s *UtilSuite) Test_LoadControlLimits() {
 	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, paramData, nil, nil)
 	assert.Nil(s.T(), fErr)
 
-	data, err = LoadControlLimits(s.service, s.monitoredEntity, entityTypes, category)
+	data, err = LoadControlLimits(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
 	assert.NotNil(s.T(), err)
 	assert.Nil(s.T(), data)
 
@@ -101,7 +104,7 @@ func (s *UtilSuite) Test_LoadControlLimits() {
 	fErr = rFeature.UpdateData(model.FunctionTypeLoadControlLimitListData, limitData, nil, nil)
 	assert.Nil(s.T(), fErr)
 
-	data, err = LoadControlLimits(s.service, s.monitoredEntity, entityTypes, category)
+	data, err = LoadControlLimits(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
 	assert.NotNil(s.T(), err)
 	assert.Nil(s.T(), data)
 
@@ -130,11 +133,300 @@ func (s *UtilSuite) Test_LoadControlLimits() {
 	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionPermittedValueSetListData, permData, nil, nil)
 	assert.Nil(s.T(), fErr)
 
-	data, err = LoadControlLimits(s.service, s.monitoredEntity, entityTypes, category)
+	data, err = LoadControlLimits(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), []float64{16.0, 16.0, 16.0}, data)
 }
 
+func (s *UtilSuite) Test_LoadControlLimitDetails() {
+	limitTimes := NewLoadControlLimitTimes(nil)
+	category := model.LoadControlCategoryTypeObligation
+	entityTypes := []model.EntityTypeType{model.EntityTypeTypeEV}
+
+	data, err := LoadControlLimitDetails(s.service, s.mockRemoteEntity, entityTypes, category, limitTimes)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	data, err = LoadControlLimitDetails(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	descData := &model.LoadControlLimitDescriptionListDataType{
+		LoadControlLimitDescriptionData: []model.LoadControlLimitDescriptionDataType{
+			{
+				LimitId:       eebusutil.Ptr(model.LoadControlLimitIdType(0)),
+				LimitCategory: eebusutil.Ptr(category),
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+			},
+			{
+				LimitId:       eebusutil.Ptr(model.LoadControlLimitIdType(1)),
+				LimitCategory: eebusutil.Ptr(category),
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(1)),
+			},
+			{
+				LimitId:       eebusutil.Ptr(model.LoadControlLimitIdType(2)),
+				LimitCategory: eebusutil.Ptr(category),
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(2)),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.monitoredEntity, model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+	fErr := rFeature.UpdateData(model.FunctionTypeLoadControlLimitDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = LoadControlLimitDetails(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	paramData := &model.ElectricalConnectionParameterDescriptionListDataType{
+		ElectricalConnectionParameterDescriptionData: []model.ElectricalConnectionParameterDescriptionDataType{
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				ParameterId:            eebusutil.Ptr(model.ElectricalConnectionParameterIdType(0)),
+				MeasurementId:          eebusutil.Ptr(model.MeasurementIdType(0)),
+				AcMeasuredPhases:       eebusutil.Ptr(model.ElectricalConnectionPhaseNameTypeA),
+			},
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				ParameterId:            eebusutil.Ptr(model.ElectricalConnectionParameterIdType(1)),
+				MeasurementId:          eebusutil.Ptr(model.MeasurementIdType(1)),
+				AcMeasuredPhases:       eebusutil.Ptr(model.ElectricalConnectionPhaseNameTypeB),
+			},
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				ParameterId:            eebusutil.Ptr(model.ElectricalConnectionParameterIdType(2)),
+				MeasurementId:          eebusutil.Ptr(model.MeasurementIdType(2)),
+				AcMeasuredPhases:       eebusutil.Ptr(model.ElectricalConnectionPhaseNameTypeC),
+			},
+		},
+	}
+
+	rElFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.monitoredEntity, model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, paramData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = LoadControlLimitDetails(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	limitData := &model.LoadControlLimitListDataType{
+		LoadControlLimitData: []model.LoadControlLimitDataType{
+			{
+				LimitId:       eebusutil.Ptr(model.LoadControlLimitIdType(0)),
+				IsLimitActive: eebusutil.Ptr(true),
+				Value:         model.NewScaledNumberType(10),
+				TimePeriod: &model.TimePeriodType{
+					EndTime: model.NewAbsoluteOrRelativeTimeTypeFromTime(time.Now().Add(time.Minute * 15)),
+				},
+			},
+			{
+				LimitId:       eebusutil.Ptr(model.LoadControlLimitIdType(1)),
+				IsLimitActive: eebusutil.Ptr(true),
+				Value:         model.NewScaledNumberType(10),
+				TimePeriod: &model.TimePeriodType{
+					EndTime: model.NewAbsoluteOrRelativeTimeTypeFromTime(time.Now().Add(-time.Minute)),
+				},
+			},
+			{
+				LimitId:           eebusutil.Ptr(model.LoadControlLimitIdType(2)),
+				IsLimitChangeable: eebusutil.Ptr(false),
+				IsLimitActive:     eebusutil.Ptr(false),
+				Value:             model.NewScaledNumberType(16),
+			},
+		},
+	}
+
+	fErr = rFeature.UpdateData(model.FunctionTypeLoadControlLimitListData, limitData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	permData := &model.ElectricalConnectionPermittedValueSetListDataType{
+		ElectricalConnectionPermittedValueSetData: []model.ElectricalConnectionPermittedValueSetDataType{
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				ParameterId:            eebusutil.Ptr(model.ElectricalConnectionParameterIdType(0)),
+				PermittedValueSet: []model.ScaledNumberSetType{
+					{
+						Value: []model.ScaledNumberType{
+							*model.NewScaledNumberType(0.1),
+						},
+						Range: []model.ScaledNumberRangeType{
+							{
+								Min: model.NewScaledNumberType(6),
+								Max: model.NewScaledNumberType(16),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionPermittedValueSetListData, permData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = LoadControlLimitDetails(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(data))
+
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeA, data[0].Phase)
+	assert.Equal(s.T(), true, data[0].IsActive)
+	assert.Equal(s.T(), 10.0, data[0].Value)
+	assert.True(s.T(), data[0].Duration > time.Minute*14 && data[0].Duration <= time.Minute*15)
+	assert.Equal(s.T(), true, data[0].IsChangeable)
+	assert.Equal(s.T(), 6.0, data[0].Min)
+	assert.Equal(s.T(), 16.0, data[0].Max)
+	assert.Equal(s.T(), 0.1, data[0].Default)
+	assert.True(s.T(), data[0].StartTime.IsZero())
+	assert.False(s.T(), data[0].EndTime.IsZero())
+
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeB, data[1].Phase)
+	assert.Equal(s.T(), false, data[1].IsActive)
+	assert.Equal(s.T(), 10.0, data[1].Value)
+	assert.Equal(s.T(), time.Duration(0), data[1].Duration)
+
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeC, data[2].Phase)
+	assert.Equal(s.T(), false, data[2].IsActive)
+	assert.Equal(s.T(), 16.0, data[2].Value)
+	assert.Equal(s.T(), time.Duration(0), data[2].Duration)
+	assert.Equal(s.T(), false, data[2].IsChangeable)
+	assert.Equal(s.T(), 0.0, data[2].Max)
+	assert.True(s.T(), data[2].EndTime.IsZero())
+}
+
+func (s *UtilSuite) Test_LoadControlLimitDetailsRelativeEndTime() {
+	category := model.LoadControlCategoryTypeObligation
+	entityTypes := []model.EntityTypeType{model.EntityTypeTypeEV}
+
+	now := time.Now()
+	limitTimes := NewLoadControlLimitTimes(func() time.Time { return now })
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.monitoredEntity, model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+	fErr := rFeature.UpdateData(model.FunctionTypeLoadControlLimitDescriptionListData, &model.LoadControlLimitDescriptionListDataType{
+		LoadControlLimitDescriptionData: []model.LoadControlLimitDescriptionDataType{
+			{
+				LimitId:       eebusutil.Ptr(model.LoadControlLimitIdType(0)),
+				LimitCategory: eebusutil.Ptr(category),
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+			},
+		},
+	}, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	rElFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.monitoredEntity, model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, &model.ElectricalConnectionParameterDescriptionListDataType{
+		ElectricalConnectionParameterDescriptionData: []model.ElectricalConnectionParameterDescriptionDataType{
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				ParameterId:            eebusutil.Ptr(model.ElectricalConnectionParameterIdType(0)),
+				MeasurementId:          eebusutil.Ptr(model.MeasurementIdType(0)),
+				AcMeasuredPhases:       eebusutil.Ptr(model.ElectricalConnectionPhaseNameTypeA),
+			},
+		},
+	}, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	fErr = rElFeature.UpdateData(model.FunctionTypeElectricalConnectionPermittedValueSetListData, &model.ElectricalConnectionPermittedValueSetListDataType{
+		ElectricalConnectionPermittedValueSetData: []model.ElectricalConnectionPermittedValueSetDataType{
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				ParameterId:            eebusutil.Ptr(model.ElectricalConnectionParameterIdType(0)),
+				PermittedValueSet: []model.ScaledNumberSetType{
+					{
+						Range: []model.ScaledNumberRangeType{
+							{
+								Min: model.NewScaledNumberType(6),
+								Max: model.NewScaledNumberType(32),
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	limitData := &model.LoadControlLimitListDataType{
+		LoadControlLimitData: []model.LoadControlLimitDataType{
+			{
+				LimitId:       eebusutil.Ptr(model.LoadControlLimitIdType(0)),
+				IsLimitActive: eebusutil.Ptr(true),
+				Value:         model.NewScaledNumberType(10),
+				TimePeriod: &model.TimePeriodType{
+					EndTime: model.NewAbsoluteOrRelativeTimeTypeFromDuration(time.Minute * 15),
+				},
+			},
+		},
+	}
+	fErr = rFeature.UpdateData(model.FunctionTypeLoadControlLimitListData, limitData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	payload := spineapi.EventPayload{
+		Ski:        remoteSki,
+		EventType:  spineapi.EventTypeDataChange,
+		ChangeType: spineapi.ElementChangeUpdate,
+		Device:     s.remoteDevice,
+		Entity:     s.monitoredEntity,
+		Data:       limitData,
+	}
+	limitTimes.HandleEvent(payload)
+
+	data, err := LoadControlLimitDetails(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), true, data[0].IsActive)
+	assert.Equal(s.T(), time.Minute*15, data[0].Duration)
+	assert.Equal(s.T(), now.Add(time.Minute*15), data[0].EndTime)
+
+	values, err := LoadControlLimits(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{10, 0, 0}, values)
+
+	// the remaining duration counts down from the receive time
+	now = now.Add(time.Minute * 10)
+	data, err = LoadControlLimitDetails(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, data[0].IsActive)
+	assert.Equal(s.T(), time.Minute*5, data[0].Duration)
+
+	now = now.Add(time.Minute * 6)
+	data, err = LoadControlLimitDetails(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), false, data[0].IsActive)
+	assert.Equal(s.T(), time.Duration(0), data[0].Duration)
+
+	// an expired limit reports the maximum permitted value like an inactive limit
+	values, err = LoadControlLimits(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{32, 0, 0}, values)
+
+	// the receive times of other instances are independent
+	otherTimes := NewLoadControlLimitTimes(func() time.Time { return now })
+	data, err = LoadControlLimitDetails(s.service, s.monitoredEntity, entityTypes, category, otherTimes)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, data[0].IsActive)
+	assert.Equal(s.T(), time.Minute*15, data[0].Duration)
+
+	// receiving the limit again restarts the duration
+	limitTimes.HandleEvent(payload)
+	data, err = LoadControlLimitDetails(s.service, s.monitoredEntity, entityTypes, category, limitTimes)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, data[0].IsActive)
+	assert.Equal(s.T(), time.Minute*15, data[0].Duration)
+
+	// the receive times are removed with the device
+	limitTimes.HandleEvent(spineapi.EventPayload{
+		Ski:        remoteSki,
+		EventType:  spineapi.EventTypeDeviceChange,
+		ChangeType: spineapi.ElementChangeRemove,
+		Device:     s.remoteDevice,
+	})
+	limitTimes.mux.Lock()
+	_, found := limitTimes.times[s.monitoredEntity]
+	limitTimes.mux.Unlock()
+	assert.False(s.T(), found)
+}
+
 func (s *UtilSuite) Test_WriteLoadControlLimits() {
 	loadLimits := []api.LoadLimitsPhase{}
 
@@ -358,7 +650,97 @@ func (s *UtilSuite) Test_WriteLoadControlLimits() {
 				msgCounter, err = WriteLoadControlLimits(s.service, s.monitoredEntity, entityTypes, category, phaseLimitValues)
 				assert.Nil(t, err)
 				assert.NotNil(t, msgCounter)
+
+				for index := range phaseLimitValues {
+					phaseLimitValues[index].Duration = time.Minute * 15
+				}
+				msgCounter, err = WriteLoadControlLimits(s.service, s.monitoredEntity, entityTypes, category, phaseLimitValues)
+				assert.Nil(t, err)
+				assert.NotNil(t, msgCounter)
 			}
 		})
 	}
 }
+
+func (s *UtilSuite) Test_WriteLoadControlLimitsKeepsRemoteData() {
+	category := model.LoadControlCategoryTypeObligation
+	entityTypes := []model.EntityTypeType{model.EntityTypeTypeEV}
+
+	paramData := &model.ElectricalConnectionParameterDescriptionListDataType{
+		ElectricalConnectionParameterDescriptionData: []model.ElectricalConnectionParameterDescriptionDataType{
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				ParameterId:            eebusutil.Ptr(model.ElectricalConnectionParameterIdType(0)),
+				MeasurementId:          eebusutil.Ptr(model.MeasurementIdType(0)),
+				AcMeasuredPhases:       eebusutil.Ptr(model.ElectricalConnectionPhaseNameTypeA),
+			},
+		},
+	}
+	permData := &model.ElectricalConnectionPermittedValueSetListDataType{
+		ElectricalConnectionPermittedValueSetData: []model.ElectricalConnectionPermittedValueSetDataType{
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				ParameterId:            eebusutil.Ptr(model.ElectricalConnectionParameterIdType(0)),
+				PermittedValueSet: []model.ScaledNumberSetType{
+					{
+						Range: []model.ScaledNumberRangeType{
+							{
+								Min: model.NewScaledNumberType(6),
+								Max: model.NewScaledNumberType(16),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.monitoredEntity, model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	fErr := rFeature.UpdateData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, paramData, nil, nil)
+	assert.Nil(s.T(), fErr)
+	fErr = rFeature.UpdateData(model.FunctionTypeElectricalConnectionPermittedValueSetListData, permData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	descData := &model.LoadControlLimitDescriptionListDataType{
+		LoadControlLimitDescriptionData: []model.LoadControlLimitDescriptionDataType{
+			{
+				LimitId:       eebusutil.Ptr(model.LoadControlLimitIdType(0)),
+				LimitCategory: eebusutil.Ptr(model.LoadControlCategoryTypeObligation),
+				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+			},
+		},
+	}
+	limitListData := &model.LoadControlLimitListDataType{
+		LoadControlLimitData: []model.LoadControlLimitDataType{
+			{
+				LimitId:           eebusutil.Ptr(model.LoadControlLimitIdType(0)),
+				IsLimitChangeable: eebusutil.Ptr(true),
+				IsLimitActive:     eebusutil.Ptr(false),
+				Value:             model.NewScaledNumberType(16),
+			},
+		},
+	}
+
+	rFeature = s.remoteDevice.FeatureByEntityTypeAndRole(s.monitoredEntity, model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+	fErr = rFeature.UpdateData(model.FunctionTypeLoadControlLimitDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+	fErr = rFeature.UpdateData(model.FunctionTypeLoadControlLimitListData, limitListData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	loadLimits := []api.LoadLimitsPhase{
+		{
+			Phase:    model.ElectricalConnectionPhaseNameTypeA,
+			IsActive: true,
+			Value:    10,
+		},
+	}
+	msgCounter, err := WriteLoadControlLimits(s.service, s.monitoredEntity, entityTypes, category, loadLimits)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), msgCounter)
+
+	// the written limits are only known after the remote device reported them
+	data := rFeature.DataCopy(model.FunctionTypeLoadControlLimitListData).(*model.LoadControlLimitListDataType)
+	assert.Equal(s.T(), 1, len(data.LoadControlLimitData))
+	assert.False(s.T(), *data.LoadControlLimitData[0].IsLimitActive)
+	assert.Equal(s.T(), 16.0, data.LoadControlLimitData[0].Value.GetValue())
+}
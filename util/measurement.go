@@ -2,7 +2,9 @@ package util
 
 import (
 	"slices"
+	"time"
 
+	"github.com/enbility/cemd/api"
 	eebusapi "github.com/enbility/eebus-go/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
@@ -43,6 +45,34 @@ func MeasurementValuesForTypeCommodityScope(
 	energyDirection model.EnergyDirectionType,
 	validPhaseNameTypes []model.ElectricalConnectionPhaseNameType,
 ) ([]float64, error) {
+	data, err := MeasurementDataForTypeCommodityScope(
+		service, entity, measurementType, commodityType, scopeType, energyDirection, validPhaseNameTypes)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	var result []float64
+
+	for _, item := range data {
+		result = append(result, item.Value.GetValue())
+	}
+
+	return result, nil
+}
+
+// return the measurement data items with a value for the given filters
+//
+// the items are filtered by the phases, if validPhaseNameTypes is not nil, and
+// by the energy direction, if energyDirection is not empty
+func MeasurementDataForTypeCommodityScope(
+	service eebusapi.ServiceInterface,
+	entity spineapi.EntityRemoteInterface,
+	measurementType model.MeasurementTypeType,
+	commodityType model.CommodityTypeType,
+	scopeType model.ScopeTypeType,
+	energyDirection model.EnergyDirectionType,
+	validPhaseNameTypes []model.ElectricalConnectionPhaseNameType,
+) ([]model.MeasurementDataType, error) {
 
 	measurement := measurementType
 	commodity := commodityType
@@ -57,7 +87,7 @@ func MeasurementValuesForTypeCommodityScope(
 		return nil, err
 	}
 
-	var result []float64
+	var result []model.MeasurementDataType
 
 	for _, item := range data {
 		if item.Value == nil || item.MeasurementId == nil {
@@ -85,14 +115,98 @@ func MeasurementValuesForTypeCommodityScope(
 			}
 		}
 
-		value := item.Value.GetValue()
+		result = append(result, item)
+	}
+
+	return result, nil
+}
 
-		result = append(result, value)
+// return the measurement results of each phase for the given filters, ordered by the phase
+//
+// the items are filtered by the energy direction, if energyDirection is not empty
+//
+// parameters:
+//   - staleThreshold: the age after which a value is considered stale, 0 disables the check
+func MeasurementPhaseResultsForTypeCommodityScope(
+	service eebusapi.ServiceInterface,
+	entity spineapi.EntityRemoteInterface,
+	measurementType model.MeasurementTypeType,
+	commodityType model.CommodityTypeType,
+	scopeType model.ScopeTypeType,
+	energyDirection model.EnergyDirectionType,
+	staleThreshold time.Duration,
+) ([]api.MeasurementResult, error) {
+	data, err := MeasurementDataForTypeCommodityScope(
+		service, entity, measurementType, commodityType, scopeType, energyDirection, PhaseNameMapping)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	electricalConnection, err := ElectricalConnection(service, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []api.MeasurementResult
+
+	for _, phase := range PhaseNameMapping {
+		for _, item := range data {
+			param, err := electricalConnection.GetParameterDescriptionForMeasurementId(*item.MeasurementId)
+			if err != nil || param.AcMeasuredPhases == nil || *param.AcMeasuredPhases != phase {
+				continue
+			}
+
+			value := MeasurementResultForData(item, staleThreshold)
+			value.Phase = phase
+			result = append(result, value)
+		}
 	}
 
 	return result, nil
 }
 
+// return the values of measurement results
+func MeasurementResultValues(results []api.MeasurementResult) []float64 {
+	var values []float64
+	for _, item := range results {
+		values = append(values, item.Value)
+	}
+
+	return values
+}
+
+// return the value of a measurement data item including its metadata
+//
+// parameters:
+//   - item: the measurement data item, the value has to be set
+//   - staleThreshold: the age after which the value is considered stale, 0 disables the check
+func MeasurementResultForData(item model.MeasurementDataType, staleThreshold time.Duration) api.MeasurementResult {
+	result := api.MeasurementResult{
+		ValueState: model.MeasurementValueStateTypeNormal,
+	}
+
+	if item.Value != nil {
+		result.Value = item.Value.GetValue()
+	}
+
+	if item.Timestamp != nil {
+		if timestamp, err := item.Timestamp.GetTime(); err == nil {
+			result.Timestamp = timestamp
+			result.IsStale = staleThreshold > 0 && time.Since(timestamp) > staleThreshold
+		}
+	}
+
+	if item.ValueState != nil {
+		result.ValueState = *item.ValueState
+	}
+
+	if item.ValueSource != nil {
+		result.ValueSource = *item.ValueSource
+	}
+
+	return result
+}
+
 func GetValuesForTypeCommodityScope(
 	service eebusapi.ServiceInterface,
 	entity spineapi.EntityRemoteInterface,
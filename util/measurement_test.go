@@ -1,6 +1,8 @@
 package util
 
 import (
+	"time"
+
 	eebusutil "github.com/enbility/eebus-go/util"
 	"github.com/enbility/spine-go/model"
 	"github.com/stretchr/testify/assert"
@@ -199,3 +201,35 @@ func (s *UtilSuite) Test_MeasurementValuesForTypeCommodityScope() {
 	assert.Equal(s.T(), []float64{10, 10, 10}, data)
 
 }
+
+func (s *UtilSuite) Test_MeasurementResultForData() {
+	item := model.MeasurementDataType{
+		MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+		Value:         model.NewScaledNumberType(80),
+	}
+
+	result := MeasurementResultForData(item, time.Minute)
+	assert.Equal(s.T(), 80.0, result.Value)
+	assert.True(s.T(), result.Timestamp.IsZero())
+	assert.Equal(s.T(), model.MeasurementValueStateTypeNormal, result.ValueState)
+	assert.Equal(s.T(), model.MeasurementValueSourceType(""), result.ValueSource)
+	assert.False(s.T(), result.IsStale)
+
+	timestamp := time.Now().Add(-2 * time.Minute).UTC()
+	item.Timestamp = model.NewAbsoluteOrRelativeTimeTypeFromTime(timestamp)
+	item.ValueState = eebusutil.Ptr(model.MeasurementValueStateTypeOutofrange)
+	item.ValueSource = eebusutil.Ptr(model.MeasurementValueSourceTypeCalculatedValue)
+
+	result = MeasurementResultForData(item, time.Minute)
+	assert.Equal(s.T(), 80.0, result.Value)
+	assert.Equal(s.T(), timestamp.Unix(), result.Timestamp.Unix())
+	assert.Equal(s.T(), model.MeasurementValueStateTypeOutofrange, result.ValueState)
+	assert.Equal(s.T(), model.MeasurementValueSourceTypeCalculatedValue, result.ValueSource)
+	assert.True(s.T(), result.IsStale)
+
+	result = MeasurementResultForData(item, 5*time.Minute)
+	assert.False(s.T(), result.IsStale)
+
+	result = MeasurementResultForData(item, 0)
+	assert.False(s.T(), result.IsStale)
+}
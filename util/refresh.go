@@ -0,0 +1,97 @@
+package util
+
+import (
+	"github.com/enbility/cemd/api"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// the functions with descriptions, constraints and values of the feature types used by the usecases
+var refreshFunctions = map[model.FeatureTypeType][]model.FunctionType{
+	model.FeatureTypeTypeDeviceClassification: {
+		model.FunctionTypeDeviceClassificationManufacturerData,
+	},
+	model.FeatureTypeTypeDeviceConfiguration: {
+		model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData,
+		model.FunctionTypeDeviceConfigurationKeyValueConstraintsListData,
+		model.FunctionTypeDeviceConfigurationKeyValueListData,
+	},
+	model.FeatureTypeTypeDeviceDiagnosis: {
+		model.FunctionTypeDeviceDiagnosisStateData,
+	},
+	model.FeatureTypeTypeElectricalConnection: {
+		model.FunctionTypeElectricalConnectionDescriptionListData,
+		model.FunctionTypeElectricalConnectionParameterDescriptionListData,
+		model.FunctionTypeElectricalConnectionPermittedValueSetListData,
+	},
+	model.FeatureTypeTypeIdentification: {
+		model.FunctionTypeIdentificationListData,
+	},
+	model.FeatureTypeTypeIncentiveTable: {
+		model.FunctionTypeIncentiveTableDescriptionData,
+		model.FunctionTypeIncentiveTableConstraintsData,
+		model.FunctionTypeIncentiveTableData,
+	},
+	model.FeatureTypeTypeLoadControl: {
+		model.FunctionTypeLoadControlLimitDescriptionListData,
+		model.FunctionTypeLoadControlLimitConstraintsListData,
+		model.FunctionTypeLoadControlLimitListData,
+	},
+	model.FeatureTypeTypeMeasurement: {
+		model.FunctionTypeMeasurementDescriptionListData,
+		model.FunctionTypeMeasurementConstraintsListData,
+		model.FunctionTypeMeasurementListData,
+	},
+	model.FeatureTypeTypeTimeSeries: {
+		model.FunctionTypeTimeSeriesDescriptionListData,
+		model.FunctionTypeTimeSeriesConstraintsListData,
+		model.FunctionTypeTimeSeriesListData,
+	},
+}
+
+// request the descriptions, constraints and values of features of a remote entity
+//
+// only functions the remote feature allows to read are requested,
+// a feature without any readable function is reported with ErrOperationOnFunctionNotSupported
+func RefreshFeatures(
+	service eebusapi.ServiceInterface,
+	entity spineapi.EntityRemoteInterface,
+	features []model.FeatureTypeType) []api.RefreshResult {
+	var result []api.RefreshResult
+
+	for _, feature := range features {
+		item := api.RefreshResult{
+			Feature: feature,
+		}
+
+		_, featureRemote, err := localAndRemoteFeatures(service, entity, feature)
+		if err != nil {
+			item.Error = err
+			result = append(result, item)
+			continue
+		}
+
+		operations := featureRemote.Operations()
+		for _, function := range refreshFunctions[feature] {
+			if op, ok := operations[function]; !ok || !op.Read() {
+				continue
+			}
+
+			if _, err := RequestData(service, entity, DataRequest{Feature: feature, Function: function}); err != nil {
+				item.Error = err
+				break
+			}
+
+			item.Functions = append(item.Functions, function)
+		}
+
+		if item.Error == nil && len(item.Functions) == 0 {
+			item.Error = eebusapi.ErrOperationOnFunctionNotSupported
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
@@ -0,0 +1,46 @@
+package util
+
+import (
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UtilSuite) Test_RefreshFeatures() {
+	features := []model.FeatureTypeType{
+		model.FeatureTypeTypeLoadControl,
+		model.FeatureTypeTypeElectricalConnection,
+		model.FeatureTypeTypeMeasurement,
+		model.FeatureTypeTypeDeviceConfiguration,
+	}
+
+	result := RefreshFeatures(s.service, s.monitoredEntity, features)
+	assert.Equal(s.T(), 4, len(result))
+
+	assert.Equal(s.T(), model.FeatureTypeTypeLoadControl, result[0].Feature)
+	assert.Nil(s.T(), result[0].Error)
+	assert.Equal(s.T(), []model.FunctionType{
+		model.FunctionTypeLoadControlLimitDescriptionListData,
+		model.FunctionTypeLoadControlLimitConstraintsListData,
+		model.FunctionTypeLoadControlLimitListData,
+	}, result[0].Functions)
+
+	// the remote feature does not provide the descriptions
+	assert.Nil(s.T(), result[1].Error)
+	assert.Equal(s.T(), []model.FunctionType{
+		model.FunctionTypeElectricalConnectionParameterDescriptionListData,
+		model.FunctionTypeElectricalConnectionPermittedValueSetListData,
+	}, result[1].Functions)
+
+	assert.Nil(s.T(), result[2].Error)
+	assert.Equal(s.T(), 2, len(result[2].Functions))
+
+	assert.Equal(s.T(), model.FeatureTypeTypeDeviceConfiguration, result[3].Feature)
+	assert.ErrorIs(s.T(), result[3].Error, eebusapi.ErrFunctionNotSupported)
+	assert.Nil(s.T(), result[3].Functions)
+
+	// the EVSE entity does not provide any of the features
+	result = RefreshFeatures(s.service, s.evseEntity, features[:1])
+	assert.Equal(s.T(), 1, len(result))
+	assert.ErrorIs(s.T(), result[0].Error, eebusapi.ErrFunctionNotSupported)
+}
@@ -0,0 +1,197 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/enbility/cemd/api"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Defines a read request for the data of a function of a remote server feature
+type DataRequest struct {
+	Feature  model.FeatureTypeType
+	Function model.FunctionType
+}
+
+// the read requests used by the use cases
+var (
+	DeviceClassificationManufacturerRequest = DataRequest{
+		Feature:  model.FeatureTypeTypeDeviceClassification,
+		Function: model.FunctionTypeDeviceClassificationManufacturerData,
+	}
+	DeviceConfigurationKeyValuesRequest = DataRequest{
+		Feature:  model.FeatureTypeTypeDeviceConfiguration,
+		Function: model.FunctionTypeDeviceConfigurationKeyValueListData,
+	}
+	DeviceDiagnosisStateRequest = DataRequest{
+		Feature:  model.FeatureTypeTypeDeviceDiagnosis,
+		Function: model.FunctionTypeDeviceDiagnosisStateData,
+	}
+	ElectricalConnectionDescriptionsRequest = DataRequest{
+		Feature:  model.FeatureTypeTypeElectricalConnection,
+		Function: model.FunctionTypeElectricalConnectionDescriptionListData,
+	}
+	ElectricalConnectionPermittedValuesRequest = DataRequest{
+		Feature:  model.FeatureTypeTypeElectricalConnection,
+		Function: model.FunctionTypeElectricalConnectionPermittedValueSetListData,
+	}
+	IdentificationValuesRequest = DataRequest{
+		Feature:  model.FeatureTypeTypeIdentification,
+		Function: model.FunctionTypeIdentificationListData,
+	}
+	IncentiveTableConstraintsRequest = DataRequest{
+		Feature:  model.FeatureTypeTypeIncentiveTable,
+		Function: model.FunctionTypeIncentiveTableConstraintsData,
+	}
+	LoadControlLimitValuesRequest = DataRequest{
+		Feature:  model.FeatureTypeTypeLoadControl,
+		Function: model.FunctionTypeLoadControlLimitListData,
+	}
+	MeasurementValuesRequest = DataRequest{
+		Feature:  model.FeatureTypeTypeMeasurement,
+		Function: model.FunctionTypeMeasurementListData,
+	}
+	TimeSeriesConstraintsRequest = DataRequest{
+		Feature:  model.FeatureTypeTypeTimeSeries,
+		Function: model.FunctionTypeTimeSeriesConstraintsListData,
+	}
+	TimeSeriesValuesRequest = DataRequest{
+		Feature:  model.FeatureTypeTypeTimeSeries,
+		Function: model.FunctionTypeTimeSeriesListData,
+	}
+)
+
+// return the local client feature and the remote server feature for a feature type
+func localAndRemoteFeatures(
+	service eebusapi.ServiceInterface,
+	entity spineapi.EntityRemoteInterface,
+	featureType model.FeatureTypeType) (spineapi.FeatureLocalInterface, spineapi.FeatureRemoteInterface, error) {
+	if entity == nil || entity.Device() == nil {
+		return nil, nil, api.ErrNoCompatibleEntity
+	}
+
+	localEntity := localCemEntity(service)
+	if localEntity == nil {
+		return nil, nil, eebusapi.ErrFunctionNotSupported
+	}
+
+	featureLocal := localEntity.FeatureOfTypeAndRole(featureType, model.RoleTypeClient)
+	if featureLocal == nil {
+		featureLocal = localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeGeneric, model.RoleTypeClient)
+	}
+
+	featureRemote := entity.Device().FeatureByEntityTypeAndRole(entity, featureType, model.RoleTypeServer)
+
+	if featureLocal == nil || featureRemote == nil {
+		return nil, nil, eebusapi.ErrFunctionNotSupported
+	}
+
+	return featureLocal, featureRemote, nil
+}
+
+// bind to a remote server feature, which is required to write its data
+//
+// nothing is sent if the binding already exists, e.g. as another use case
+// writing to the same feature already requested it
+func Bind(
+	service eebusapi.ServiceInterface,
+	entity spineapi.EntityRemoteInterface,
+	featureType model.FeatureTypeType) error {
+	featureLocal, featureRemote, err := localAndRemoteFeatures(service, entity, featureType)
+	if err != nil {
+		return err
+	}
+
+	if featureLocal.HasBindingToRemote(featureRemote.Address()) {
+		return nil
+	}
+
+	if _, fErr := featureLocal.BindToRemote(featureRemote.Address()); fErr != nil {
+		return errors.New(fErr.String())
+	}
+
+	return nil
+}
+
+// send a read request for the data of a function of a remote server feature
+//
+// possible errors:
+//   - ErrFunctionNotSupported if the remote entity does not provide the feature or function
+//   - ErrOperationOnFunctionNotSupported if the function can not be read
+//   - and others
+func RequestData(
+	service eebusapi.ServiceInterface,
+	entity spineapi.EntityRemoteInterface,
+	request DataRequest) (*model.MsgCounterType, error) {
+	featureLocal, featureRemote, err := localAndRemoteFeatures(service, entity, request.Feature)
+	if err != nil {
+		return nil, err
+	}
+
+	operations, ok := featureRemote.Operations()[request.Function]
+	if !ok {
+		return nil, eebusapi.ErrFunctionNotSupported
+	}
+	if !operations.Read() {
+		return nil, eebusapi.ErrOperationOnFunctionNotSupported
+	}
+
+	msgCounter, fErr := featureLocal.RequestRemoteData(request.Function, nil, nil, featureRemote)
+	if fErr != nil {
+		return nil, errors.New(fErr.String())
+	}
+
+	return msgCounter, nil
+}
+
+// wait for the reply to a read request sent with RequestData until the context is done
+//
+// the reply is stored in the remote feature before this returns,
+// a reply not arriving within the maximum response delay of the remote feature is returned as an error
+func WaitForReply(
+	ctx context.Context,
+	service eebusapi.ServiceInterface,
+	entity spineapi.EntityRemoteInterface,
+	request DataRequest,
+	msgCounter model.MsgCounterType) error {
+	featureLocal, featureRemote, err := localAndRemoteFeatures(service, entity, request.Feature)
+	if err != nil {
+		return err
+	}
+
+	// spine-go can't cancel the wait for a reply, but it reports a timeout after the
+	// maximum response delay, so the goroutine ends at the latest then
+	reply := make(chan error, 1)
+	go func() {
+		if _, fErr := featureLocal.FetchRequestRemoteData(msgCounter, featureRemote); fErr != nil {
+			reply <- errors.New(fErr.String())
+			return
+		}
+
+		reply <- nil
+	}()
+
+	select {
+	case err := <-reply:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// return an error for a result data containing an error number
+func resultError(data *model.ResultDataType) error {
+	if data == nil || data.ErrorNumber == nil || *data.ErrorNumber == model.ErrorNumberTypeNoError {
+		return nil
+	}
+
+	if data.Description != nil {
+		return fmt.Errorf("%w: error %d: %s", api.ErrWriteRejected, *data.ErrorNumber, *data.Description)
+	}
+
+	return fmt.Errorf("%w: error %d", api.ErrWriteRejected, *data.ErrorNumber)
+}
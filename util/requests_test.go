@@ -0,0 +1,358 @@
+package util
+
+import (
+	"context"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UtilSuite) Test_RequestData() {
+	msgCounter, err := RequestData(s.service, nil, MeasurementValuesRequest)
+	assert.ErrorIs(s.T(), err, api.ErrNoCompatibleEntity)
+	assert.Nil(s.T(), msgCounter)
+
+	msgCounter, err = RequestData(s.service, s.monitoredEntity, DeviceConfigurationKeyValuesRequest)
+	assert.ErrorIs(s.T(), err, eebusapi.ErrFunctionNotSupported)
+	assert.Nil(s.T(), msgCounter)
+
+	request := DataRequest{
+		Feature:  model.FeatureTypeTypeMeasurement,
+		Function: model.FunctionTypeMeasurementConstraintsListData,
+	}
+	msgCounter, err = RequestData(s.service, s.monitoredEntity, request)
+	assert.ErrorIs(s.T(), err, eebusapi.ErrFunctionNotSupported)
+	assert.Nil(s.T(), msgCounter)
+
+	msgCounter, err = RequestData(s.service, s.monitoredEntity, MeasurementValuesRequest)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), msgCounter)
+}
+
+func (s *UtilSuite) Test_WaitForReply() {
+	msgCounter, err := RequestData(s.service, s.monitoredEntity, MeasurementValuesRequest)
+	assert.Nil(s.T(), err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+
+	err = WaitForReply(ctx, s.service, s.monitoredEntity, MeasurementValuesRequest, *msgCounter)
+	assert.ErrorIs(s.T(), err, context.DeadlineExceeded)
+
+	msgCounter, err = RequestData(s.service, s.monitoredEntity, MeasurementValuesRequest)
+	assert.Nil(s.T(), err)
+
+	featureLocal, featureRemote, err := localAndRemoteFeatures(s.service, s.monitoredEntity, model.FeatureTypeTypeMeasurement)
+	assert.Nil(s.T(), err)
+
+	go func() {
+		_ = featureLocal.HandleMessage(&spineapi.Message{
+			RequestHeader: &model.HeaderType{
+				MsgCounterReference: msgCounter,
+			},
+			CmdClassifier: model.CmdClassifierTypeReply,
+			Cmd: model.CmdType{
+				MeasurementListData: &model.MeasurementListDataType{
+					MeasurementData: []model.MeasurementDataType{
+						{
+							MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+							Value:         model.NewScaledNumberType(10),
+						},
+					},
+				},
+			},
+			FeatureRemote: featureRemote,
+			DeviceRemote:  s.remoteDevice,
+		})
+	}()
+
+	err = WaitForReply(context.Background(), s.service, s.monitoredEntity, MeasurementValuesRequest, *msgCounter)
+	assert.Nil(s.T(), err)
+
+	data, ok := featureRemote.DataCopy(model.FunctionTypeMeasurementListData).(*model.MeasurementListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 1, len(data.MeasurementData))
+}
+
+func (s *UtilSuite) Test_Bind() {
+	err := Bind(s.service, nil, model.FeatureTypeTypeLoadControl)
+	assert.ErrorIs(s.T(), err, api.ErrNoCompatibleEntity)
+
+	err = Bind(s.service, s.monitoredEntity, model.FeatureTypeTypeTimeSeries)
+	assert.ErrorIs(s.T(), err, eebusapi.ErrFunctionNotSupported)
+
+	featureLocal, featureRemote, err := localAndRemoteFeatures(s.service, s.monitoredEntity, model.FeatureTypeTypeLoadControl)
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), featureLocal.HasBindingToRemote(featureRemote.Address()))
+
+	err = Bind(s.service, s.monitoredEntity, model.FeatureTypeTypeLoadControl)
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), featureLocal.HasBindingToRemote(featureRemote.Address()))
+
+	// an existing binding is kept
+	err = Bind(s.service, s.monitoredEntity, model.FeatureTypeTypeLoadControl)
+	assert.Nil(s.T(), err)
+}
+
+func (s *UtilSuite) Test_WaitForResult() {
+	featureLocal, featureRemote, err := localAndRemoteFeatures(s.service, s.monitoredEntity, model.FeatureTypeTypeLoadControl)
+	assert.Nil(s.T(), err)
+
+	msgCounter := model.MsgCounterType(500)
+	result := &model.ResultDataType{
+		ErrorNumber: eebusutil.Ptr(model.ErrorNumberTypeCommandRejected),
+		Description: eebusutil.Ptr(model.DescriptionType("limit too high")),
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.results.WaitForResult(context.Background(), s.monitoredEntity, msgCounter)
+	}()
+
+	// the result may be sent before the callback is registered, so repeat it
+	var resultErr error
+	for resultErr == nil {
+		_ = featureLocal.HandleMessage(&spineapi.Message{
+			RequestHeader: &model.HeaderType{
+				MsgCounterReference: &msgCounter,
+			},
+			CmdClassifier: model.CmdClassifierTypeResult,
+			Cmd: model.CmdType{
+				ResultData: result,
+			},
+			FeatureRemote: featureRemote,
+			DeviceRemote:  s.remoteDevice,
+		})
+
+		select {
+		case resultErr = <-done:
+		case <-time.After(time.Millisecond * 10):
+		}
+	}
+	assert.ErrorIs(s.T(), resultErr, api.ErrWriteRejected)
+	assert.Contains(s.T(), resultErr.Error(), "limit too high")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+
+	err = s.results.WaitForResult(ctx, s.monitoredEntity, msgCounter)
+	assert.ErrorIs(s.T(), err, context.DeadlineExceeded)
+}
+
+func (s *UtilSuite) Test_ResultError() {
+	assert.Nil(s.T(), resultError(nil))
+	assert.Nil(s.T(), resultError(&model.ResultDataType{}))
+	assert.Nil(s.T(), resultError(&model.ResultDataType{
+		ErrorNumber: eebusutil.Ptr(model.ErrorNumberTypeNoError),
+	}))
+
+	err := resultError(&model.ResultDataType{
+		ErrorNumber: eebusutil.Ptr(model.ErrorNumberTypeGeneralError),
+	})
+	assert.ErrorIs(s.T(), err, api.ErrWriteRejected)
+}
+
+func (s *UtilSuite) Test_ReadWithContext() {
+	reads := 0
+	read := func() (float64, error) {
+		reads++
+		return 10, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ReadWithContext(ctx, s.service, s.monitoredEntity, api.ReadOptions{}, nil, read)
+	assert.ErrorIs(s.T(), err, context.Canceled)
+	assert.Equal(s.T(), 0, reads)
+
+	// the cached value is returned without a request
+	value, err := ReadWithContext(context.Background(), s.service, s.monitoredEntity, api.ReadOptions{}, []DataRequest{DeviceConfigurationKeyValuesRequest}, read)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 10.0, value)
+	assert.Equal(s.T(), 1, reads)
+
+	options := api.ReadOptions{Fresh: true}
+
+	_, err = ReadWithContext(context.Background(), s.service, s.monitoredEntity, options, []DataRequest{DeviceConfigurationKeyValuesRequest}, read)
+	assert.ErrorIs(s.T(), err, eebusapi.ErrFunctionNotSupported)
+
+	// no reply is sent
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+
+	_, err = ReadWithContext(ctx, s.service, s.monitoredEntity, options, []DataRequest{MeasurementValuesRequest}, read)
+	assert.ErrorIs(s.T(), err, context.DeadlineExceeded)
+
+	// an incompatible entity is not requested
+	incompatible := func() (float64, error) {
+		return 0, api.ErrNoCompatibleEntity
+	}
+	_, err = ReadWithContext(context.Background(), s.service, nil, options, []DataRequest{MeasurementValuesRequest}, incompatible)
+	assert.ErrorIs(s.T(), err, api.ErrNoCompatibleEntity)
+}
+
+func (s *UtilSuite) Test_WriteWithContext() {
+	writes := 0
+	write := func() (*model.MsgCounterType, error) {
+		writes++
+		return eebusutil.Ptr(model.MsgCounterType(600)), nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	msgCounter, err := WriteWithContext(ctx, s.service, s.monitoredEntity, model.FeatureTypeTypeLoadControl, api.WriteOptions{}, s.results, write)
+	assert.ErrorIs(s.T(), err, context.Canceled)
+	assert.Nil(s.T(), msgCounter)
+	assert.Equal(s.T(), 0, writes)
+
+	msgCounter, err = WriteWithContext(context.Background(), s.service, s.monitoredEntity, model.FeatureTypeTypeLoadControl, api.WriteOptions{}, s.results, write)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.MsgCounterType(600), *msgCounter)
+	assert.Equal(s.T(), 1, writes)
+
+	// no result is sent
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+
+	options := api.WriteOptions{WaitForResult: true}
+	msgCounter, err = WriteWithContext(ctx, s.service, s.monitoredEntity, model.FeatureTypeTypeLoadControl, options, s.results, write)
+	assert.ErrorIs(s.T(), err, context.DeadlineExceeded)
+	assert.NotNil(s.T(), msgCounter)
+}
+
+func (s *UtilSuite) Test_WriteWithContextEarlyResult() {
+	featureLocal, featureRemote, err := localAndRemoteFeatures(s.service, s.monitoredEntity, model.FeatureTypeTypeLoadControl)
+	assert.Nil(s.T(), err)
+
+	msgCounter := model.MsgCounterType(700)
+	result := &model.ResultDataType{
+		ErrorNumber: eebusutil.Ptr(model.ErrorNumberTypeCommandRejected),
+	}
+
+	// the result is received before write returns
+	write := func() (*model.MsgCounterType, error) {
+		_ = featureLocal.HandleMessage(&spineapi.Message{
+			RequestHeader: &model.HeaderType{
+				MsgCounterReference: &msgCounter,
+			},
+			CmdClassifier: model.CmdClassifierTypeResult,
+			Cmd: model.CmdType{
+				ResultData: result,
+			},
+			FeatureRemote: featureRemote,
+			DeviceRemote:  s.remoteDevice,
+		})
+
+		// spine-go hands the result to the handler in a goroutine
+		waiters := s.results
+		assert.Eventually(s.T(), func() bool {
+			waiters.mux.Lock()
+			defer waiters.mux.Unlock()
+
+			return len(waiters.early) == 1
+		}, time.Second, time.Millisecond)
+
+		return &msgCounter, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	options := api.WriteOptions{WaitForResult: true}
+	_, err = WriteWithContext(ctx, s.service, s.monitoredEntity, model.FeatureTypeTypeLoadControl, options, s.results, write)
+	assert.ErrorIs(s.T(), err, api.ErrWriteRejected)
+
+	waiters := s.results
+	waiters.mux.Lock()
+	assert.Equal(s.T(), 0, len(waiters.early))
+	assert.Equal(s.T(), 0, len(waiters.waiters))
+	waiters.mux.Unlock()
+}
+
+func (s *UtilSuite) Test_WriteWithContextRemovesWaiter() {
+	write := func() (*model.MsgCounterType, error) {
+		return eebusutil.Ptr(model.MsgCounterType(800)), nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+
+	options := api.WriteOptions{WaitForResult: true}
+	_, err := WriteWithContext(ctx, s.service, s.monitoredEntity, model.FeatureTypeTypeLoadControl, options, s.results, write)
+	assert.ErrorIs(s.T(), err, context.DeadlineExceeded)
+
+	waiters := s.results
+	waiters.mux.Lock()
+	assert.Equal(s.T(), 0, len(waiters.waiters))
+	assert.Equal(s.T(), 0, waiters.sending)
+	waiters.mux.Unlock()
+}
+
+func (s *UtilSuite) Test_ResultWaitersPerOwner() {
+	featureLocal, featureRemote, err := localAndRemoteFeatures(s.service, s.monitoredEntity, model.FeatureTypeTypeLoadControl)
+	assert.Nil(s.T(), err)
+
+	// a second owner of writes to the same local feature, e.g. another use case
+	other := NewResultWaiters()
+	other.AddResultHandler(featureLocal)
+	other.AddResultHandler(featureLocal)
+	assert.Equal(s.T(), 1, len(other.features))
+
+	msgCounter := model.MsgCounterType(900)
+	done := make(chan error, 1)
+	go func() {
+		done <- other.WaitForResult(context.Background(), s.monitoredEntity, msgCounter)
+	}()
+
+	assert.Eventually(s.T(), func() bool {
+		other.mux.Lock()
+		defer other.mux.Unlock()
+
+		return len(other.waiters) == 1
+	}, time.Second, time.Millisecond)
+
+	_ = featureLocal.HandleMessage(&spineapi.Message{
+		RequestHeader: &model.HeaderType{
+			MsgCounterReference: &msgCounter,
+		},
+		CmdClassifier: model.CmdClassifierTypeResult,
+		Cmd: model.CmdType{
+			ResultData: &model.ResultDataType{
+				ErrorNumber: eebusutil.Ptr(model.ErrorNumberTypeNoError),
+			},
+		},
+		FeatureRemote: featureRemote,
+		DeviceRemote:  s.remoteDevice,
+	})
+
+	select {
+	case err := <-done:
+		assert.Nil(s.T(), err)
+	case <-time.After(time.Second):
+		s.T().Fatal("no result received")
+	}
+
+	// the waiters of the suite are independent and waiting for nothing
+	s.results.mux.Lock()
+	assert.Equal(s.T(), 0, len(s.results.waiters))
+	assert.Equal(s.T(), 0, len(s.results.early))
+	s.results.mux.Unlock()
+
+	// a waiter is removed when its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+
+	err = other.WaitForResult(ctx, s.monitoredEntity, msgCounter+1)
+	assert.ErrorIs(s.T(), err, context.DeadlineExceeded)
+
+	other.mux.Lock()
+	assert.Equal(s.T(), 0, len(other.waiters))
+	other.mux.Unlock()
+}
@@ -0,0 +1,172 @@
+package util
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// a result of a remote device, identified by the message counter of the write
+type resultKey struct {
+	ski        string
+	msgCounter model.MsgCounterType
+}
+
+// dispatches the results received by local features to the waiting writes
+//
+// spine-go can't remove result callbacks, so the owner of the writes, e.g. a use case,
+// adds a single instance as result handler to its local features and the waiting
+// writes are tracked here instead
+type ResultWaiters struct {
+	mux sync.Mutex
+
+	// the local features the waiters are added to as result handler
+	features []spineapi.FeatureLocalInterface
+
+	// the writes which are sent, but whose message counter is not yet known
+	sending int
+
+	// the channels of the writes waiting for their result
+	waiters map[resultKey]chan *model.ResultDataType
+
+	// the results received while writes were sent, which may belong to one of them
+	early map[resultKey]*model.ResultDataType
+}
+
+var _ spineapi.FeatureResultInterface = (*ResultWaiters)(nil)
+
+func NewResultWaiters() *ResultWaiters {
+	return &ResultWaiters{
+		waiters: make(map[resultKey]chan *model.ResultDataType),
+		early:   make(map[resultKey]*model.ResultDataType),
+	}
+}
+
+// add the waiters as result handler to a local feature, if they are not yet added
+//
+// spine-go doesn't synchronize adding result handlers with received results,
+// so this should be called when the features are added, e.g. in AddFeatures
+func (r *ResultWaiters) AddResultHandler(feature spineapi.FeatureLocalInterface) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if slices.Contains(r.features, feature) {
+		return
+	}
+
+	r.features = append(r.features, feature)
+	feature.AddResultHandler(r)
+}
+
+// hand a received result over to its waiting write
+func (r *ResultWaiters) HandleResult(msg spineapi.ResultMessage) {
+	if msg.DeviceRemote == nil {
+		return
+	}
+
+	key := resultKey{ski: msg.DeviceRemote.Ski(), msgCounter: msg.MsgCounterReference}
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if waiter, ok := r.waiters[key]; ok {
+		delete(r.waiters, key)
+		waiter <- msg.Result
+		return
+	}
+
+	// the result may arrive before the write returned its message counter
+	if r.sending > 0 {
+		r.early[key] = msg.Result
+	}
+}
+
+// announce a write whose message counter is not yet known,
+// results received until the matching sent call are kept
+func (r *ResultWaiters) send() {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.sending++
+}
+
+// end a write announced with send and return the channel receiving its result,
+// which already contains the result if it was received while sending
+//
+// without a message counter nil is returned
+func (r *ResultWaiters) sent(ski string, msgCounter *model.MsgCounterType) chan *model.ResultDataType {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	var result chan *model.ResultDataType
+	if msgCounter != nil {
+		result = r.add(resultKey{ski: ski, msgCounter: *msgCounter})
+	}
+
+	r.sending--
+	if r.sending == 0 {
+		clear(r.early)
+	}
+
+	return result
+}
+
+// return the channel receiving the result of a write
+//
+// the lock needs to be held
+func (r *ResultWaiters) add(key resultKey) chan *model.ResultDataType {
+	result := make(chan *model.ResultDataType, 1)
+
+	if data, ok := r.early[key]; ok {
+		delete(r.early, key)
+		result <- data
+		return result
+	}
+
+	r.waiters[key] = result
+
+	return result
+}
+
+// wait for the result of a write until the context is done
+func (r *ResultWaiters) wait(ctx context.Context, key resultKey, result chan *model.ResultDataType) error {
+	select {
+	case data := <-result:
+		return resultError(data)
+	case <-ctx.Done():
+		r.mux.Lock()
+		delete(r.waiters, key)
+		r.mux.Unlock()
+
+		return ctx.Err()
+	}
+}
+
+// wait for the result of a write to a remote entity until the context is done
+//
+// a result received before this is called is missed, use WriteWithContext
+// to send the write and wait for its result
+//
+// possible errors:
+//   - ErrWriteRejected if the remote entity responded with an error result
+//   - the context error if the context is done before a result is received
+func (r *ResultWaiters) WaitForResult(
+	ctx context.Context,
+	entity spineapi.EntityRemoteInterface,
+	msgCounter model.MsgCounterType) error {
+	if entity == nil || entity.Device() == nil {
+		return api.ErrNoCompatibleEntity
+	}
+
+	key := resultKey{ski: entity.Device().Ski(), msgCounter: msgCounter}
+
+	r.mux.Lock()
+	result := r.add(key)
+	r.mux.Unlock()
+
+	return r.wait(ctx, key, result)
+}
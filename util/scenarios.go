@@ -0,0 +1,97 @@
+package util
+
+import (
+	"slices"
+
+	"github.com/enbility/cemd/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// returns if a use case announcement of a remote device applies to an entity
+//
+// announcements without an entity address apply to all entities of the device
+func IsUseCaseInformationOfEntity(info model.UseCaseInformationDataType, entity spineapi.EntityRemoteInterface) bool {
+	if info.Address == nil || len(info.Address.Entity) == 0 {
+		return true
+	}
+
+	return entity.Address() != nil && slices.Equal(info.Address.Entity, entity.Address().Entity)
+}
+
+// returns the scenarios the remote device of an entity announces for the entity and a usecase
+// with one of the required actors, and if the usecase is announced at all
+func AnnouncedScenarios(
+	entity spineapi.EntityRemoteInterface,
+	usecase model.UseCaseNameType,
+	requirements api.UseCaseRequirements) ([]model.UseCaseScenarioSupportType, bool) {
+	if entity == nil || entity.Device() == nil {
+		return nil, false
+	}
+
+	var result []model.UseCaseScenarioSupportType
+	found := false
+
+	for _, info := range entity.Device().UseCases() {
+		if !IsUseCaseInformationOfEntity(info, entity) ||
+			info.Actor == nil || !slices.Contains(requirements.Actors, *info.Actor) {
+			continue
+		}
+
+		for _, support := range info.UseCaseSupport {
+			if support.UseCaseName == nil || *support.UseCaseName != usecase {
+				continue
+			}
+
+			found = true
+			for _, scenario := range support.ScenarioSupport {
+				if !slices.Contains(result, scenario) {
+					result = append(result, scenario)
+				}
+			}
+		}
+	}
+
+	slices.Sort(result)
+
+	return result, found
+}
+
+// returns api.ErrScenarioNotSupported if the remote device of an entity announces the usecase without the scenario
+//
+// if the usecase is not (yet) announced, no error is returned so the data can still be read
+func CheckScenarioSupport(
+	entity spineapi.EntityRemoteInterface,
+	usecase model.UseCaseNameType,
+	requirements api.UseCaseRequirements,
+	scenario model.UseCaseScenarioSupportType) error {
+	scenarios, found := AnnouncedScenarios(entity, usecase, requirements)
+	if found && !slices.Contains(scenarios, scenario) {
+		return api.ErrScenarioNotSupported
+	}
+
+	return nil
+}
+
+// returns the announced scenarios of a usecase for which the data is available
+//
+// checks contains a function per scenario returning an error if the data of the scenario is not available,
+// scenarios without a check are returned if they are announced
+func SupportedScenarios(
+	entity spineapi.EntityRemoteInterface,
+	usecase model.UseCaseNameType,
+	requirements api.UseCaseRequirements,
+	checks map[model.UseCaseScenarioSupportType]func() error) []model.UseCaseScenarioSupportType {
+	scenarios, _ := AnnouncedScenarios(entity, usecase, requirements)
+
+	var result []model.UseCaseScenarioSupportType
+	for _, scenario := range scenarios {
+		if check, ok := checks[scenario]; ok && check() != nil {
+			continue
+		}
+
+		result = append(result, scenario)
+	}
+
+	return result
+}
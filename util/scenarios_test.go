@@ -0,0 +1,143 @@
+package util
+
+import (
+	"errors"
+
+	"github.com/enbility/cemd/api"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UtilSuite) Test_Scenarios() {
+	usecase := model.UseCaseNameTypeMonitoringOfPowerConsumption
+	requirements := api.UseCaseRequirements{
+		Actors:    []model.UseCaseActorType{model.UseCaseActorTypeMonitoredUnit},
+		Scenarios: []model.UseCaseScenarioSupportType{1},
+	}
+
+	scenarios, found := AnnouncedScenarios(nil, usecase, requirements)
+	assert.False(s.T(), found)
+	assert.Nil(s.T(), scenarios)
+
+	scenarios, found = AnnouncedScenarios(s.monitoredEntity, usecase, requirements)
+	assert.False(s.T(), found)
+	assert.Nil(s.T(), scenarios)
+
+	// the usecase is not announced, so the data can be read
+	err := CheckScenarioSupport(s.monitoredEntity, usecase, requirements, 3)
+	assert.Nil(s.T(), err)
+
+	ucData := &model.NodeManagementUseCaseDataType{
+		UseCaseInformation: []model.UseCaseInformationDataType{
+			{
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeMonitoredUnit),
+				UseCaseSupport: []model.UseCaseSupportType{
+					{
+						UseCaseName:     eebusutil.Ptr(usecase),
+						ScenarioSupport: []model.UseCaseScenarioSupportType{4, 1, 2},
+					},
+				},
+			},
+			{
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeEV),
+				UseCaseSupport: []model.UseCaseSupportType{
+					{
+						UseCaseName:     eebusutil.Ptr(usecase),
+						ScenarioSupport: []model.UseCaseScenarioSupportType{3},
+					},
+				},
+			},
+		},
+	}
+
+	nodemgmtEntity := s.remoteDevice.Entity([]model.AddressEntityType{0})
+	nodeFeature := s.remoteDevice.FeatureByEntityTypeAndRole(nodemgmtEntity, model.FeatureTypeTypeNodeManagement, model.RoleTypeSpecial)
+	fErr := nodeFeature.UpdateData(model.FunctionTypeNodeManagementUseCaseData, ucData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	scenarios, found = AnnouncedScenarios(s.monitoredEntity, usecase, requirements)
+	assert.True(s.T(), found)
+	assert.Equal(s.T(), []model.UseCaseScenarioSupportType{1, 2, 4}, scenarios)
+
+	err = CheckScenarioSupport(s.monitoredEntity, usecase, requirements, 2)
+	assert.Nil(s.T(), err)
+
+	// scenario 3 is only announced with an actor not required by the usecase
+	err = CheckScenarioSupport(s.monitoredEntity, usecase, requirements, 3)
+	assert.ErrorIs(s.T(), err, api.ErrScenarioNotSupported)
+
+	checks := map[model.UseCaseScenarioSupportType]func() error{
+		1: func() error { return nil },
+		2: func() error { return errors.New("data not available") },
+	}
+	scenarios = SupportedScenarios(s.monitoredEntity, usecase, requirements, checks)
+	assert.Equal(s.T(), []model.UseCaseScenarioSupportType{1, 4}, scenarios)
+}
+
+func (s *UtilSuite) Test_ScenariosPerEntity() {
+	usecase := model.UseCaseNameTypeMonitoringOfPowerConsumption
+	requirements := api.UseCaseRequirements{
+		Actors:    []model.UseCaseActorType{model.UseCaseActorTypeMonitoredUnit},
+		Scenarios: []model.UseCaseScenarioSupportType{1},
+	}
+
+	// a second entity of the same type, e.g. a second EVSE
+	otherEntity := spine.NewEntityRemote(s.remoteDevice, s.monitoredEntity.EntityType(), []model.AddressEntityType{9})
+	s.remoteDevice.AddEntity(otherEntity)
+
+	ucData := &model.NodeManagementUseCaseDataType{
+		UseCaseInformation: []model.UseCaseInformationDataType{
+			{
+				Address: &model.FeatureAddressType{
+					Device: s.remoteDevice.Address(),
+					Entity: s.monitoredEntity.Address().Entity,
+				},
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeMonitoredUnit),
+				UseCaseSupport: []model.UseCaseSupportType{
+					{
+						UseCaseName:     eebusutil.Ptr(usecase),
+						ScenarioSupport: []model.UseCaseScenarioSupportType{1, 2},
+					},
+				},
+			},
+			{
+				Address: &model.FeatureAddressType{
+					Device: s.remoteDevice.Address(),
+					Entity: otherEntity.Address().Entity,
+				},
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeMonitoredUnit),
+				UseCaseSupport: []model.UseCaseSupportType{
+					{
+						UseCaseName:     eebusutil.Ptr(usecase),
+						ScenarioSupport: []model.UseCaseScenarioSupportType{3},
+					},
+				},
+			},
+		},
+	}
+
+	nodemgmtEntity := s.remoteDevice.Entity([]model.AddressEntityType{0})
+	nodeFeature := s.remoteDevice.FeatureByEntityTypeAndRole(nodemgmtEntity, model.FeatureTypeTypeNodeManagement, model.RoleTypeSpecial)
+	fErr := nodeFeature.UpdateData(model.FunctionTypeNodeManagementUseCaseData, ucData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	// each entity is only credited with the scenarios announced for its address
+	scenarios, found := AnnouncedScenarios(s.monitoredEntity, usecase, requirements)
+	assert.True(s.T(), found)
+	assert.Equal(s.T(), []model.UseCaseScenarioSupportType{1, 2}, scenarios)
+
+	scenarios, found = AnnouncedScenarios(otherEntity, usecase, requirements)
+	assert.True(s.T(), found)
+	assert.Equal(s.T(), []model.UseCaseScenarioSupportType{3}, scenarios)
+
+	err := CheckScenarioSupport(s.monitoredEntity, usecase, requirements, 3)
+	assert.ErrorIs(s.T(), err, api.ErrScenarioNotSupported)
+
+	// an entity without an announcement for its address
+	thirdEntity := spine.NewEntityRemote(s.remoteDevice, s.monitoredEntity.EntityType(), []model.AddressEntityType{10})
+	scenarios, found = AnnouncedScenarios(thirdEntity, usecase, requirements)
+	assert.False(s.T(), found)
+	assert.Nil(s.T(), scenarios)
+}
@@ -33,6 +33,8 @@ type UtilSuite struct {
 	mockRemoteEntity *mocks.EntityRemoteInterface
 	evseEntity       spineapi.EntityRemoteInterface
 	monitoredEntity  spineapi.EntityRemoteInterface
+
+	results *ResultWaiters
 }
 
 func (s *UtilSuite) Event(ski string, entity spineapi.EntityRemoteInterface, event api.EventType) {
@@ -68,6 +70,10 @@ func (s *UtilSuite) BeforeTest(suiteName, testName string) {
 	s.remoteDevice, entities = setupDevices(s.service, s.T())
 	s.evseEntity = entities[0]
 	s.monitoredEntity = entities[1]
+
+	localEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	s.results = NewResultWaiters()
+	s.results.AddResultHandler(localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeLoadControl, model.RoleTypeClient))
 }
 
 const remoteSki string = "testremoteski"
@@ -185,6 +191,7 @@ func setupDevices(
 	if err != nil {
 		fmt.Println(err)
 	}
+	remoteDevice.UpdateDevice(detailedData.DeviceInformation.Description)
 
 	localDevice.AddRemoteDeviceForSki(remoteSki, remoteDevice)
 